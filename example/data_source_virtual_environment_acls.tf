@@ -0,0 +1,13 @@
+data "proxmox_virtual_environment_acls" "example" {
+  depends_on = ["proxmox_virtual_environment_acl.example"]
+
+  user_id = "${proxmox_virtual_environment_user.example.user_id}"
+}
+
+output "data_proxmox_virtual_environment_acls_example_paths" {
+  value = "${data.proxmox_virtual_environment_acls.example.paths}"
+}
+
+output "data_proxmox_virtual_environment_acls_example_role_ids" {
+  value = "${data.proxmox_virtual_environment_acls.example.role_ids}"
+}
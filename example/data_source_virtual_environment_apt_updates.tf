@@ -0,0 +1,11 @@
+data "proxmox_virtual_environment_apt_updates" "example" {
+  node_name = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
+}
+
+output "data_proxmox_virtual_environment_apt_updates_example_new_versions" {
+  value = "${data.proxmox_virtual_environment_apt_updates.example.new_versions}"
+}
+
+output "data_proxmox_virtual_environment_apt_updates_example_package_names" {
+  value = "${data.proxmox_virtual_environment_apt_updates.example.package_names}"
+}
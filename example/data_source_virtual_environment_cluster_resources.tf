@@ -0,0 +1,15 @@
+data "proxmox_virtual_environment_cluster_resources" "example" {
+  type = "node"
+}
+
+output "data_proxmox_virtual_environment_cluster_resources_example_memory_available" {
+  value = "${data.proxmox_virtual_environment_cluster_resources.example.memory_available}"
+}
+
+output "data_proxmox_virtual_environment_cluster_resources_example_memory_used" {
+  value = "${data.proxmox_virtual_environment_cluster_resources.example.memory_used}"
+}
+
+output "data_proxmox_virtual_environment_cluster_resources_example_names" {
+  value = "${data.proxmox_virtual_environment_cluster_resources.example.names}"
+}
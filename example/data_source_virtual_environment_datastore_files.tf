@@ -0,0 +1,33 @@
+data "proxmox_virtual_environment_datastore_files" "example" {
+  content_type = "iso"
+  datastore_id = "${element(data.proxmox_virtual_environment_datastores.example.datastore_ids, index(data.proxmox_virtual_environment_datastores.example.datastore_ids, "local"))}"
+  node_name    = "${data.proxmox_virtual_environment_datastores.example.node_name}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_content_types" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.content_types}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_creation_times" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.creation_times}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_file_formats" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.file_formats}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_file_names" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.file_names}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_file_sizes" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.file_sizes}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_latest_volume_id" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.latest_volume_id}"
+}
+
+output "data_proxmox_virtual_environment_datastore_files_example_volume_ids" {
+  value = "${data.proxmox_virtual_environment_datastore_files.example.volume_ids}"
+}
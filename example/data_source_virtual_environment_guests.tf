@@ -0,0 +1,16 @@
+data "proxmox_virtual_environment_guests" "example" {
+  depends_on = ["proxmox_virtual_environment_container.example", "proxmox_virtual_environment_vm.example"]
+  tags       = ["example"]
+}
+
+output "data_proxmox_virtual_environment_guests_example_names" {
+  value = "${data.proxmox_virtual_environment_guests.example.names}"
+}
+
+output "data_proxmox_virtual_environment_guests_example_types" {
+  value = "${data.proxmox_virtual_environment_guests.example.types}"
+}
+
+output "data_proxmox_virtual_environment_guests_example_vm_ids" {
+  value = "${data.proxmox_virtual_environment_guests.example.vm_ids}"
+}
@@ -0,0 +1,19 @@
+data "proxmox_virtual_environment_network_interfaces" "example" {
+  node_name = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
+}
+
+output "data_proxmox_virtual_environment_network_interfaces_example_active" {
+  value = "${data.proxmox_virtual_environment_network_interfaces.example.active}"
+}
+
+output "data_proxmox_virtual_environment_network_interfaces_example_cidrs" {
+  value = "${data.proxmox_virtual_environment_network_interfaces.example.cidrs}"
+}
+
+output "data_proxmox_virtual_environment_network_interfaces_example_names" {
+  value = "${data.proxmox_virtual_environment_network_interfaces.example.names}"
+}
+
+output "data_proxmox_virtual_environment_network_interfaces_example_types" {
+  value = "${data.proxmox_virtual_environment_network_interfaces.example.types}"
+}
@@ -0,0 +1,18 @@
+data "proxmox_virtual_environment_permissions" "example" {
+  depends_on = ["proxmox_virtual_environment_acl.example"]
+
+  path    = "/vms/${proxmox_virtual_environment_vm.example.id}"
+  user_id = "${proxmox_virtual_environment_user.example.user_id}"
+
+  required_privileges = [
+    "VM.Audit",
+  ]
+}
+
+output "data_proxmox_virtual_environment_permissions_example_has_required_privileges" {
+  value = "${data.proxmox_virtual_environment_permissions.example.has_required_privileges}"
+}
+
+output "data_proxmox_virtual_environment_permissions_example_privileges" {
+  value = "${data.proxmox_virtual_environment_permissions.example.privileges}"
+}
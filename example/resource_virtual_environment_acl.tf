@@ -0,0 +1,10 @@
+resource "proxmox_virtual_environment_acl" "example" {
+  path      = "/vms/${proxmox_virtual_environment_vm.example.id}"
+  propagate = true
+  role_id   = "${proxmox_virtual_environment_role.example.role_id}"
+  user_id   = "${proxmox_virtual_environment_user.example.user_id}"
+}
+
+output "resource_proxmox_virtual_environment_acl_example_id" {
+  value = "${proxmox_virtual_environment_acl.example.id}"
+}
@@ -0,0 +1,11 @@
+resource "proxmox_virtual_environment_backup" "example" {
+  datastore_id   = "local"
+  mode           = "snapshot"
+  node_name      = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
+  notes_template = "Managed by Terraform"
+  vm_id          = "${proxmox_virtual_environment_vm.example.id}"
+}
+
+output "resource_proxmox_virtual_environment_backup_example_volume_id" {
+  value = "${proxmox_virtual_environment_backup.example.volume_id}"
+}
@@ -0,0 +1,16 @@
+resource "proxmox_virtual_environment_backup_job" "example" {
+  comment      = "Managed by Terraform"
+  datastore_id = "local"
+  job_id       = "terraform-provider-proxmox-example"
+  mode         = "snapshot"
+  schedule     = "sat 21:00"
+  vm_ids       = ["${proxmox_virtual_environment_vm.example.id}"]
+
+  retention {
+    keep_last = 3
+  }
+}
+
+output "resource_proxmox_virtual_environment_backup_job_example_schedule" {
+  value = "${proxmox_virtual_environment_backup_job.example.schedule}"
+}
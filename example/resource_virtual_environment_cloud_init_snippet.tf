@@ -0,0 +1,48 @@
+resource "proxmox_virtual_environment_cloud_init_snippet" "example_network" {
+  datastore_id = "${element(data.proxmox_virtual_environment_datastores.example.datastore_ids, index(data.proxmox_virtual_environment_datastores.example.datastore_ids, "local"))}"
+  node_name    = "${data.proxmox_virtual_environment_datastores.example.node_name}"
+  type         = "network"
+
+  data = <<EOF
+version: 2
+ethernets:
+  eth0:
+    dhcp4: true
+EOF
+}
+
+resource "proxmox_virtual_environment_cloud_init_snippet" "example_user" {
+  datastore_id = "${element(data.proxmox_virtual_environment_datastores.example.datastore_ids, index(data.proxmox_virtual_environment_datastores.example.datastore_ids, "local"))}"
+  node_name    = "${data.proxmox_virtual_environment_datastores.example.node_name}"
+
+  part {
+    content = <<EOF
+#cloud-config
+hostname: terraform-provider-proxmox-example
+packages:
+  - qemu-guest-agent
+EOF
+  }
+
+  part {
+    content      = "#!/bin/sh\nsystemctl enable --now qemu-guest-agent\n"
+    content_type = "text/x-shellscript"
+    file_name    = "enable-guest-agent.sh"
+  }
+}
+
+output "resource_proxmox_virtual_environment_cloud_init_snippet_example_network_file_name" {
+  value = "${proxmox_virtual_environment_cloud_init_snippet.example_network.file_name}"
+}
+
+output "resource_proxmox_virtual_environment_cloud_init_snippet_example_network_id" {
+  value = "${proxmox_virtual_environment_cloud_init_snippet.example_network.id}"
+}
+
+output "resource_proxmox_virtual_environment_cloud_init_snippet_example_user_file_name" {
+  value = "${proxmox_virtual_environment_cloud_init_snippet.example_user.file_name}"
+}
+
+output "resource_proxmox_virtual_environment_cloud_init_snippet_example_user_id" {
+  value = "${proxmox_virtual_environment_cloud_init_snippet.example_user.id}"
+}
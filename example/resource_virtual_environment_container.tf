@@ -47,6 +47,7 @@ resource "proxmox_virtual_environment_container" "example" {
 
   node_name = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
   pool_id  = "${proxmox_virtual_environment_pool.example.id}"
+  tags     = ["example", "terraform"]
   vm_id    = 2043
 }
 
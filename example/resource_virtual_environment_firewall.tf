@@ -0,0 +1,64 @@
+resource "proxmox_virtual_environment_firewall_alias" "example" {
+  cidr    = "192.168.0.0/24"
+  comment = "Managed by Terraform"
+  name    = "terraform-provider-proxmox-example"
+}
+
+resource "proxmox_virtual_environment_firewall_ipset" "example" {
+  comment = "Managed by Terraform"
+  name    = "terraform-provider-proxmox-example"
+
+  cidr {
+    name    = "${proxmox_virtual_environment_firewall_alias.example.cidr}"
+    comment = "Local network"
+  }
+
+  cidr {
+    name    = "192.168.0.1"
+    nomatch = true
+  }
+}
+
+resource "proxmox_virtual_environment_firewall_security_group" "example" {
+  comment = "Managed by Terraform"
+  name    = "tf-example"
+}
+
+resource "proxmox_virtual_environment_firewall_rules" "example_security_group" {
+  security_group = "${proxmox_virtual_environment_firewall_security_group.example.name}"
+
+  rule {
+    action = "ACCEPT"
+    macro  = "SSH"
+    source = "+${proxmox_virtual_environment_firewall_ipset.example.name}"
+    type   = "in"
+  }
+}
+
+resource "proxmox_virtual_environment_firewall_rules" "example_vm" {
+  node_name = "${proxmox_virtual_environment_vm.example.node_name}"
+  vm_id     = "${proxmox_virtual_environment_vm.example.vm_id}"
+
+  rule {
+    action = "${proxmox_virtual_environment_firewall_rules.example_security_group.security_group}"
+    type   = "group"
+  }
+
+  rule {
+    action = "DROP"
+    log    = "info"
+    type   = "in"
+  }
+}
+
+resource "proxmox_virtual_environment_firewall_options" "example_vm" {
+  enabled    = true
+  mac_filter = true
+  node_name  = "${proxmox_virtual_environment_vm.example.node_name}"
+  policy_in  = "DROP"
+  vm_id      = "${proxmox_virtual_environment_vm.example.vm_id}"
+}
+
+output "resource_proxmox_virtual_environment_firewall_rules_example_vm_rule" {
+  value = "${proxmox_virtual_environment_firewall_rules.example_vm.rule}"
+}
@@ -0,0 +1,21 @@
+resource "proxmox_virtual_environment_ha_group" "example" {
+  comment  = "Managed by Terraform"
+  group_id = "terraform-provider-proxmox-example"
+
+  nodes = "${map(data.proxmox_virtual_environment_nodes.example.names[0], 1)}"
+}
+
+resource "proxmox_virtual_environment_ha_resource" "example" {
+  comment     = "Managed by Terraform"
+  group       = "${proxmox_virtual_environment_ha_group.example.group_id}"
+  resource_id = "vm:${proxmox_virtual_environment_vm.example.id}"
+  state       = "started"
+}
+
+output "resource_proxmox_virtual_environment_ha_group_example_nodes" {
+  value = "${proxmox_virtual_environment_ha_group.example.nodes}"
+}
+
+output "resource_proxmox_virtual_environment_ha_resource_example_resource_id" {
+  value = "${proxmox_virtual_environment_ha_resource.example.resource_id}"
+}
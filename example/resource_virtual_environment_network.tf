@@ -0,0 +1,23 @@
+resource "proxmox_virtual_environment_network_interface" "example" {
+  autostart = true
+  comment   = "Managed by Terraform"
+  name      = "vmbr99"
+  node_name = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
+  type      = "bridge"
+}
+
+resource "proxmox_virtual_environment_network_apply" "example" {
+  node_name = "${proxmox_virtual_environment_network_interface.example.node_name}"
+
+  triggers = {
+    bridge = "${proxmox_virtual_environment_network_interface.example.id}"
+  }
+
+  depends_on = [
+    "proxmox_virtual_environment_network_interface.example",
+  ]
+}
+
+output "resource_proxmox_virtual_environment_network_interface_example_name" {
+  value = "${proxmox_virtual_environment_network_interface.example.name}"
+}
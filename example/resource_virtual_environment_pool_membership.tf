@@ -0,0 +1,8 @@
+resource "proxmox_virtual_environment_pool_membership" "example" {
+  datastore_id = "${element(data.proxmox_virtual_environment_datastores.example.datastore_ids, index(data.proxmox_virtual_environment_datastores.example.datastore_ids, "local"))}"
+  pool_id      = "${proxmox_virtual_environment_pool.example.id}"
+}
+
+output "resource_proxmox_virtual_environment_pool_membership_example_type" {
+  value = "${proxmox_virtual_environment_pool_membership.example.type}"
+}
@@ -0,0 +1,17 @@
+resource "proxmox_virtual_environment_realm" "example" {
+  comment = "Managed by Terraform"
+  realm   = "terraform-provider-proxmox-example"
+
+  openid {
+    client_id  = "terraform-provider-proxmox-example"
+    issuer_url = "https://sso.example.com/realms/example"
+  }
+}
+
+output "resource_proxmox_virtual_environment_realm_example_realm" {
+  value = "${proxmox_virtual_environment_realm.example.realm}"
+}
+
+output "resource_proxmox_virtual_environment_realm_example_type" {
+  value = "${proxmox_virtual_environment_realm.example.type}"
+}
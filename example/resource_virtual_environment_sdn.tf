@@ -0,0 +1,48 @@
+resource "proxmox_virtual_environment_sdn_zone" "example" {
+  mtu     = 1450
+  type    = "simple"
+  zone_id = "tfexmpl"
+}
+
+resource "proxmox_virtual_environment_sdn_vnet" "example" {
+  alias   = "Managed by Terraform"
+  vnet_id = "tfexmpl"
+  zone    = "${proxmox_virtual_environment_sdn_zone.example.zone_id}"
+}
+
+resource "proxmox_virtual_environment_sdn_subnet" "example" {
+  cidr    = "10.254.0.0/24"
+  gateway = "10.254.0.1"
+  snat    = true
+  vnet_id = "${proxmox_virtual_environment_sdn_vnet.example.vnet_id}"
+}
+
+resource "proxmox_virtual_environment_sdn_apply" "example" {
+  triggers = {
+    subnet = "${proxmox_virtual_environment_sdn_subnet.example.id}"
+    vnet   = "${proxmox_virtual_environment_sdn_vnet.example.id}"
+    zone   = "${proxmox_virtual_environment_sdn_zone.example.id}"
+  }
+
+  depends_on = [
+    "proxmox_virtual_environment_sdn_subnet.example",
+    "proxmox_virtual_environment_sdn_vnet.example",
+    "proxmox_virtual_environment_sdn_zone.example",
+  ]
+}
+
+output "resource_proxmox_virtual_environment_sdn_subnet_example_subnet_id" {
+  value = "${proxmox_virtual_environment_sdn_subnet.example.subnet_id}"
+}
+
+output "resource_proxmox_virtual_environment_sdn_subnet_example_zone" {
+  value = "${proxmox_virtual_environment_sdn_subnet.example.zone}"
+}
+
+output "resource_proxmox_virtual_environment_sdn_vnet_example_vnet_id" {
+  value = "${proxmox_virtual_environment_sdn_vnet.example.vnet_id}"
+}
+
+output "resource_proxmox_virtual_environment_sdn_zone_example_type" {
+  value = "${proxmox_virtual_environment_sdn_zone.example.type}"
+}
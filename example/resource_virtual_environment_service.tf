@@ -0,0 +1,8 @@
+resource "proxmox_virtual_environment_service" "example" {
+  name      = "chronyd"
+  node_name = "${data.proxmox_virtual_environment_nodes.example.names[0]}"
+}
+
+output "resource_proxmox_virtual_environment_service_example_state" {
+  value = "${proxmox_virtual_environment_service.example.state}"
+}
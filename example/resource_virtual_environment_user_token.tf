@@ -0,0 +1,14 @@
+resource "proxmox_virtual_environment_user_token" "example" {
+  comment    = "Managed by Terraform"
+  token_name = "terraform-provider-proxmox-example"
+  user_id    = "${proxmox_virtual_environment_user.example.user_id}"
+}
+
+output "resource_proxmox_virtual_environment_user_token_example_secret" {
+  sensitive = true
+  value     = "${proxmox_virtual_environment_user_token.example.secret}"
+}
+
+output "resource_proxmox_virtual_environment_user_token_example_token_id" {
+  value = "${proxmox_virtual_environment_user_token.example.token_id}"
+}
@@ -0,0 +1,14 @@
+resource "proxmox_virtual_environment_user_totp" "example" {
+  description = "Managed by Terraform"
+  issuer      = "terraform-provider-proxmox-example"
+  user_id     = "${proxmox_virtual_environment_user.example.user_id}"
+}
+
+output "resource_proxmox_virtual_environment_user_totp_example_provisioning_uri" {
+  sensitive = true
+  value     = "${proxmox_virtual_environment_user_totp.example.provisioning_uri}"
+}
+
+output "resource_proxmox_virtual_environment_user_totp_example_tfa_id" {
+  value = "${proxmox_virtual_environment_user_totp.example.tfa_id}"
+}
@@ -9,4 +9,5 @@ require (
 	github.com/pkg/sftp v1.11.0
 	golang.org/x/crypto v0.0.0-20190820162420-60c769a6c586
 	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55
+	gopkg.in/yaml.v2 v2.2.2
 )
@@ -7,6 +7,7 @@ package proxmox
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -157,6 +158,13 @@ func (r *CustomPrivileges) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// EncodeValues converts a CustomTimestamp value to a URL value.
+func (r CustomTimestamp) EncodeValues(key string, v *url.Values) error {
+	v.Add(key, strconv.FormatInt(time.Time(r).Unix(), 10))
+
+	return nil
+}
+
 // MarshalJSON converts a boolean to a JSON value.
 func (r CustomTimestamp) MarshalJSON() ([]byte, error) {
 	var timestamp time.Time
@@ -0,0 +1,261 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// CreateACMEAccount creates an ACME account.
+func (c *VirtualEnvironmentClient) CreateACMEAccount(d *VirtualEnvironmentACMEAccountCreateRequestBody, timeout int) error {
+	upid, err := c.CreateACMEAccountAsync(d)
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitForTask(*upid, timeout)
+}
+
+// CreateACMEAccountAsync creates an ACME account asynchronously.
+func (c *VirtualEnvironmentClient) CreateACMEAccountAsync(d *VirtualEnvironmentACMEAccountCreateRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmPOST, "cluster/acme/account", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateACMEPlugin creates an ACME plugin.
+func (c *VirtualEnvironmentClient) CreateACMEPlugin(d *VirtualEnvironmentACMEPluginCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/acme/plugins", d, nil)
+}
+
+// DeactivateACMEAccount deactivates an ACME account and removes it from the cluster.
+func (c *VirtualEnvironmentClient) DeactivateACMEAccount(name string, timeout int) error {
+	upid, err := c.DeactivateACMEAccountAsync(name)
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitForTask(*upid, timeout)
+}
+
+// DeactivateACMEAccountAsync deactivates an ACME account and removes it from the cluster asynchronously.
+func (c *VirtualEnvironmentClient) DeactivateACMEAccountAsync(name string) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmDELETE, fmt.Sprintf("cluster/acme/account/%s", url.PathEscape(name)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// DeleteACMEPlugin deletes an ACME plugin.
+func (c *VirtualEnvironmentClient) DeleteACMEPlugin(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/acme/plugins/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetACMEAccount retrieves an ACME account.
+func (c *VirtualEnvironmentClient) GetACMEAccount(name string) (*VirtualEnvironmentACMEAccountGetResponseData, error) {
+	resBody := &VirtualEnvironmentACMEAccountGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/acme/account/%s", url.PathEscape(name)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetACMEPlugin retrieves an ACME plugin.
+func (c *VirtualEnvironmentClient) GetACMEPlugin(id string) (*VirtualEnvironmentACMEPluginGetResponseData, error) {
+	resBody := &VirtualEnvironmentACMEPluginGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/acme/plugins/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListACMEAccounts retrieves a list of ACME accounts.
+func (c *VirtualEnvironmentClient) ListACMEAccounts() ([]*VirtualEnvironmentACMEAccountListResponseData, error) {
+	resBody := &VirtualEnvironmentACMEAccountListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/acme/account", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Name < resBody.Data[j].Name
+	})
+
+	return resBody.Data, nil
+}
+
+// ListACMEPlugins retrieves a list of ACME plugins.
+func (c *VirtualEnvironmentClient) ListACMEPlugins() ([]*VirtualEnvironmentACMEPluginGetResponseData, error) {
+	resBody := &VirtualEnvironmentACMEPluginListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/acme/plugins", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// OrderACMECertificate orders a new certificate for a node from the ACME directory.
+func (c *VirtualEnvironmentClient) OrderACMECertificate(nodeName string, d *VirtualEnvironmentACMECertificateOrderRequestBody, timeout int) error {
+	upid, err := c.OrderACMECertificateAsync(nodeName, d)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *upid, timeout, 5)
+}
+
+// OrderACMECertificateAsync orders a new certificate for a node from the ACME directory asynchronously.
+func (c *VirtualEnvironmentClient) OrderACMECertificateAsync(nodeName string, d *VirtualEnvironmentACMECertificateOrderRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/certificates/acme/certificate", url.PathEscape(nodeName)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// RenewACMECertificate renews the ACME certificate for a node.
+func (c *VirtualEnvironmentClient) RenewACMECertificate(nodeName string, d *VirtualEnvironmentACMECertificateRenewRequestBody, timeout int) error {
+	upid, err := c.RenewACMECertificateAsync(nodeName, d)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *upid, timeout, 5)
+}
+
+// RenewACMECertificateAsync renews the ACME certificate for a node asynchronously.
+func (c *VirtualEnvironmentClient) RenewACMECertificateAsync(nodeName string, d *VirtualEnvironmentACMECertificateRenewRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/certificates/acme/certificate", url.PathEscape(nodeName)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// RevokeACMECertificate revokes the ACME certificate for a node and removes it.
+func (c *VirtualEnvironmentClient) RevokeACMECertificate(nodeName string, timeout int) error {
+	upid, err := c.RevokeACMECertificateAsync(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *upid, timeout, 5)
+}
+
+// RevokeACMECertificateAsync revokes the ACME certificate for a node and removes it asynchronously.
+func (c *VirtualEnvironmentClient) RevokeACMECertificateAsync(nodeName string) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmDELETE, fmt.Sprintf("nodes/%s/certificates/acme/certificate", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateACMEAccount updates an ACME account.
+func (c *VirtualEnvironmentClient) UpdateACMEAccount(name string, d *VirtualEnvironmentACMEAccountUpdateRequestBody, timeout int) error {
+	upid, err := c.UpdateACMEAccountAsync(name, d)
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitForTask(*upid, timeout)
+}
+
+// UpdateACMEAccountAsync updates an ACME account asynchronously.
+func (c *VirtualEnvironmentClient) UpdateACMEAccountAsync(name string, d *VirtualEnvironmentACMEAccountUpdateRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentACMETaskResponseBody{}
+	err := c.DoRequest(hmPUT, fmt.Sprintf("cluster/acme/account/%s", url.PathEscape(name)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateACMEPlugin updates an ACME plugin.
+func (c *VirtualEnvironmentClient) UpdateACMEPlugin(id string, d *VirtualEnvironmentACMEPluginUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/acme/plugins/%s", url.PathEscape(id)), d, nil)
+}
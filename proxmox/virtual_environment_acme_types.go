@@ -0,0 +1,166 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CustomACMEPluginData handles the API data of an ACME plugin.
+type CustomACMEPluginData map[string]string
+
+// VirtualEnvironmentACMEAccountCreateRequestBody contains the data for an ACME account create request.
+type VirtualEnvironmentACMEAccountCreateRequestBody struct {
+	Contact    string  `json:"contact" url:"contact"`
+	Directory  *string `json:"directory,omitempty" url:"directory,omitempty"`
+	EABHMACKey *string `json:"eab-hmac-key,omitempty" url:"eab-hmac-key,omitempty"`
+	EABKID     *string `json:"eab-kid,omitempty" url:"eab-kid,omitempty"`
+	Name       *string `json:"name,omitempty" url:"name,omitempty"`
+	TOSURL     *string `json:"tos_url,omitempty" url:"tos_url,omitempty"`
+}
+
+// VirtualEnvironmentACMEAccountGetResponseAccount contains the account object from an ACME account get response.
+type VirtualEnvironmentACMEAccountGetResponseAccount struct {
+	Contact   []string `json:"contact,omitempty"`
+	CreatedAt *string  `json:"createdAt,omitempty"`
+	Status    *string  `json:"status,omitempty"`
+}
+
+// VirtualEnvironmentACMEAccountGetResponseBody contains the body from an ACME account get response.
+type VirtualEnvironmentACMEAccountGetResponseBody struct {
+	Data *VirtualEnvironmentACMEAccountGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentACMEAccountGetResponseData contains the data from an ACME account get response.
+type VirtualEnvironmentACMEAccountGetResponseData struct {
+	Account   *VirtualEnvironmentACMEAccountGetResponseAccount `json:"account,omitempty"`
+	Directory *string                                          `json:"directory,omitempty"`
+	Location  *string                                          `json:"location,omitempty"`
+	TOS       *string                                          `json:"tos,omitempty"`
+}
+
+// VirtualEnvironmentACMEAccountListResponseBody contains the body from an ACME account list response.
+type VirtualEnvironmentACMEAccountListResponseBody struct {
+	Data []*VirtualEnvironmentACMEAccountListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentACMEAccountListResponseData contains the data from an ACME account list response.
+type VirtualEnvironmentACMEAccountListResponseData struct {
+	Name string `json:"name"`
+}
+
+// VirtualEnvironmentACMEAccountUpdateRequestBody contains the data for an ACME account update request.
+type VirtualEnvironmentACMEAccountUpdateRequestBody struct {
+	Contact *string `json:"contact,omitempty" url:"contact,omitempty"`
+}
+
+// VirtualEnvironmentACMECertificateOrderRequestBody contains the data for an ACME certificate order request.
+type VirtualEnvironmentACMECertificateOrderRequestBody struct {
+	Force *CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
+
+// VirtualEnvironmentACMECertificateRenewRequestBody contains the data for an ACME certificate renew request.
+type VirtualEnvironmentACMECertificateRenewRequestBody struct {
+	Force *CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
+
+// VirtualEnvironmentACMEPluginCreateRequestBody contains the data for an ACME plugin create request.
+type VirtualEnvironmentACMEPluginCreateRequestBody struct {
+	API             *string               `json:"api,omitempty" url:"api,omitempty"`
+	Data            *CustomACMEPluginData `json:"data,omitempty" url:"data,omitempty"`
+	Disable         *CustomBool           `json:"disable,omitempty" url:"disable,omitempty,int"`
+	ID              string                `json:"id" url:"id"`
+	Nodes           *string               `json:"nodes,omitempty" url:"nodes,omitempty"`
+	Type            string                `json:"type" url:"type"`
+	ValidationDelay *int                  `json:"validation-delay,omitempty" url:"validation-delay,omitempty"`
+}
+
+// VirtualEnvironmentACMEPluginGetResponseBody contains the body from an ACME plugin get response.
+type VirtualEnvironmentACMEPluginGetResponseBody struct {
+	Data *VirtualEnvironmentACMEPluginGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentACMEPluginGetResponseData contains the data from an ACME plugin get response.
+type VirtualEnvironmentACMEPluginGetResponseData struct {
+	API             *string               `json:"api,omitempty"`
+	Data            *CustomACMEPluginData `json:"data,omitempty"`
+	Digest          *string               `json:"digest,omitempty"`
+	Disable         *CustomBool           `json:"disable,omitempty"`
+	ID              string                `json:"plugin"`
+	Nodes           *string               `json:"nodes,omitempty"`
+	Type            string                `json:"type"`
+	ValidationDelay *int                  `json:"validation-delay,omitempty"`
+}
+
+// VirtualEnvironmentACMEPluginListResponseBody contains the body from an ACME plugin list response.
+type VirtualEnvironmentACMEPluginListResponseBody struct {
+	Data []*VirtualEnvironmentACMEPluginGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentACMEPluginUpdateRequestBody contains the data for an ACME plugin update request.
+type VirtualEnvironmentACMEPluginUpdateRequestBody struct {
+	API             *string               `json:"api,omitempty" url:"api,omitempty"`
+	Data            *CustomACMEPluginData `json:"data,omitempty" url:"data,omitempty"`
+	Delete          []string              `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Digest          *string               `json:"digest,omitempty" url:"digest,omitempty"`
+	Disable         *CustomBool           `json:"disable,omitempty" url:"disable,omitempty,int"`
+	Nodes           *string               `json:"nodes,omitempty" url:"nodes,omitempty"`
+	ValidationDelay *int                  `json:"validation-delay,omitempty" url:"validation-delay,omitempty"`
+}
+
+// VirtualEnvironmentACMETaskResponseBody contains the body from an ACME task response.
+type VirtualEnvironmentACMETaskResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// EncodeValues converts a CustomACMEPluginData map to a URL value.
+func (r CustomACMEPluginData) EncodeValues(key string, v *url.Values) error {
+	keys := make([]string, 0, len(r))
+
+	for k := range r {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+
+	for i, k := range keys {
+		lines[i] = fmt.Sprintf("%s=%s", k, r[k])
+	}
+
+	// The API expects the data to be base64 encoded, as it may contain line breaks and special characters.
+	v.Add(key, base64.StdEncoding.EncodeToString([]byte(strings.Join(lines, "\n")+"\n")))
+
+	return nil
+}
+
+// UnmarshalJSON converts a CustomACMEPluginData string to a map.
+func (r *CustomACMEPluginData) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*r = CustomACMEPluginData{}
+
+	for _, line := range strings.Split(s, "\n") {
+		v := strings.SplitN(strings.TrimSpace(line), "=", 2)
+
+		if len(v) == 2 && v[0] != "" {
+			(*r)[v[0]] = v[1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+const (
+	aptSourcesDir = "/etc/apt/sources.list.d"
+)
+
+// AddAPTStandardRepository adds a standard APT repository to a node.
+func (c *VirtualEnvironmentClient) AddAPTStandardRepository(nodeName string, d *VirtualEnvironmentAPTRepositoryAddRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/apt/repositories", url.PathEscape(nodeName)), d, nil)
+}
+
+// DeleteAPTRepositoryFile deletes an APT repository file from a node.
+func (c *VirtualEnvironmentClient) DeleteAPTRepositoryFile(nodeName string, fileName string) error {
+	return c.ExecuteNodeCommands(nodeName, []string{
+		fmt.Sprintf("rm -f %s/%s", aptSourcesDir, fileName),
+	})
+}
+
+// GetAPTRepositoryFilePath returns the path to an APT repository file, which is managed with WriteAPTRepositoryFile.
+func (c *VirtualEnvironmentClient) GetAPTRepositoryFilePath(fileName string) string {
+	return fmt.Sprintf("%s/%s", aptSourcesDir, fileName)
+}
+
+// ListAPTRepositories retrieves the APT repositories configured on a node.
+func (c *VirtualEnvironmentClient) ListAPTRepositories(nodeName string) (*VirtualEnvironmentAPTRepositoryListResponseData, error) {
+	resBody := &VirtualEnvironmentAPTRepositoryListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/apt/repositories", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListAPTUpdates retrieves the pending package updates for a node.
+func (c *VirtualEnvironmentClient) ListAPTUpdates(nodeName string) ([]*VirtualEnvironmentAPTUpdateListResponseData, error) {
+	resBody := &VirtualEnvironmentAPTUpdateListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/apt/update", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Package < resBody.Data[j].Package
+	})
+
+	return resBody.Data, nil
+}
+
+// RefreshAPTUpdates refreshes the package database of a node.
+func (c *VirtualEnvironmentClient) RefreshAPTUpdates(nodeName string, timeout int) error {
+	taskID, err := c.RefreshAPTUpdatesAsync(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *taskID, timeout, 5)
+}
+
+// RefreshAPTUpdatesAsync refreshes the package database of a node asynchronously.
+func (c *VirtualEnvironmentClient) RefreshAPTUpdatesAsync(nodeName string) (*string, error) {
+	resBody := &VirtualEnvironmentAPTUpdateRefreshResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/apt/update", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateAPTRepository updates an APT repository on a node.
+func (c *VirtualEnvironmentClient) UpdateAPTRepository(nodeName string, d *VirtualEnvironmentAPTRepositoryUpdateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/apt/repositories", url.PathEscape(nodeName)), d, nil)
+}
+
+// WriteAPTRepositoryFile writes an APT repository file to a node, as the API does not support custom repositories.
+func (c *VirtualEnvironmentClient) WriteAPTRepositoryFile(nodeName string, fileName string, content string) error {
+	return c.ExecuteNodeCommands(nodeName, []string{
+		fmt.Sprintf(
+			"echo %s | base64 -d > %s/%s",
+			base64.StdEncoding.EncodeToString([]byte(content)),
+			aptSourcesDir,
+			fileName,
+		),
+	})
+}
@@ -0,0 +1,81 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentAPTRepositoryAddRequestBody contains the data for an APT standard repository add request.
+type VirtualEnvironmentAPTRepositoryAddRequestBody struct {
+	Handle string `json:"handle" url:"handle"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseBody contains the body from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseBody struct {
+	Data *VirtualEnvironmentAPTRepositoryListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseData contains the data from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseData struct {
+	Digest        string                                                    `json:"digest"`
+	Files         []VirtualEnvironmentAPTRepositoryListResponseFile         `json:"files"`
+	StandardRepos []VirtualEnvironmentAPTRepositoryListResponseStandardRepo `json:"standard-repos"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseFile contains the data for a repository file from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseFile struct {
+	FileType     string                                                  `json:"file-type"`
+	Path         string                                                  `json:"path"`
+	Repositories []VirtualEnvironmentAPTRepositoryListResponseRepository `json:"repositories"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseRepository contains the data for a repository from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseRepository struct {
+	Comment    *string                                                       `json:"Comment,omitempty"`
+	Components []string                                                      `json:"Components,omitempty"`
+	Enabled    CustomBool                                                    `json:"Enabled"`
+	Options    []VirtualEnvironmentAPTRepositoryListResponseRepositoryOption `json:"Options,omitempty"`
+	Suites     []string                                                      `json:"Suites,omitempty"`
+	Types      []string                                                      `json:"Types,omitempty"`
+	URIs       []string                                                      `json:"URIs,omitempty"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseRepositoryOption contains the data for a repository option from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseRepositoryOption struct {
+	Key    string   `json:"Key"`
+	Values []string `json:"Values"`
+}
+
+// VirtualEnvironmentAPTRepositoryListResponseStandardRepo contains the data for a standard repository from an APT repository list response.
+type VirtualEnvironmentAPTRepositoryListResponseStandardRepo struct {
+	Handle string      `json:"handle"`
+	Name   string      `json:"name"`
+	Status *CustomBool `json:"status,omitempty"`
+}
+
+// VirtualEnvironmentAPTRepositoryUpdateRequestBody contains the data for an APT repository update request.
+type VirtualEnvironmentAPTRepositoryUpdateRequestBody struct {
+	Enabled *CustomBool `json:"enabled,omitempty" url:"enabled,omitempty,int"`
+	Index   int         `json:"index" url:"index"`
+	Path    string      `json:"path" url:"path"`
+}
+
+// VirtualEnvironmentAPTUpdateListResponseBody contains the body from an APT update list response.
+type VirtualEnvironmentAPTUpdateListResponseBody struct {
+	Data []*VirtualEnvironmentAPTUpdateListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentAPTUpdateListResponseData contains the data from an APT update list response.
+type VirtualEnvironmentAPTUpdateListResponseData struct {
+	NewVersion string  `json:"Version"`
+	OldVersion *string `json:"OldVersion,omitempty"`
+	Origin     *string `json:"Origin,omitempty"`
+	Package    string  `json:"Package"`
+	Priority   *string `json:"Priority,omitempty"`
+	Section    *string `json:"Section,omitempty"`
+	Title      *string `json:"Title,omitempty"`
+}
+
+// VirtualEnvironmentAPTUpdateRefreshResponseBody contains the body from an APT update refresh response.
+type VirtualEnvironmentAPTUpdateRefreshResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
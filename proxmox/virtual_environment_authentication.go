@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
@@ -24,67 +25,47 @@ func (c *VirtualEnvironmentClient) Authenticate(reset bool) error {
 		return nil
 	}
 
-	var reqBody *bytes.Buffer
-
-	if c.OTP != nil {
-		reqBody = bytes.NewBufferString(fmt.Sprintf(
-			"username=%s&password=%s&otp=%s",
-			url.QueryEscape(c.Username),
-			url.QueryEscape(c.Password),
-			url.QueryEscape(*c.OTP),
-		))
-	} else {
-		reqBody = bytes.NewBufferString(fmt.Sprintf(
-			"username=%s&password=%s",
-			url.QueryEscape(c.Username),
-			url.QueryEscape(c.Password),
-		))
-	}
-
-	req, err := http.NewRequest(hmPOST, fmt.Sprintf("%s/%s/access/ticket", c.Endpoint, basePathJSONAPI), reqBody)
+	otp, err := c.getOTP()
 
 	if err != nil {
-		return errors.New("Failed to create authentication request")
+		return err
 	}
 
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	values := url.Values{}
 
-	res, err := c.httpClient.Do(req)
+	values.Set("username", c.Username)
+	values.Set("password", c.Password)
 
-	if err != nil {
-		return errors.New("Failed to retrieve authentication response")
+	if otp != "" {
+		values.Set("otp", otp)
 	}
 
-	err = c.ValidateResponseCode(res)
+	resData, err := c.requestTicket(values)
 
 	if err != nil {
 		return err
 	}
 
-	resBody := VirtualEnvironmentAuthenticationResponseBody{}
-	err = json.NewDecoder(res.Body).Decode(&resBody)
+	// Newer versions may ignore the legacy OTP argument and respond with a ticket, which must be used to complete a TFA challenge.
+	if resData.NeedTFA != nil && bool(*resData.NeedTFA) {
+		if otp == "" {
+			return errors.New("The server requires a second factor for authentication (specify either an OTP or a TOTP secret)")
+		}
 
-	if err != nil {
-		return errors.New("Failed to decode authentication response")
-	}
+		values = url.Values{}
 
-	if resBody.Data == nil {
-		return errors.New("The server did not include a data object in the authentication response")
-	}
+		values.Set("username", c.Username)
+		values.Set("password", fmt.Sprintf("totp:%s", otp))
+		values.Set("tfa-challenge", *resData.Ticket)
 
-	if resBody.Data.CSRFPreventionToken == nil {
-		return errors.New("The server did not include a CSRF prevention token in the authentication response")
-	}
+		resData, err = c.requestTicket(values)
 
-	if resBody.Data.Ticket == nil {
-		return errors.New("The server did not include a ticket in the authentication response")
+		if err != nil {
+			return err
+		}
 	}
 
-	if resBody.Data.Username == "" {
-		return errors.New("The server did not include the username in the authentication response")
-	}
-
-	c.authenticationData = resBody.Data
+	c.authenticationData = resData
 
 	return nil
 }
@@ -108,3 +89,73 @@ func (c *VirtualEnvironmentClient) AuthenticateRequest(req *http.Request) error
 
 	return nil
 }
+
+// getOTP returns the one-time password for the current authentication attempt.
+func (c *VirtualEnvironmentClient) getOTP() (string, error) {
+	if c.TOTPSecret != nil {
+		otp, err := GenerateTOTPCode(*c.TOTPSecret, time.Now())
+
+		if err != nil {
+			return "", fmt.Errorf("Failed to generate a TOTP code - Reason: %s", err.Error())
+		}
+
+		return otp, nil
+	}
+
+	if c.OTP != nil {
+		return *c.OTP, nil
+	}
+
+	return "", nil
+}
+
+// requestTicket requests a new authentication ticket.
+func (c *VirtualEnvironmentClient) requestTicket(values url.Values) (*VirtualEnvironmentAuthenticationResponseData, error) {
+	reqBody := bytes.NewBufferString(values.Encode())
+	req, err := http.NewRequest(hmPOST, fmt.Sprintf("%s/%s/access/ticket", c.Endpoint, basePathJSONAPI), reqBody)
+
+	if err != nil {
+		return nil, errors.New("Failed to create authentication request")
+	}
+
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return nil, errors.New("Failed to retrieve authentication response")
+	}
+
+	defer res.Body.Close()
+
+	err = c.ValidateResponseCode(res)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := VirtualEnvironmentAuthenticationResponseBody{}
+	err = json.NewDecoder(res.Body).Decode(&resBody)
+
+	if err != nil {
+		return nil, errors.New("Failed to decode authentication response")
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the authentication response")
+	}
+
+	if resBody.Data.CSRFPreventionToken == nil {
+		return nil, errors.New("The server did not include a CSRF prevention token in the authentication response")
+	}
+
+	if resBody.Data.Ticket == nil {
+		return nil, errors.New("The server did not include a ticket in the authentication response")
+	}
+
+	if resBody.Data.Username == "" {
+		return nil, errors.New("The server did not include the username in the authentication response")
+	}
+
+	return resBody.Data, nil
+}
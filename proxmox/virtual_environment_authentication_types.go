@@ -23,6 +23,7 @@ type VirtualEnvironmentAuthenticationResponseData struct {
 	ClusterName         *string                                               `json:"clustername,omitempty"`
 	CSRFPreventionToken *string                                               `json:"CSRFPreventionToken,omitempty"`
 	Capabilities        *VirtualEnvironmentAuthenticationResponseCapabilities `json:"cap,omitempty"`
+	NeedTFA             *CustomBool                                           `json:"NeedTFA,omitempty"`
 	Ticket              *string                                               `json:"ticket,omitempty"`
 	Username            string                                                `json:"username"`
 }
@@ -0,0 +1,96 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// CreateBackupJob creates a backup job.
+func (c *VirtualEnvironmentClient) CreateBackupJob(d *VirtualEnvironmentBackupJobCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/backup", d, nil)
+}
+
+// DeleteBackupJob deletes a backup job.
+func (c *VirtualEnvironmentClient) DeleteBackupJob(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/backup/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetBackupJob retrieves a backup job.
+func (c *VirtualEnvironmentClient) GetBackupJob(id string) (*VirtualEnvironmentBackupJobGetResponseData, error) {
+	resBody := &VirtualEnvironmentBackupJobGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/backup/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListBackupJobs retrieves a list of backup jobs.
+func (c *VirtualEnvironmentClient) ListBackupJobs() ([]*VirtualEnvironmentBackupJobGetResponseData, error) {
+	resBody := &VirtualEnvironmentBackupJobListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/backup", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateBackupJob updates a backup job.
+func (c *VirtualEnvironmentClient) UpdateBackupJob(id string, d *VirtualEnvironmentBackupJobUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/backup/%s", url.PathEscape(id)), d, nil)
+}
+
+// Vzdump creates a backup of a virtual machine or container.
+func (c *VirtualEnvironmentClient) Vzdump(nodeName string, timeout int, d *VirtualEnvironmentVzdumpRequestBody) error {
+	taskID, err := c.VzdumpAsync(nodeName, d)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.WaitForNodeTask(nodeName, *taskID, timeout, 5)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VzdumpAsync creates a backup of a virtual machine or container asynchronously.
+func (c *VirtualEnvironmentClient) VzdumpAsync(nodeName string, d *VirtualEnvironmentVzdumpRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentVzdumpResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/vzdump", url.PathEscape(nodeName)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
@@ -0,0 +1,209 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CustomPruneBackups contains the retention options for backups.
+type CustomPruneBackups struct {
+	KeepAll     *CustomBool
+	KeepDaily   *int
+	KeepHourly  *int
+	KeepLast    *int
+	KeepMonthly *int
+	KeepWeekly  *int
+	KeepYearly  *int
+}
+
+// VirtualEnvironmentBackupJobCreateRequestBody contains the data for a backup job create request.
+type VirtualEnvironmentBackupJobCreateRequestBody struct {
+	All              *CustomBool              `json:"all,omitempty" url:"all,omitempty,int"`
+	Comment          *string                  `json:"comment,omitempty" url:"comment,omitempty"`
+	Compress         *string                  `json:"compress,omitempty" url:"compress,omitempty"`
+	Enabled          *CustomBool              `json:"enabled,omitempty" url:"enabled,omitempty,int"`
+	Exclude          CustomCommaSeparatedList `json:"exclude,omitempty" url:"exclude,omitempty,comma"`
+	ID               string                   `json:"id" url:"id"`
+	MailNotification *string                  `json:"mailnotification,omitempty" url:"mailnotification,omitempty"`
+	MailTo           CustomCommaSeparatedList `json:"mailto,omitempty" url:"mailto,omitempty,comma"`
+	Mode             *string                  `json:"mode,omitempty" url:"mode,omitempty"`
+	Node             *string                  `json:"node,omitempty" url:"node,omitempty"`
+	NotesTemplate    *string                  `json:"notes-template,omitempty" url:"notes-template,omitempty"`
+	Pool             *string                  `json:"pool,omitempty" url:"pool,omitempty"`
+	PruneBackups     *CustomPruneBackups      `json:"prune-backups,omitempty" url:"prune-backups,omitempty"`
+	Schedule         string                   `json:"schedule" url:"schedule"`
+	Storage          *string                  `json:"storage,omitempty" url:"storage,omitempty"`
+	VMIDs            CustomCommaSeparatedList `json:"vmid,omitempty" url:"vmid,omitempty,comma"`
+}
+
+// VirtualEnvironmentBackupJobGetResponseBody contains the body from a backup job get response.
+type VirtualEnvironmentBackupJobGetResponseBody struct {
+	Data *VirtualEnvironmentBackupJobGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentBackupJobGetResponseData contains the data from a backup job get response.
+type VirtualEnvironmentBackupJobGetResponseData struct {
+	All              *CustomBool               `json:"all,omitempty"`
+	Comment          *string                   `json:"comment,omitempty"`
+	Compress         *string                   `json:"compress,omitempty"`
+	Enabled          *CustomBool               `json:"enabled,omitempty"`
+	Exclude          *CustomCommaSeparatedList `json:"exclude,omitempty"`
+	ID               string                    `json:"id"`
+	MailNotification *string                   `json:"mailnotification,omitempty"`
+	MailTo           *CustomCommaSeparatedList `json:"mailto,omitempty"`
+	Mode             *string                   `json:"mode,omitempty"`
+	Node             *string                   `json:"node,omitempty"`
+	NotesTemplate    *string                   `json:"notes-template,omitempty"`
+	Pool             *string                   `json:"pool,omitempty"`
+	PruneBackups     *CustomPruneBackups       `json:"prune-backups,omitempty"`
+	Schedule         string                    `json:"schedule"`
+	Storage          *string                   `json:"storage,omitempty"`
+	VMIDs            *CustomCommaSeparatedList `json:"vmid,omitempty"`
+}
+
+// VirtualEnvironmentBackupJobListResponseBody contains the body from a backup job list response.
+type VirtualEnvironmentBackupJobListResponseBody struct {
+	Data []*VirtualEnvironmentBackupJobGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentBackupJobUpdateRequestBody contains the data for a backup job update request.
+type VirtualEnvironmentBackupJobUpdateRequestBody struct {
+	All              *CustomBool              `json:"all,omitempty" url:"all,omitempty,int"`
+	Comment          *string                  `json:"comment,omitempty" url:"comment,omitempty"`
+	Compress         *string                  `json:"compress,omitempty" url:"compress,omitempty"`
+	Delete           []string                 `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Enabled          *CustomBool              `json:"enabled,omitempty" url:"enabled,omitempty,int"`
+	Exclude          CustomCommaSeparatedList `json:"exclude,omitempty" url:"exclude,omitempty,comma"`
+	MailNotification *string                  `json:"mailnotification,omitempty" url:"mailnotification,omitempty"`
+	MailTo           CustomCommaSeparatedList `json:"mailto,omitempty" url:"mailto,omitempty,comma"`
+	Mode             *string                  `json:"mode,omitempty" url:"mode,omitempty"`
+	Node             *string                  `json:"node,omitempty" url:"node,omitempty"`
+	NotesTemplate    *string                  `json:"notes-template,omitempty" url:"notes-template,omitempty"`
+	Pool             *string                  `json:"pool,omitempty" url:"pool,omitempty"`
+	PruneBackups     *CustomPruneBackups      `json:"prune-backups,omitempty" url:"prune-backups,omitempty"`
+	Schedule         string                   `json:"schedule" url:"schedule"`
+	Storage          *string                  `json:"storage,omitempty" url:"storage,omitempty"`
+	VMIDs            CustomCommaSeparatedList `json:"vmid,omitempty" url:"vmid,omitempty,comma"`
+}
+
+// VirtualEnvironmentVzdumpRequestBody contains the data for a vzdump request.
+type VirtualEnvironmentVzdumpRequestBody struct {
+	Compress      *string     `json:"compress,omitempty" url:"compress,omitempty"`
+	Mode          *string     `json:"mode,omitempty" url:"mode,omitempty"`
+	NotesTemplate *string     `json:"notes-template,omitempty" url:"notes-template,omitempty"`
+	Protected     *CustomBool `json:"protected,omitempty" url:"protected,omitempty,int"`
+	Remove        *CustomBool `json:"remove,omitempty" url:"remove,omitempty,int"`
+	Storage       *string     `json:"storage,omitempty" url:"storage,omitempty"`
+	VMID          int         `json:"vmid" url:"vmid"`
+}
+
+// VirtualEnvironmentVzdumpResponseBody contains the body from a vzdump response.
+type VirtualEnvironmentVzdumpResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// EncodeValues converts a CustomPruneBackups struct to a URL vlaue.
+func (r CustomPruneBackups) EncodeValues(key string, v *url.Values) error {
+	values := []string{}
+
+	if r.KeepAll != nil {
+		if *r.KeepAll {
+			values = append(values, "keep-all=1")
+		} else {
+			values = append(values, "keep-all=0")
+		}
+	}
+
+	keep := []struct {
+		name  string
+		value *int
+	}{
+		{"keep-daily", r.KeepDaily},
+		{"keep-hourly", r.KeepHourly},
+		{"keep-last", r.KeepLast},
+		{"keep-monthly", r.KeepMonthly},
+		{"keep-weekly", r.KeepWeekly},
+		{"keep-yearly", r.KeepYearly},
+	}
+
+	for _, k := range keep {
+		if k.value != nil {
+			values = append(values, fmt.Sprintf("%s=%d", k.name, *k.value))
+		}
+	}
+
+	if len(values) > 0 {
+		v.Add(key, strings.Join(values, ","))
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts a JSON value to a CustomPruneBackups struct.
+func (r *CustomPruneBackups) UnmarshalJSON(b []byte) error {
+	values := map[string]string{}
+
+	var s string
+
+	// The retention options are returned as either a property string or an object depending on the API version.
+	if err := json.Unmarshal(b, &s); err == nil {
+		for _, option := range strings.Split(s, ",") {
+			kv := strings.SplitN(option, "=", 2)
+
+			if len(kv) == 2 {
+				values[kv[0]] = kv[1]
+			}
+		}
+	} else {
+		var o map[string]interface{}
+
+		err = json.Unmarshal(b, &o)
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range o {
+			values[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	for k, v := range values {
+		if k == "keep-all" {
+			keepAll := CustomBool(v == "1" || v == "true")
+			r.KeepAll = &keepAll
+
+			continue
+		}
+
+		i, err := strconv.Atoi(v)
+
+		if err != nil {
+			return err
+		}
+
+		switch k {
+		case "keep-daily":
+			r.KeepDaily = &i
+		case "keep-hourly":
+			r.KeepHourly = &i
+		case "keep-last":
+			r.KeepLast = &i
+		case "keep-monthly":
+			r.KeepMonthly = &i
+		case "keep-weekly":
+			r.KeepWeekly = &i
+		case "keep-yearly":
+			r.KeepYearly = &i
+		}
+	}
+
+	return nil
+}
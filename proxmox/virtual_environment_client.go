@@ -21,7 +21,7 @@ import (
 )
 
 // NewVirtualEnvironmentClient creates and initializes a VirtualEnvironmentClient instance.
-func NewVirtualEnvironmentClient(endpoint, username, password, otp string, insecure bool) (*VirtualEnvironmentClient, error) {
+func NewVirtualEnvironmentClient(endpoint, username, password, otp, totpSecret string, insecure bool) (*VirtualEnvironmentClient, error) {
 	url, err := url.ParseRequestURI(endpoint)
 
 	if err != nil {
@@ -46,6 +46,18 @@ func NewVirtualEnvironmentClient(endpoint, username, password, otp string, insec
 		pOTP = &otp
 	}
 
+	var pTOTPSecret *string
+
+	if totpSecret != "" {
+		err = ValidateTOTPSecret(totpSecret)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pTOTPSecret = &totpSecret
+	}
+
 	httpClient := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
@@ -59,8 +71,12 @@ func NewVirtualEnvironmentClient(endpoint, username, password, otp string, insec
 		Insecure:   insecure,
 		OTP:        pOTP,
 		Password:   password,
+		TOTPSecret: pTOTPSecret,
 		Username:   username,
 		httpClient: httpClient,
+		vmIDMax:    vmIDMax,
+		vmIDMin:    vmIDMin,
+		vmIDNext:   -1,
 	}, nil
 }
 
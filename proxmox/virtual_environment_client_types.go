@@ -7,6 +7,7 @@ package proxmox
 import (
 	"io"
 	"net/http"
+	"sync"
 )
 
 const (
@@ -20,14 +21,19 @@ const (
 
 // VirtualEnvironmentClient implements an API client for the Proxmox Virtual Environment API.
 type VirtualEnvironmentClient struct {
-	Endpoint string
-	Insecure bool
-	OTP      *string
-	Password string
-	Username string
+	Endpoint   string
+	Insecure   bool
+	OTP        *string
+	Password   string
+	TOTPSecret *string
+	Username   string
 
 	authenticationData *VirtualEnvironmentAuthenticationResponseData
 	httpClient         *http.Client
+	vmIDMax            int
+	vmIDMin            int
+	vmIDMutex          sync.Mutex
+	vmIDNext           int
 }
 
 // VirtualEnvironmentErrorResponseBody contains the body of an error response.
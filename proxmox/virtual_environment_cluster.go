@@ -6,6 +6,7 @@ package proxmox
 
 import (
 	"errors"
+	"sort"
 )
 
 // GetClusterNextID retrieves the next free VM identifier for the cluster.
@@ -27,3 +28,44 @@ func (c *VirtualEnvironmentClient) GetClusterNextID(vmID *int) (*int, error) {
 
 	return (*int)(resBody.Data), nil
 }
+
+// GetClusterOptions retrieves the cluster options.
+func (c *VirtualEnvironmentClient) GetClusterOptions() (*VirtualEnvironmentClusterOptionsResponseData, error) {
+	resBody := &VirtualEnvironmentClusterOptionsResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/options", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListClusterResources retrieves a list of the resources in the cluster.
+func (c *VirtualEnvironmentClient) ListClusterResources(d *VirtualEnvironmentClusterResourceListRequestBody) ([]*VirtualEnvironmentClusterResourceListResponseData, error) {
+	resBody := &VirtualEnvironmentClusterResourceListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/resources", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateClusterOptions updates the cluster options.
+func (c *VirtualEnvironmentClient) UpdateClusterOptions(d *VirtualEnvironmentClusterOptionsUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, "cluster/options", d, nil)
+}
@@ -4,6 +4,22 @@
 
 package proxmox
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CustomTagStyle handles the tag style of the cluster options.
+type CustomTagStyle struct {
+	CaseSensitive *CustomBool       `json:"case-sensitive,omitempty"`
+	ColorMap      map[string]string `json:"color-map,omitempty"`
+	Ordering      *string           `json:"ordering,omitempty"`
+	Shape         *string           `json:"shape,omitempty"`
+}
+
 // VirtualEnvironmentClusterNextIDRequestBody contains the data for a cluster next id request.
 type VirtualEnvironmentClusterNextIDRequestBody struct {
 	VMID *int `json:"vmid,omitempty" url:"vmid,omitempty"`
@@ -13,3 +29,151 @@ type VirtualEnvironmentClusterNextIDRequestBody struct {
 type VirtualEnvironmentClusterNextIDResponseBody struct {
 	Data *CustomInt `json:"data,omitempty"`
 }
+
+// VirtualEnvironmentClusterOptionsResponseBody contains the body from a cluster options response.
+type VirtualEnvironmentClusterOptionsResponseBody struct {
+	Data *VirtualEnvironmentClusterOptionsResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentClusterOptionsResponseData contains the data from a cluster options response.
+type VirtualEnvironmentClusterOptionsResponseData struct {
+	TagStyle *CustomTagStyle `json:"tag-style,omitempty"`
+}
+
+// VirtualEnvironmentClusterOptionsUpdateRequestBody contains the data for a cluster options update request.
+type VirtualEnvironmentClusterOptionsUpdateRequestBody struct {
+	Delete   []string        `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	TagStyle *CustomTagStyle `json:"tag-style,omitempty" url:"tag-style,omitempty"`
+}
+
+// VirtualEnvironmentClusterResourceListRequestBody contains the data for a cluster resource list request.
+type VirtualEnvironmentClusterResourceListRequestBody struct {
+	Type *string `json:"type,omitempty" url:"type,omitempty"`
+}
+
+// VirtualEnvironmentClusterResourceListResponseBody contains the body from a cluster resource list response.
+type VirtualEnvironmentClusterResourceListResponseBody struct {
+	Data []*VirtualEnvironmentClusterResourceListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentClusterResourceListResponseData contains the data from a cluster resource list response.
+type VirtualEnvironmentClusterResourceListResponseData struct {
+	CPUCount        *float64    `json:"maxcpu,omitempty"`
+	CPUUtilization  *float64    `json:"cpu,omitempty"`
+	DiskAvailable   *int        `json:"maxdisk,omitempty"`
+	DiskUsed        *int        `json:"disk,omitempty"`
+	HAState         *string     `json:"hastate,omitempty"`
+	ID              string      `json:"id"`
+	MemoryAvailable *int        `json:"maxmem,omitempty"`
+	MemoryUsed      *int        `json:"mem,omitempty"`
+	Name            *string     `json:"name,omitempty"`
+	NodeName        *string     `json:"node,omitempty"`
+	PoolID          *string     `json:"pool,omitempty"`
+	SDN             *string     `json:"sdn,omitempty"`
+	Status          *string     `json:"status,omitempty"`
+	Storage         *string     `json:"storage,omitempty"`
+	Tags            *string     `json:"tags,omitempty"`
+	Template        *CustomBool `json:"template,omitempty"`
+	Type            string      `json:"type"`
+	Uptime          *int        `json:"uptime,omitempty"`
+	VMID            *int        `json:"vmid,omitempty"`
+}
+
+// EncodeValues converts a CustomTagStyle struct to a URL vlaue.
+func (r CustomTagStyle) EncodeValues(key string, v *url.Values) error {
+	values := []string{}
+
+	if r.CaseSensitive != nil {
+		if *r.CaseSensitive {
+			values = append(values, "case-sensitive=1")
+		} else {
+			values = append(values, "case-sensitive=0")
+		}
+	}
+
+	if len(r.ColorMap) > 0 {
+		tags := make([]string, 0, len(r.ColorMap))
+
+		for tag := range r.ColorMap {
+			tags = append(tags, tag)
+		}
+
+		sort.Strings(tags)
+
+		colors := make([]string, len(tags))
+
+		for i, tag := range tags {
+			colors[i] = fmt.Sprintf("%s:%s", tag, r.ColorMap[tag])
+		}
+
+		values = append(values, fmt.Sprintf("color-map=%s", strings.Join(colors, ";")))
+	}
+
+	if r.Ordering != nil {
+		values = append(values, fmt.Sprintf("ordering=%s", *r.Ordering))
+	}
+
+	if r.Shape != nil {
+		values = append(values, fmt.Sprintf("shape=%s", *r.Shape))
+	}
+
+	v.Add(key, strings.Join(values, ","))
+
+	return nil
+}
+
+// UnmarshalJSON converts a CustomTagStyle string or object to an object.
+func (r *CustomTagStyle) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	// Newer API versions return the parsed property string instead of the raw value.
+	if err != nil {
+		var m map[string]interface{}
+
+		err = json.Unmarshal(b, &m)
+
+		if err != nil {
+			return err
+		}
+
+		pairs := []string{}
+
+		for k, v := range m {
+			pairs = append(pairs, fmt.Sprintf("%s=%v", k, v))
+		}
+
+		s = strings.Join(pairs, ",")
+	}
+
+	pairs := strings.Split(s, ",")
+
+	for _, p := range pairs {
+		v := strings.SplitN(strings.TrimSpace(p), "=", 2)
+
+		if len(v) == 2 {
+			switch v[0] {
+			case "case-sensitive":
+				bv := CustomBool(v[1] == "1" || v[1] == "true")
+				r.CaseSensitive = &bv
+			case "color-map":
+				r.ColorMap = map[string]string{}
+
+				for _, c := range strings.Split(v[1], ";") {
+					cv := strings.SplitN(c, ":", 2)
+
+					if len(cv) == 2 {
+						r.ColorMap[cv[0]] = cv[1]
+					}
+				}
+			case "ordering":
+				r.Ordering = &v[1]
+			case "shape":
+				r.Shape = &v[1]
+			}
+		}
+	}
+
+	return nil
+}
@@ -64,6 +64,39 @@ func (c *VirtualEnvironmentClient) RebootContainer(nodeName string, vmID int, d
 	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/lxc/%d/status/reboot", url.PathEscape(nodeName), vmID), d, nil)
 }
 
+// RestoreContainer restores a container from a backup.
+func (c *VirtualEnvironmentClient) RestoreContainer(nodeName string, timeout int, d *VirtualEnvironmentContainerCreateRequestBody) error {
+	taskID, err := c.RestoreContainerAsync(nodeName, d)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.WaitForNodeTask(nodeName, *taskID, timeout, 5)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreContainerAsync restores a container from a backup asynchronously.
+func (c *VirtualEnvironmentClient) RestoreContainerAsync(nodeName string, d *VirtualEnvironmentContainerCreateRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentContainerRestoreResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/lxc", url.PathEscape(nodeName)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
 // ShutdownContainer shuts down a container.
 func (c *VirtualEnvironmentClient) ShutdownContainer(nodeName string, vmID int, d *VirtualEnvironmentContainerShutdownRequestBody) error {
 	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/lxc/%d/status/shutdown", url.PathEscape(nodeName), vmID), d, nil)
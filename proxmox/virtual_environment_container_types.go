@@ -208,6 +208,11 @@ type VirtualEnvironmentContainerRebootRequestBody struct {
 	Timeout *int `json:"timeout,omitempty" url:"timeout,omitempty"`
 }
 
+// VirtualEnvironmentContainerRestoreResponseBody contains the body from a container restore response.
+type VirtualEnvironmentContainerRestoreResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
 // VirtualEnvironmentContainerShutdownRequestBody contains the body for a container shutdown request.
 type VirtualEnvironmentContainerShutdownRequestBody struct {
 	ForceStop *CustomBool `json:"forceStop,omitempty,int" url:"forceStop,omitempty,int"`
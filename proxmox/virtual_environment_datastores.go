@@ -5,6 +5,7 @@
 package proxmox
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -29,10 +30,113 @@ func (c *VirtualEnvironmentClient) DeleteDatastoreFile(nodeName, datastoreID, vo
 	return nil
 }
 
+// DownloadFileToDatastore makes a node download a file from a URL to a datastore.
+func (c *VirtualEnvironmentClient) DownloadFileToDatastore(nodeName, datastoreID string, timeout int, d *VirtualEnvironmentDatastoreDownloadURLRequestBody) error {
+	taskID, err := c.DownloadFileToDatastoreAsync(nodeName, datastoreID, d)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.WaitForNodeTask(nodeName, *taskID, timeout, 5)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DownloadFileToDatastoreAsync makes a node download a file from a URL to a datastore asynchronously.
+func (c *VirtualEnvironmentClient) DownloadFileToDatastoreAsync(nodeName, datastoreID string, d *VirtualEnvironmentDatastoreDownloadURLRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentDatastoreDownloadURLResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/storage/%s/download-url", url.PathEscape(nodeName), url.PathEscape(datastoreID)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetDatastoreFile retrieves the attributes of a file in a datastore.
+func (c *VirtualEnvironmentClient) GetDatastoreFile(nodeName, datastoreID, volumeID string) (*VirtualEnvironmentDatastoreFileGetResponseData, error) {
+	resBody := &VirtualEnvironmentDatastoreFileGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/storage/%s/content/%s", url.PathEscape(nodeName), url.PathEscape(datastoreID), url.PathEscape(volumeID)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetDatastoreFileChecksum calculates the checksum of a file in a datastore.
+func (c *VirtualEnvironmentClient) GetDatastoreFileChecksum(nodeName, datastoreID, volumeID, algorithm string) (*string, error) {
+	switch algorithm {
+	case "md5", "sha1", "sha224", "sha256", "sha384", "sha512":
+	default:
+		return nil, fmt.Errorf("Unsupported checksum algorithm \"%s\"", algorithm)
+	}
+
+	file, err := c.GetDatastoreFile(nodeName, datastoreID, volumeID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Path == nil || *file.Path == "" {
+		return nil, fmt.Errorf("Failed to determine the path of volume \"%s\"", volumeID)
+	}
+
+	// The API does not expose file checksums, which is why we need to calculate them using SSH.
+	sshClient, err := c.OpenNodeShell(nodeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sshClient.Close()
+
+	sshSession, err := sshClient.NewSession()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sshSession.Close()
+
+	output, err := sshSession.CombinedOutput(
+		fmt.Sprintf("%ssum -- '%s'", algorithm, strings.ReplaceAll(*file.Path, "'", "'\"'\"'")),
+	)
+
+	if err != nil {
+		return nil, errors.New(string(output))
+	}
+
+	outputFields := strings.Fields(string(output))
+
+	if len(outputFields) == 0 {
+		return nil, fmt.Errorf("Failed to calculate the checksum of volume \"%s\"", volumeID)
+	}
+
+	checksum := strings.ToLower(outputFields[0])
+
+	return &checksum, nil
+}
+
 // ListDatastoreFiles retrieves a list of the files in a datastore.
-func (c *VirtualEnvironmentClient) ListDatastoreFiles(nodeName, datastoreID string) ([]*VirtualEnvironmentDatastoreFileListResponseData, error) {
+func (c *VirtualEnvironmentClient) ListDatastoreFiles(nodeName, datastoreID string, d *VirtualEnvironmentDatastoreFileListRequestBody) ([]*VirtualEnvironmentDatastoreFileListResponseData, error) {
 	resBody := &VirtualEnvironmentDatastoreFileListResponseBody{}
-	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/storage/%s/content", url.PathEscape(nodeName), url.PathEscape(datastoreID)), nil, resBody)
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/storage/%s/content", url.PathEscape(nodeName), url.PathEscape(datastoreID)), d, resBody)
 
 	if err != nil {
 		return nil, err
@@ -73,76 +177,143 @@ func (c *VirtualEnvironmentClient) ListDatastores(nodeName string, d *VirtualEnv
 func (c *VirtualEnvironmentClient) UploadFileToDatastore(d *VirtualEnvironmentDatastoreUploadRequestBody) (*VirtualEnvironmentDatastoreUploadResponseBody, error) {
 	switch d.ContentType {
 	case "iso", "vztmpl":
-		r, w := io.Pipe()
+		var reqBody *VirtualEnvironmentMultiPartData
 
-		defer r.Close()
+		if d.FileSize != nil {
+			// The size of the multipart payload can be calculated up front, when the size of the file is known,
+			// which allows us to stream the file without support for chunked transfers in Proxmox VE v6.1 and earlier.
+			var buf bytes.Buffer
 
-		m := multipart.NewWriter(w)
+			m := multipart.NewWriter(&buf)
 
-		go func() {
-			defer w.Close()
-			defer m.Close()
+			err := m.WriteField("content", d.ContentType)
 
-			m.WriteField("content", d.ContentType)
+			if err != nil {
+				return nil, err
+			}
 
-			part, err := m.CreateFormFile("filename", d.FileName)
+			_, err = m.CreateFormFile("filename", d.FileName)
 
 			if err != nil {
-				return
+				return nil, err
 			}
 
-			_, err = io.Copy(part, d.FileReader)
+			header := make([]byte, buf.Len())
+			copy(header, buf.Bytes())
+			buf.Reset()
+
+			err = m.Close()
 
 			if err != nil {
-				return
+				return nil, err
 			}
-		}()
 
-		// We need to store the multipart content in a temporary file to avoid using high amounts of memory.
-		// This is necessary due to Proxmox VE not supporting chunked transfers in v6.1 and earlier versions.
-		tempMultipartFile, err := ioutil.TempFile("", "multipart")
+			trailer := buf.Bytes()
+			size := int64(len(header)) + *d.FileSize + int64(len(trailer))
+
+			reqBody = &VirtualEnvironmentMultiPartData{
+				Boundary: m.Boundary(),
+				Reader: io.MultiReader(
+					bytes.NewReader(header),
+					&virtualEnvironmentProgressReader{
+						FileName: d.FileName,
+						Reader:   io.LimitReader(d.FileReader, *d.FileSize),
+						Size:     *d.FileSize,
+					},
+					bytes.NewReader(trailer),
+				),
+				Size: &size,
+			}
+		} else {
+			r, w := io.Pipe()
 
-		if err != nil {
-			return nil, err
-		}
+			defer r.Close()
 
-		tempMultipartFileName := tempMultipartFile.Name()
+			m := multipart.NewWriter(w)
 
-		io.Copy(tempMultipartFile, r)
+			// Any error must be passed on to the reader, as the temporary file would otherwise contain a truncated payload.
+			go func() {
+				err := m.WriteField("content", d.ContentType)
 
-		err = tempMultipartFile.Close()
+				if err != nil {
+					w.CloseWithError(err)
+					return
+				}
 
-		if err != nil {
-			return nil, err
-		}
+				part, err := m.CreateFormFile("filename", d.FileName)
 
-		defer os.Remove(tempMultipartFileName)
+				if err != nil {
+					w.CloseWithError(err)
+					return
+				}
 
-		// Now that the multipart data is stored in a file, we can go ahead and do a HTTP POST request.
-		fileReader, err := os.Open(tempMultipartFileName)
+				_, err = io.Copy(part, d.FileReader)
 
-		if err != nil {
-			return nil, err
-		}
+				if err != nil {
+					w.CloseWithError(err)
+					return
+				}
 
-		defer fileReader.Close()
+				w.CloseWithError(m.Close())
+			}()
 
-		fileInfo, err := fileReader.Stat()
+			// We need to store the multipart content in a temporary file, when the size of the file is unknown,
+			// in order to determine the content length. This is necessary due to Proxmox VE not supporting
+			// chunked transfers in v6.1 and earlier versions.
+			tempMultipartFile, err := ioutil.TempFile("", "multipart")
 
-		if err != nil {
-			return nil, err
-		}
+			if err != nil {
+				return nil, err
+			}
+
+			tempMultipartFileName := tempMultipartFile.Name()
 
-		fileSize := fileInfo.Size()
+			defer os.Remove(tempMultipartFileName)
 
-		reqBody := &VirtualEnvironmentMultiPartData{
-			Boundary: m.Boundary(),
-			Reader:   fileReader,
-			Size:     &fileSize,
+			_, err = io.Copy(tempMultipartFile, r)
+
+			if err != nil {
+				tempMultipartFile.Close()
+
+				return nil, err
+			}
+
+			err = tempMultipartFile.Close()
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Now that the multipart data is stored in a file, we can go ahead and do a HTTP POST request.
+			fileReader, err := os.Open(tempMultipartFileName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			defer fileReader.Close()
+
+			fileInfo, err := fileReader.Stat()
+
+			if err != nil {
+				return nil, err
+			}
+
+			fileSize := fileInfo.Size()
+
+			reqBody = &VirtualEnvironmentMultiPartData{
+				Boundary: m.Boundary(),
+				Reader: &virtualEnvironmentProgressReader{
+					FileName: d.FileName,
+					Reader:   fileReader,
+					Size:     fileSize,
+				},
+				Size: &fileSize,
+			}
 		}
 
 		resBody := &VirtualEnvironmentDatastoreUploadResponseBody{}
-		err = c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/storage/%s/upload", url.PathEscape(d.NodeName), url.PathEscape(d.DatastoreID)), reqBody, resBody)
+		err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/storage/%s/upload", url.PathEscape(d.NodeName), url.PathEscape(d.DatastoreID)), reqBody, resBody)
 
 		if err != nil {
 			return nil, err
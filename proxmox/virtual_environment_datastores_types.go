@@ -6,8 +6,49 @@ package proxmox
 
 import (
 	"io"
+	"log"
+	"time"
 )
 
+const (
+	virtualEnvironmentProgressReaderInterval = 10 * time.Second
+)
+
+// VirtualEnvironmentDatastoreDownloadURLRequestBody contains the body for a datastore download URL request.
+type VirtualEnvironmentDatastoreDownloadURLRequestBody struct {
+	Checksum           *string     `json:"checksum,omitempty" url:"checksum,omitempty"`
+	ChecksumAlgorithm  *string     `json:"checksum-algorithm,omitempty" url:"checksum-algorithm,omitempty"`
+	Compression        *string     `json:"compression,omitempty" url:"compression,omitempty"`
+	ContentType        string      `json:"content" url:"content"`
+	FileName           string      `json:"filename" url:"filename"`
+	URL                string      `json:"url" url:"url"`
+	VerifyCertificates *CustomBool `json:"verify-certificates,omitempty" url:"verify-certificates,omitempty,int"`
+}
+
+// VirtualEnvironmentDatastoreDownloadURLResponseBody contains the body from a datastore download URL response.
+type VirtualEnvironmentDatastoreDownloadURLResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentDatastoreFileGetResponseBody contains the body from a datastore content get response.
+type VirtualEnvironmentDatastoreFileGetResponseBody struct {
+	Data *VirtualEnvironmentDatastoreFileGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentDatastoreFileGetResponseData contains the data from a datastore content get response.
+type VirtualEnvironmentDatastoreFileGetResponseData struct {
+	FileFormat *string `json:"format,omitempty"`
+	FileSize   *int    `json:"size,omitempty"`
+	Path       *string `json:"path,omitempty"`
+	SpaceUsed  *int    `json:"used,omitempty"`
+}
+
+// VirtualEnvironmentDatastoreFileListRequestBody contains the body for a datastore content list request.
+type VirtualEnvironmentDatastoreFileListRequestBody struct {
+	ContentType *string `json:"content,omitempty" url:"content,omitempty"`
+	VMID        *int    `json:"vmid,omitempty" url:"vmid,omitempty"`
+}
+
 // VirtualEnvironmentDatastoreFileListResponseBody contains the body from a datastore content list response.
 type VirtualEnvironmentDatastoreFileListResponseBody struct {
 	Data []*VirtualEnvironmentDatastoreFileListResponseData `json:"data,omitempty"`
@@ -15,13 +56,16 @@ type VirtualEnvironmentDatastoreFileListResponseBody struct {
 
 // VirtualEnvironmentDatastoreFileListResponseData contains the data from a datastore content list response.
 type VirtualEnvironmentDatastoreFileListResponseData struct {
-	ContentType    string  `json:"content"`
-	FileFormat     string  `json:"format"`
-	FileSize       int     `json:"size"`
-	ParentVolumeID *string `json:"parent,omitempty"`
-	SpaceUsed      *int    `json:"used,omitempty"`
-	VMID           *int    `json:"vmid,omitempty"`
-	VolumeID       string  `json:"volid"`
+	ContentType    string           `json:"content"`
+	CreationTime   *CustomTimestamp `json:"ctime,omitempty"`
+	FileFormat     string           `json:"format"`
+	FileSize       int              `json:"size"`
+	Notes          *string          `json:"notes,omitempty"`
+	ParentVolumeID *string          `json:"parent,omitempty"`
+	Protected      *CustomBool      `json:"protected,omitempty"`
+	SpaceUsed      *int             `json:"used,omitempty"`
+	VMID           *int             `json:"vmid,omitempty"`
+	VolumeID       string           `json:"volid"`
 }
 
 // VirtualEnvironmentDatastoreListRequestBody contains the body for a datastore list request.
@@ -58,6 +102,7 @@ type VirtualEnvironmentDatastoreUploadRequestBody struct {
 	DatastoreID string    `json:"storage,omitempty"`
 	FileName    string    `json:"filename,omitempty"`
 	FileReader  io.Reader `json:"-"`
+	FileSize    *int64    `json:"-"`
 	NodeName    string    `json:"node,omitempty"`
 }
 
@@ -65,3 +110,35 @@ type VirtualEnvironmentDatastoreUploadRequestBody struct {
 type VirtualEnvironmentDatastoreUploadResponseBody struct {
 	UploadID *string `json:"data,omitempty"`
 }
+
+// virtualEnvironmentProgressReader reports the progress of an upload in the debug logs.
+type virtualEnvironmentProgressReader struct {
+	FileName string
+	Reader   io.Reader
+	Size     int64
+
+	bytesRead  int64
+	lastReport time.Time
+}
+
+// Read reads from the underlying reader and reports the progress at regular intervals.
+func (r *virtualEnvironmentProgressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.bytesRead += int64(n)
+
+	if r.lastReport.IsZero() {
+		r.lastReport = time.Now()
+	}
+
+	if err == io.EOF || time.Since(r.lastReport) >= virtualEnvironmentProgressReaderInterval {
+		r.lastReport = time.Now()
+
+		if r.Size > 0 {
+			log.Printf("[DEBUG] Uploaded %d of %d bytes (%.1f%%) of file \"%s\"", r.bytesRead, r.Size, float64(r.bytesRead)*100/float64(r.Size), r.FileName)
+		} else {
+			log.Printf("[DEBUG] Uploaded %d bytes of file \"%s\"", r.bytesRead, r.FileName)
+		}
+	}
+
+	return n, err
+}
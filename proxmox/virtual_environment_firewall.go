@@ -0,0 +1,244 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// GetClusterFirewallPath returns the base path of the cluster firewall.
+func GetClusterFirewallPath() string {
+	return "cluster/firewall"
+}
+
+// GetContainerFirewallPath returns the base path of a container's firewall.
+func GetContainerFirewallPath(nodeName string, vmID int) string {
+	return fmt.Sprintf("nodes/%s/lxc/%d/firewall", url.PathEscape(nodeName), vmID)
+}
+
+// GetFirewallRulesPath returns the path of the rules for a firewall base path.
+func GetFirewallRulesPath(basePath string) string {
+	return fmt.Sprintf("%s/rules", basePath)
+}
+
+// GetFirewallSecurityGroupRulesPath returns the path of the rules in a security group.
+func GetFirewallSecurityGroupRulesPath(name string) string {
+	return fmt.Sprintf("cluster/firewall/groups/%s", url.PathEscape(name))
+}
+
+// GetNodeFirewallPath returns the base path of a node's firewall.
+func GetNodeFirewallPath(nodeName string) string {
+	return fmt.Sprintf("nodes/%s/firewall", url.PathEscape(nodeName))
+}
+
+// GetVMFirewallPath returns the base path of a virtual machine's firewall.
+func GetVMFirewallPath(nodeName string, vmID int) string {
+	return fmt.Sprintf("nodes/%s/qemu/%d/firewall", url.PathEscape(nodeName), vmID)
+}
+
+// CreateFirewallAlias creates a firewall alias.
+func (c *VirtualEnvironmentClient) CreateFirewallAlias(basePath string, d *VirtualEnvironmentFirewallAliasCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("%s/aliases", basePath), d, nil)
+}
+
+// CreateFirewallGroup creates a firewall security group.
+func (c *VirtualEnvironmentClient) CreateFirewallGroup(d *VirtualEnvironmentFirewallGroupCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/firewall/groups", d, nil)
+}
+
+// CreateFirewallIPSet creates a firewall IP set.
+func (c *VirtualEnvironmentClient) CreateFirewallIPSet(basePath string, d *VirtualEnvironmentFirewallIPSetCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("%s/ipset", basePath), d, nil)
+}
+
+// CreateFirewallIPSetEntry adds an entry to a firewall IP set.
+func (c *VirtualEnvironmentClient) CreateFirewallIPSetEntry(basePath, name string, d *VirtualEnvironmentFirewallIPSetEntryCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("%s/ipset/%s", basePath, url.PathEscape(name)), d, nil)
+}
+
+// CreateFirewallRule creates a firewall rule at the top of the list.
+func (c *VirtualEnvironmentClient) CreateFirewallRule(rulesPath string, d *VirtualEnvironmentFirewallRuleCreateRequestBody) error {
+	return c.DoRequest(hmPOST, rulesPath, d, nil)
+}
+
+// DeleteFirewallAlias deletes a firewall alias.
+func (c *VirtualEnvironmentClient) DeleteFirewallAlias(basePath, name string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("%s/aliases/%s", basePath, url.PathEscape(name)), nil, nil)
+}
+
+// DeleteFirewallGroup deletes a firewall security group.
+func (c *VirtualEnvironmentClient) DeleteFirewallGroup(name string) error {
+	return c.DoRequest(hmDELETE, GetFirewallSecurityGroupRulesPath(name), nil, nil)
+}
+
+// DeleteFirewallIPSet deletes a firewall IP set.
+func (c *VirtualEnvironmentClient) DeleteFirewallIPSet(basePath, name string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("%s/ipset/%s", basePath, url.PathEscape(name)), nil, nil)
+}
+
+// DeleteFirewallIPSetEntry removes an entry from a firewall IP set.
+func (c *VirtualEnvironmentClient) DeleteFirewallIPSetEntry(basePath, name, cidr string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("%s/ipset/%s/%s", basePath, url.PathEscape(name), url.PathEscape(cidr)), nil, nil)
+}
+
+// DeleteFirewallRule deletes a firewall rule.
+func (c *VirtualEnvironmentClient) DeleteFirewallRule(rulesPath string, position int) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("%s/%d", rulesPath, position), nil, nil)
+}
+
+// GetFirewallAlias retrieves a firewall alias.
+func (c *VirtualEnvironmentClient) GetFirewallAlias(basePath, name string) (*VirtualEnvironmentFirewallAliasGetResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallAliasGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("%s/aliases/%s", basePath, url.PathEscape(name)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetFirewallOptions retrieves the firewall options.
+func (c *VirtualEnvironmentClient) GetFirewallOptions(basePath string) (*VirtualEnvironmentFirewallOptionsGetResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallOptionsGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("%s/options", basePath), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListFirewallAliases retrieves a list of firewall aliases.
+func (c *VirtualEnvironmentClient) ListFirewallAliases(basePath string) ([]*VirtualEnvironmentFirewallAliasGetResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallAliasListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("%s/aliases", basePath), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Name < resBody.Data[j].Name
+	})
+
+	return resBody.Data, nil
+}
+
+// ListFirewallGroups retrieves a list of firewall security groups.
+func (c *VirtualEnvironmentClient) ListFirewallGroups() ([]*VirtualEnvironmentFirewallGroupListResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallGroupListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/firewall/groups", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Name < resBody.Data[j].Name
+	})
+
+	return resBody.Data, nil
+}
+
+// ListFirewallIPSetEntries retrieves the entries of a firewall IP set.
+func (c *VirtualEnvironmentClient) ListFirewallIPSetEntries(basePath, name string) ([]*VirtualEnvironmentFirewallIPSetEntryListResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallIPSetEntryListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("%s/ipset/%s", basePath, url.PathEscape(name)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].CIDR < resBody.Data[j].CIDR
+	})
+
+	return resBody.Data, nil
+}
+
+// ListFirewallIPSets retrieves a list of firewall IP sets.
+func (c *VirtualEnvironmentClient) ListFirewallIPSets(basePath string) ([]*VirtualEnvironmentFirewallIPSetListResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallIPSetListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("%s/ipset", basePath), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Name < resBody.Data[j].Name
+	})
+
+	return resBody.Data, nil
+}
+
+// ListFirewallRules retrieves a list of firewall rules ordered by their position.
+func (c *VirtualEnvironmentClient) ListFirewallRules(rulesPath string) ([]*VirtualEnvironmentFirewallRuleListResponseData, error) {
+	resBody := &VirtualEnvironmentFirewallRuleListResponseBody{}
+	err := c.DoRequest(hmGET, rulesPath, nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Position < resBody.Data[j].Position
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateFirewallAlias updates a firewall alias.
+func (c *VirtualEnvironmentClient) UpdateFirewallAlias(basePath, name string, d *VirtualEnvironmentFirewallAliasUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("%s/aliases/%s", basePath, url.PathEscape(name)), d, nil)
+}
+
+// UpdateFirewallIPSetEntry updates an entry in a firewall IP set.
+func (c *VirtualEnvironmentClient) UpdateFirewallIPSetEntry(basePath, name, cidr string, d *VirtualEnvironmentFirewallIPSetEntryUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("%s/ipset/%s/%s", basePath, url.PathEscape(name), url.PathEscape(cidr)), d, nil)
+}
+
+// UpdateFirewallOptions updates the firewall options.
+func (c *VirtualEnvironmentClient) UpdateFirewallOptions(basePath string, d *VirtualEnvironmentFirewallOptionsUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("%s/options", basePath), d, nil)
+}
+
+// UpdateFirewallRule updates a firewall rule.
+func (c *VirtualEnvironmentClient) UpdateFirewallRule(rulesPath string, position int, d *VirtualEnvironmentFirewallRuleUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("%s/%d", rulesPath, position), d, nil)
+}
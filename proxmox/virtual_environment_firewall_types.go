@@ -0,0 +1,192 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentFirewallAliasCreateRequestBody contains the data for a firewall alias create request.
+type VirtualEnvironmentFirewallAliasCreateRequestBody struct {
+	CIDR    string  `json:"cidr" url:"cidr"`
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Name    string  `json:"name" url:"name"`
+}
+
+// VirtualEnvironmentFirewallAliasGetResponseBody contains the body from a firewall alias get response.
+type VirtualEnvironmentFirewallAliasGetResponseBody struct {
+	Data *VirtualEnvironmentFirewallAliasGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallAliasGetResponseData contains the data from a firewall alias get response.
+type VirtualEnvironmentFirewallAliasGetResponseData struct {
+	CIDR    string  `json:"cidr"`
+	Comment *string `json:"comment,omitempty"`
+	Name    string  `json:"name"`
+}
+
+// VirtualEnvironmentFirewallAliasListResponseBody contains the body from a firewall alias list response.
+type VirtualEnvironmentFirewallAliasListResponseBody struct {
+	Data []*VirtualEnvironmentFirewallAliasGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallAliasUpdateRequestBody contains the data for a firewall alias update request.
+type VirtualEnvironmentFirewallAliasUpdateRequestBody struct {
+	CIDR    string  `json:"cidr" url:"cidr"`
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Rename  *string `json:"rename,omitempty" url:"rename,omitempty"`
+}
+
+// VirtualEnvironmentFirewallGroupCreateRequestBody contains the data for a firewall security group create request.
+type VirtualEnvironmentFirewallGroupCreateRequestBody struct {
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Name    string  `json:"group" url:"group"`
+	Rename  *string `json:"rename,omitempty" url:"rename,omitempty"`
+}
+
+// VirtualEnvironmentFirewallGroupListResponseBody contains the body from a firewall security group list response.
+type VirtualEnvironmentFirewallGroupListResponseBody struct {
+	Data []*VirtualEnvironmentFirewallGroupListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallGroupListResponseData contains the data from a firewall security group list response.
+type VirtualEnvironmentFirewallGroupListResponseData struct {
+	Comment *string `json:"comment,omitempty"`
+	Name    string  `json:"group"`
+}
+
+// VirtualEnvironmentFirewallIPSetCreateRequestBody contains the data for a firewall IP set create request.
+type VirtualEnvironmentFirewallIPSetCreateRequestBody struct {
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Name    string  `json:"name" url:"name"`
+	Rename  *string `json:"rename,omitempty" url:"rename,omitempty"`
+}
+
+// VirtualEnvironmentFirewallIPSetEntryCreateRequestBody contains the data for a firewall IP set entry create request.
+type VirtualEnvironmentFirewallIPSetEntryCreateRequestBody struct {
+	CIDR    string      `json:"cidr" url:"cidr"`
+	Comment *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	NoMatch *CustomBool `json:"nomatch,omitempty" url:"nomatch,omitempty,int"`
+}
+
+// VirtualEnvironmentFirewallIPSetEntryListResponseBody contains the body from a firewall IP set entry list response.
+type VirtualEnvironmentFirewallIPSetEntryListResponseBody struct {
+	Data []*VirtualEnvironmentFirewallIPSetEntryListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallIPSetEntryListResponseData contains the data from a firewall IP set entry list response.
+type VirtualEnvironmentFirewallIPSetEntryListResponseData struct {
+	CIDR    string      `json:"cidr"`
+	Comment *string     `json:"comment,omitempty"`
+	NoMatch *CustomBool `json:"nomatch,omitempty"`
+}
+
+// VirtualEnvironmentFirewallIPSetEntryUpdateRequestBody contains the data for a firewall IP set entry update request.
+type VirtualEnvironmentFirewallIPSetEntryUpdateRequestBody struct {
+	Comment *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	NoMatch *CustomBool `json:"nomatch,omitempty" url:"nomatch,omitempty,int"`
+}
+
+// VirtualEnvironmentFirewallIPSetListResponseBody contains the body from a firewall IP set list response.
+type VirtualEnvironmentFirewallIPSetListResponseBody struct {
+	Data []*VirtualEnvironmentFirewallIPSetListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallIPSetListResponseData contains the data from a firewall IP set list response.
+type VirtualEnvironmentFirewallIPSetListResponseData struct {
+	Comment *string `json:"comment,omitempty"`
+	Name    string  `json:"name"`
+}
+
+// VirtualEnvironmentFirewallOptionsGetResponseBody contains the body from a firewall options get response.
+type VirtualEnvironmentFirewallOptionsGetResponseBody struct {
+	Data *VirtualEnvironmentFirewallOptionsGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallOptionsGetResponseData contains the data from a firewall options get response.
+type VirtualEnvironmentFirewallOptionsGetResponseData struct {
+	DHCP                *CustomBool `json:"dhcp,omitempty"`
+	EBTables            *CustomBool `json:"ebtables,omitempty"`
+	Enabled             *CustomBool `json:"enable,omitempty"`
+	IPFilter            *CustomBool `json:"ipfilter,omitempty"`
+	LogLevelIn          *string     `json:"log_level_in,omitempty"`
+	LogLevelOut         *string     `json:"log_level_out,omitempty"`
+	MACFilter           *CustomBool `json:"macfilter,omitempty"`
+	NDP                 *CustomBool `json:"ndp,omitempty"`
+	NoSMURFs            *CustomBool `json:"nosmurfs,omitempty"`
+	PolicyIn            *string     `json:"policy_in,omitempty"`
+	PolicyOut           *string     `json:"policy_out,omitempty"`
+	RouterAdvertisement *CustomBool `json:"radv,omitempty"`
+	TCPFlags            *CustomBool `json:"tcpflags,omitempty"`
+}
+
+// VirtualEnvironmentFirewallOptionsUpdateRequestBody contains the data for a firewall options update request.
+type VirtualEnvironmentFirewallOptionsUpdateRequestBody struct {
+	Delete              []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	DHCP                *CustomBool `json:"dhcp,omitempty" url:"dhcp,omitempty,int"`
+	EBTables            *CustomBool `json:"ebtables,omitempty" url:"ebtables,omitempty,int"`
+	Enabled             *CustomBool `json:"enable,omitempty" url:"enable,omitempty,int"`
+	IPFilter            *CustomBool `json:"ipfilter,omitempty" url:"ipfilter,omitempty,int"`
+	LogLevelIn          *string     `json:"log_level_in,omitempty" url:"log_level_in,omitempty"`
+	LogLevelOut         *string     `json:"log_level_out,omitempty" url:"log_level_out,omitempty"`
+	MACFilter           *CustomBool `json:"macfilter,omitempty" url:"macfilter,omitempty,int"`
+	NDP                 *CustomBool `json:"ndp,omitempty" url:"ndp,omitempty,int"`
+	NoSMURFs            *CustomBool `json:"nosmurfs,omitempty" url:"nosmurfs,omitempty,int"`
+	PolicyIn            *string     `json:"policy_in,omitempty" url:"policy_in,omitempty"`
+	PolicyOut           *string     `json:"policy_out,omitempty" url:"policy_out,omitempty"`
+	RouterAdvertisement *CustomBool `json:"radv,omitempty" url:"radv,omitempty,int"`
+	TCPFlags            *CustomBool `json:"tcpflags,omitempty" url:"tcpflags,omitempty,int"`
+}
+
+// VirtualEnvironmentFirewallRuleCreateRequestBody contains the data for a firewall rule create request.
+type VirtualEnvironmentFirewallRuleCreateRequestBody struct {
+	Action          string      `json:"action" url:"action"`
+	Comment         *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Destination     *string     `json:"dest,omitempty" url:"dest,omitempty"`
+	DestinationPort *string     `json:"dport,omitempty" url:"dport,omitempty"`
+	Enabled         *CustomBool `json:"enable,omitempty" url:"enable,omitempty,int"`
+	Interface       *string     `json:"iface,omitempty" url:"iface,omitempty"`
+	Log             *string     `json:"log,omitempty" url:"log,omitempty"`
+	Macro           *string     `json:"macro,omitempty" url:"macro,omitempty"`
+	Protocol        *string     `json:"proto,omitempty" url:"proto,omitempty"`
+	Source          *string     `json:"source,omitempty" url:"source,omitempty"`
+	SourcePort      *string     `json:"sport,omitempty" url:"sport,omitempty"`
+	Type            string      `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentFirewallRuleListResponseBody contains the body from a firewall rule list response.
+type VirtualEnvironmentFirewallRuleListResponseBody struct {
+	Data []*VirtualEnvironmentFirewallRuleListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentFirewallRuleListResponseData contains the data from a firewall rule list response.
+type VirtualEnvironmentFirewallRuleListResponseData struct {
+	Action          string      `json:"action"`
+	Comment         *string     `json:"comment,omitempty"`
+	Destination     *string     `json:"dest,omitempty"`
+	DestinationPort *string     `json:"dport,omitempty"`
+	Enabled         *CustomBool `json:"enable,omitempty"`
+	Interface       *string     `json:"iface,omitempty"`
+	Log             *string     `json:"log,omitempty"`
+	Macro           *string     `json:"macro,omitempty"`
+	Position        int         `json:"pos"`
+	Protocol        *string     `json:"proto,omitempty"`
+	Source          *string     `json:"source,omitempty"`
+	SourcePort      *string     `json:"sport,omitempty"`
+	Type            string      `json:"type"`
+}
+
+// VirtualEnvironmentFirewallRuleUpdateRequestBody contains the data for a firewall rule update request.
+type VirtualEnvironmentFirewallRuleUpdateRequestBody struct {
+	Action          string      `json:"action" url:"action"`
+	Comment         *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Delete          []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Destination     *string     `json:"dest,omitempty" url:"dest,omitempty"`
+	DestinationPort *string     `json:"dport,omitempty" url:"dport,omitempty"`
+	Enabled         *CustomBool `json:"enable,omitempty" url:"enable,omitempty,int"`
+	Interface       *string     `json:"iface,omitempty" url:"iface,omitempty"`
+	Log             *string     `json:"log,omitempty" url:"log,omitempty"`
+	Macro           *string     `json:"macro,omitempty" url:"macro,omitempty"`
+	Protocol        *string     `json:"proto,omitempty" url:"proto,omitempty"`
+	Source          *string     `json:"source,omitempty" url:"source,omitempty"`
+	SourcePort      *string     `json:"sport,omitempty" url:"sport,omitempty"`
+	Type            string      `json:"type" url:"type"`
+}
@@ -0,0 +1,114 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// CreateHAGroup creates an HA group.
+func (c *VirtualEnvironmentClient) CreateHAGroup(d *VirtualEnvironmentHAGroupCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/ha/groups", d, nil)
+}
+
+// CreateHAResource creates an HA resource.
+func (c *VirtualEnvironmentClient) CreateHAResource(d *VirtualEnvironmentHAResourceCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/ha/resources", d, nil)
+}
+
+// DeleteHAGroup deletes an HA group.
+func (c *VirtualEnvironmentClient) DeleteHAGroup(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/ha/groups/%s", url.PathEscape(id)), nil, nil)
+}
+
+// DeleteHAResource deletes an HA resource.
+func (c *VirtualEnvironmentClient) DeleteHAResource(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/ha/resources/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetHAGroup retrieves an HA group.
+func (c *VirtualEnvironmentClient) GetHAGroup(id string) (*VirtualEnvironmentHAGroupGetResponseData, error) {
+	resBody := &VirtualEnvironmentHAGroupGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/ha/groups/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetHAResource retrieves an HA resource.
+func (c *VirtualEnvironmentClient) GetHAResource(id string) (*VirtualEnvironmentHAResourceGetResponseData, error) {
+	resBody := &VirtualEnvironmentHAResourceGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/ha/resources/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListHAGroups retrieves a list of HA groups.
+func (c *VirtualEnvironmentClient) ListHAGroups() ([]*VirtualEnvironmentHAGroupGetResponseData, error) {
+	resBody := &VirtualEnvironmentHAGroupListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/ha/groups", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// ListHAResources retrieves a list of HA resources.
+func (c *VirtualEnvironmentClient) ListHAResources(d *VirtualEnvironmentHAResourceListRequestBody) ([]*VirtualEnvironmentHAResourceGetResponseData, error) {
+	resBody := &VirtualEnvironmentHAResourceListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/ha/resources", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateHAGroup updates an HA group.
+func (c *VirtualEnvironmentClient) UpdateHAGroup(id string, d *VirtualEnvironmentHAGroupUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/ha/groups/%s", url.PathEscape(id)), d, nil)
+}
+
+// UpdateHAResource updates an HA resource.
+func (c *VirtualEnvironmentClient) UpdateHAResource(id string, d *VirtualEnvironmentHAResourceUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/ha/resources/%s", url.PathEscape(id)), d, nil)
+}
@@ -0,0 +1,90 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentHAGroupCreateRequestBody contains the data for an HA group create request.
+type VirtualEnvironmentHAGroupCreateRequestBody struct {
+	Comment    *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	ID         string      `json:"group" url:"group"`
+	NoFailback *CustomBool `json:"nofailback,omitempty" url:"nofailback,omitempty,int"`
+	Nodes      string      `json:"nodes" url:"nodes"`
+	Restricted *CustomBool `json:"restricted,omitempty" url:"restricted,omitempty,int"`
+	Type       string      `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentHAGroupGetResponseBody contains the body from an HA group get response.
+type VirtualEnvironmentHAGroupGetResponseBody struct {
+	Data *VirtualEnvironmentHAGroupGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentHAGroupGetResponseData contains the data from an HA group get response.
+type VirtualEnvironmentHAGroupGetResponseData struct {
+	Comment    *string     `json:"comment,omitempty"`
+	ID         string      `json:"group"`
+	NoFailback *CustomBool `json:"nofailback,omitempty"`
+	Nodes      string      `json:"nodes"`
+	Restricted *CustomBool `json:"restricted,omitempty"`
+	Type       string      `json:"type"`
+}
+
+// VirtualEnvironmentHAGroupListResponseBody contains the body from an HA group list response.
+type VirtualEnvironmentHAGroupListResponseBody struct {
+	Data []*VirtualEnvironmentHAGroupGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentHAGroupUpdateRequestBody contains the data for an HA group update request.
+type VirtualEnvironmentHAGroupUpdateRequestBody struct {
+	Comment    *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Delete     []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	NoFailback *CustomBool `json:"nofailback,omitempty" url:"nofailback,omitempty,int"`
+	Nodes      string      `json:"nodes" url:"nodes"`
+	Restricted *CustomBool `json:"restricted,omitempty" url:"restricted,omitempty,int"`
+}
+
+// VirtualEnvironmentHAResourceCreateRequestBody contains the data for an HA resource create request.
+type VirtualEnvironmentHAResourceCreateRequestBody struct {
+	Comment     *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Group       *string `json:"group,omitempty" url:"group,omitempty"`
+	ID          string  `json:"sid" url:"sid"`
+	MaxRelocate *int    `json:"max_relocate,omitempty" url:"max_relocate,omitempty"`
+	MaxRestart  *int    `json:"max_restart,omitempty" url:"max_restart,omitempty"`
+	State       *string `json:"state,omitempty" url:"state,omitempty"`
+}
+
+// VirtualEnvironmentHAResourceGetResponseBody contains the body from an HA resource get response.
+type VirtualEnvironmentHAResourceGetResponseBody struct {
+	Data *VirtualEnvironmentHAResourceGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentHAResourceGetResponseData contains the data from an HA resource get response.
+type VirtualEnvironmentHAResourceGetResponseData struct {
+	Comment     *string    `json:"comment,omitempty"`
+	Group       *string    `json:"group,omitempty"`
+	ID          string     `json:"sid"`
+	MaxRelocate *CustomInt `json:"max_relocate,omitempty"`
+	MaxRestart  *CustomInt `json:"max_restart,omitempty"`
+	State       *string    `json:"state,omitempty"`
+	Type        string     `json:"type"`
+}
+
+// VirtualEnvironmentHAResourceListRequestBody contains the data for an HA resource list request.
+type VirtualEnvironmentHAResourceListRequestBody struct {
+	Type *string `json:"type,omitempty" url:"type,omitempty"`
+}
+
+// VirtualEnvironmentHAResourceListResponseBody contains the body from an HA resource list response.
+type VirtualEnvironmentHAResourceListResponseBody struct {
+	Data []*VirtualEnvironmentHAResourceGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentHAResourceUpdateRequestBody contains the data for an HA resource update request.
+type VirtualEnvironmentHAResourceUpdateRequestBody struct {
+	Comment     *string  `json:"comment,omitempty" url:"comment,omitempty"`
+	Delete      []string `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Group       *string  `json:"group,omitempty" url:"group,omitempty"`
+	MaxRelocate *int     `json:"max_relocate,omitempty" url:"max_relocate,omitempty"`
+	MaxRestart  *int     `json:"max_restart,omitempty" url:"max_restart,omitempty"`
+	State       *string  `json:"state,omitempty" url:"state,omitempty"`
+}
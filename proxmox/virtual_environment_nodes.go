@@ -15,6 +15,16 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
+// CreateNodeNetworkDevice creates a network device on a specific node.
+func (c *VirtualEnvironmentClient) CreateNodeNetworkDevice(nodeName string, d *VirtualEnvironmentNodeNetworkDeviceCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), d, nil)
+}
+
+// DeleteNodeNetworkDevice deletes a network device on a specific node.
+func (c *VirtualEnvironmentClient) DeleteNodeNetworkDevice(nodeName string, iface string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), nil, nil)
+}
+
 // ExecuteNodeCommands executes commands on a given node.
 func (c *VirtualEnvironmentClient) ExecuteNodeCommands(nodeName string, commands []string) error {
 	sshClient, err := c.OpenNodeShell(nodeName)
@@ -47,6 +57,22 @@ func (c *VirtualEnvironmentClient) ExecuteNodeCommands(nodeName string, commands
 	return nil
 }
 
+// GetNodeConfig retrieves the configuration for a node.
+func (c *VirtualEnvironmentClient) GetNodeConfig(nodeName string) (*VirtualEnvironmentNodeGetConfigResponseData, error) {
+	resBody := &VirtualEnvironmentNodeGetConfigResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/config", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
 // GetNodeIP retrieves the IP address of a node.
 func (c *VirtualEnvironmentClient) GetNodeIP(nodeName string) (*string, error) {
 	networkDevices, err := c.ListNodeNetworkDevices(nodeName)
@@ -73,6 +99,36 @@ func (c *VirtualEnvironmentClient) GetNodeIP(nodeName string) (*string, error) {
 	return &nodeAddressParts[0], nil
 }
 
+// GetNodeNetworkChanges retrieves the pending network configuration changes for a specific node.
+func (c *VirtualEnvironmentClient) GetNodeNetworkChanges(nodeName string) (*string, error) {
+	resBody := &VirtualEnvironmentNodeNetworkDeviceListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody.Changes, nil
+}
+
+// GetNodeNetworkDevice retrieves a network device on a specific node.
+func (c *VirtualEnvironmentClient) GetNodeNetworkDevice(nodeName string, iface string) (*VirtualEnvironmentNodeNetworkDeviceListResponseData, error) {
+	resBody := &VirtualEnvironmentNodeNetworkDeviceGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	resBody.Data.Iface = iface
+
+	return resBody.Data, nil
+}
+
 // GetNodeTime retrieves the time information for a node.
 func (c *VirtualEnvironmentClient) GetNodeTime(nodeName string) (*VirtualEnvironmentNodeGetTimeResponseData, error) {
 	resBody := &VirtualEnvironmentNodeGetTimeResponseBody{}
@@ -170,6 +226,48 @@ func (c *VirtualEnvironmentClient) OpenNodeShell(nodeName string) (*ssh.Client,
 	return sshClient, nil
 }
 
+// ReloadNodeNetwork applies the pending network configuration changes for a specific node.
+func (c *VirtualEnvironmentClient) ReloadNodeNetwork(nodeName string, timeout int) error {
+	upid, err := c.ReloadNodeNetworkAsync(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *upid, timeout, 5)
+}
+
+// ReloadNodeNetworkAsync applies the pending network configuration changes for a specific node asynchronously.
+func (c *VirtualEnvironmentClient) ReloadNodeNetworkAsync(nodeName string) (*string, error) {
+	resBody := &VirtualEnvironmentNodeReloadNetworkResponseBody{}
+	err := c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// RevertNodeNetworkChanges discards the pending network configuration changes for a specific node.
+func (c *VirtualEnvironmentClient) RevertNodeNetworkChanges(nodeName string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), nil, nil)
+}
+
+// UpdateNodeConfig updates the configuration for a node.
+func (c *VirtualEnvironmentClient) UpdateNodeConfig(nodeName string, d *VirtualEnvironmentNodeUpdateConfigRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/config", url.PathEscape(nodeName)), d, nil)
+}
+
+// UpdateNodeNetworkDevice updates a network device on a specific node.
+func (c *VirtualEnvironmentClient) UpdateNodeNetworkDevice(nodeName string, iface string, d *VirtualEnvironmentNodeNetworkDeviceUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), d, nil)
+}
+
 // UpdateNodeTime updates the time on a node.
 func (c *VirtualEnvironmentClient) UpdateNodeTime(nodeName string, d *VirtualEnvironmentNodeUpdateTimeRequestBody) error {
 	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/time", url.PathEscape(nodeName)), d, nil)
@@ -207,3 +305,14 @@ func (c *VirtualEnvironmentClient) WaitForNodeTask(nodeName string, upid string,
 
 	return fmt.Errorf("Timeout while waiting for task \"%s\" on node \"%s\" to complete", upid, nodeName)
 }
+
+// waitForTask waits for a task to complete on the node, which is specified in the task identifier (UPID:node:...).
+func (c *VirtualEnvironmentClient) waitForTask(upid string, timeout int) error {
+	upidParts := strings.SplitN(upid, ":", 3)
+
+	if len(upidParts) < 3 {
+		return fmt.Errorf("The server returned an invalid task identifier (%s)", upid)
+	}
+
+	return c.WaitForNodeTask(upidParts[1], upid, timeout, 5)
+}
@@ -6,9 +6,24 @@ package proxmox
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
+	"strings"
 )
 
+// CustomNodeACME handles the ACME settings of a node.
+type CustomNodeACME struct {
+	Account *string
+	Domains []string
+}
+
+// CustomNodeACMEDomain handles an ACME domain of a node.
+type CustomNodeACMEDomain struct {
+	Alias  *string
+	Domain string
+	Plugin *string
+}
+
 // CustomNodeCommands contains an array of commands to execute.
 type CustomNodeCommands []string
 
@@ -17,6 +32,24 @@ type VirtualEnvironmentNodeExecuteRequestBody struct {
 	Commands CustomNodeCommands `json:"commands" url:"commands"`
 }
 
+// VirtualEnvironmentNodeGetConfigResponseBody contains the body from a node configuration get response.
+type VirtualEnvironmentNodeGetConfigResponseBody struct {
+	Data *VirtualEnvironmentNodeGetConfigResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentNodeGetConfigResponseData contains the data from a node configuration get response.
+type VirtualEnvironmentNodeGetConfigResponseData struct {
+	ACME        *CustomNodeACME       `json:"acme,omitempty"`
+	ACMEDomain0 *CustomNodeACMEDomain `json:"acmedomain0,omitempty"`
+	ACMEDomain1 *CustomNodeACMEDomain `json:"acmedomain1,omitempty"`
+	ACMEDomain2 *CustomNodeACMEDomain `json:"acmedomain2,omitempty"`
+	ACMEDomain3 *CustomNodeACMEDomain `json:"acmedomain3,omitempty"`
+	ACMEDomain4 *CustomNodeACMEDomain `json:"acmedomain4,omitempty"`
+	ACMEDomain5 *CustomNodeACMEDomain `json:"acmedomain5,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Digest      *string               `json:"digest,omitempty"`
+}
+
 // VirtualEnvironmentNodeGetTimeResponseBody contains the body from a node time zone get response.
 type VirtualEnvironmentNodeGetTimeResponseBody struct {
 	Data *VirtualEnvironmentNodeGetTimeResponseData `json:"data,omitempty"`
@@ -59,29 +92,122 @@ type VirtualEnvironmentNodeListResponseData struct {
 	Uptime          *int     `json:"uptime"`
 }
 
+// VirtualEnvironmentNodeNetworkDeviceCreateRequestBody contains the data for a node network device create request.
+type VirtualEnvironmentNodeNetworkDeviceCreateRequestBody struct {
+	Autostart          *CustomBool `json:"autostart,omitempty" url:"autostart,omitempty,int"`
+	BondMode           *string     `json:"bond_mode,omitempty" url:"bond_mode,omitempty"`
+	BondPrimary        *string     `json:"bond-primary,omitempty" url:"bond-primary,omitempty"`
+	BondXmitHashPolicy *string     `json:"bond_xmit_hash_policy,omitempty" url:"bond_xmit_hash_policy,omitempty"`
+	BridgePorts        *string     `json:"bridge_ports,omitempty" url:"bridge_ports,omitempty"`
+	BridgeVLANAware    *CustomBool `json:"bridge_vlan_aware,omitempty" url:"bridge_vlan_aware,omitempty,int"`
+	CIDR               *string     `json:"cidr,omitempty" url:"cidr,omitempty"`
+	CIDR6              *string     `json:"cidr6,omitempty" url:"cidr6,omitempty"`
+	Comments           *string     `json:"comments,omitempty" url:"comments,omitempty"`
+	Gateway            *string     `json:"gateway,omitempty" url:"gateway,omitempty"`
+	Gateway6           *string     `json:"gateway6,omitempty" url:"gateway6,omitempty"`
+	Iface              string      `json:"iface" url:"iface"`
+	MTU                *int        `json:"mtu,omitempty" url:"mtu,omitempty"`
+	OVSBonds           *string     `json:"ovs_bonds,omitempty" url:"ovs_bonds,omitempty"`
+	OVSBridge          *string     `json:"ovs_bridge,omitempty" url:"ovs_bridge,omitempty"`
+	OVSOptions         *string     `json:"ovs_options,omitempty" url:"ovs_options,omitempty"`
+	OVSPorts           *string     `json:"ovs_ports,omitempty" url:"ovs_ports,omitempty"`
+	OVSTag             *int        `json:"ovs_tag,omitempty" url:"ovs_tag,omitempty"`
+	Slaves             *string     `json:"slaves,omitempty" url:"slaves,omitempty"`
+	Type               string      `json:"type" url:"type"`
+	VLANID             *int        `json:"vlan-id,omitempty" url:"vlan-id,omitempty"`
+	VLANRawDevice      *string     `json:"vlan-raw-device,omitempty" url:"vlan-raw-device,omitempty"`
+}
+
+// VirtualEnvironmentNodeNetworkDeviceGetResponseBody contains the body from a node network device get response.
+type VirtualEnvironmentNodeNetworkDeviceGetResponseBody struct {
+	Data *VirtualEnvironmentNodeNetworkDeviceListResponseData `json:"data,omitempty"`
+}
+
 // VirtualEnvironmentNodeNetworkDeviceListResponseBody contains the body from a node network device list response.
 type VirtualEnvironmentNodeNetworkDeviceListResponseBody struct {
-	Data []*VirtualEnvironmentNodeNetworkDeviceListResponseData `json:"data,omitempty"`
+	Changes *string                                                `json:"changes,omitempty"`
+	Data    []*VirtualEnvironmentNodeNetworkDeviceListResponseData `json:"data,omitempty"`
 }
 
 // VirtualEnvironmentNodeNetworkDeviceListResponseData contains the data from a node network device list response.
 type VirtualEnvironmentNodeNetworkDeviceListResponseData struct {
-	Active      *CustomBool `json:"active,omitempty"`
-	Address     *string     `json:"address,omitempty"`
-	Autostart   *CustomBool `json:"autostart,omitempty"`
-	BridgeFD    *string     `json:"bridge_fd,omitempty"`
-	BridgePorts *string     `json:"bridge_ports,omitempty"`
-	BridgeSTP   *string     `json:"bridge_stp,omitempty"`
-	CIDR        *string     `json:"cidr,omitempty"`
-	Exists      *CustomBool `json:"exists,omitempty"`
-	Families    *[]string   `json:"families,omitempty"`
-	Gateway     *string     `json:"gateway,omitempty"`
-	Iface       string      `json:"iface"`
-	MethodIPv4  *string     `json:"method,omitempty"`
-	MethodIPv6  *string     `json:"method6,omitempty"`
-	Netmask     *string     `json:"netmask,omitempty"`
-	Priority    int         `json:"priority"`
-	Type        string      `json:"type"`
+	Active             *CustomBool `json:"active,omitempty"`
+	Address            *string     `json:"address,omitempty"`
+	Address6           *string     `json:"address6,omitempty"`
+	Autostart          *CustomBool `json:"autostart,omitempty"`
+	BondMode           *string     `json:"bond_mode,omitempty"`
+	BondPrimary        *string     `json:"bond-primary,omitempty"`
+	BondXmitHashPolicy *string     `json:"bond_xmit_hash_policy,omitempty"`
+	BridgeFD           *string     `json:"bridge_fd,omitempty"`
+	BridgePorts        *string     `json:"bridge_ports,omitempty"`
+	BridgeSTP          *string     `json:"bridge_stp,omitempty"`
+	BridgeVLANAware    *CustomBool `json:"bridge_vlan_aware,omitempty"`
+	CIDR               *string     `json:"cidr,omitempty"`
+	CIDR6              *string     `json:"cidr6,omitempty"`
+	Comments           *string     `json:"comments,omitempty"`
+	Exists             *CustomBool `json:"exists,omitempty"`
+	Families           *[]string   `json:"families,omitempty"`
+	Gateway            *string     `json:"gateway,omitempty"`
+	Gateway6           *string     `json:"gateway6,omitempty"`
+	Iface              string      `json:"iface"`
+	MethodIPv4         *string     `json:"method,omitempty"`
+	MethodIPv6         *string     `json:"method6,omitempty"`
+	MTU                *CustomInt  `json:"mtu,omitempty"`
+	Netmask            *string     `json:"netmask,omitempty"`
+	OVSBonds           *string     `json:"ovs_bonds,omitempty"`
+	OVSBridge          *string     `json:"ovs_bridge,omitempty"`
+	OVSOptions         *string     `json:"ovs_options,omitempty"`
+	OVSPorts           *string     `json:"ovs_ports,omitempty"`
+	OVSTag             *CustomInt  `json:"ovs_tag,omitempty"`
+	Priority           int         `json:"priority"`
+	Slaves             *string     `json:"slaves,omitempty"`
+	Type               string      `json:"type"`
+	VLANID             *CustomInt  `json:"vlan-id,omitempty"`
+	VLANRawDevice      *string     `json:"vlan-raw-device,omitempty"`
+}
+
+// VirtualEnvironmentNodeNetworkDeviceUpdateRequestBody contains the data for a node network device update request.
+type VirtualEnvironmentNodeNetworkDeviceUpdateRequestBody struct {
+	Autostart          *CustomBool `json:"autostart,omitempty" url:"autostart,omitempty,int"`
+	BondMode           *string     `json:"bond_mode,omitempty" url:"bond_mode,omitempty"`
+	BondPrimary        *string     `json:"bond-primary,omitempty" url:"bond-primary,omitempty"`
+	BondXmitHashPolicy *string     `json:"bond_xmit_hash_policy,omitempty" url:"bond_xmit_hash_policy,omitempty"`
+	BridgePorts        *string     `json:"bridge_ports,omitempty" url:"bridge_ports,omitempty"`
+	BridgeVLANAware    *CustomBool `json:"bridge_vlan_aware,omitempty" url:"bridge_vlan_aware,omitempty,int"`
+	CIDR               *string     `json:"cidr,omitempty" url:"cidr,omitempty"`
+	CIDR6              *string     `json:"cidr6,omitempty" url:"cidr6,omitempty"`
+	Comments           *string     `json:"comments,omitempty" url:"comments,omitempty"`
+	Delete             []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Gateway            *string     `json:"gateway,omitempty" url:"gateway,omitempty"`
+	Gateway6           *string     `json:"gateway6,omitempty" url:"gateway6,omitempty"`
+	MTU                *int        `json:"mtu,omitempty" url:"mtu,omitempty"`
+	OVSBonds           *string     `json:"ovs_bonds,omitempty" url:"ovs_bonds,omitempty"`
+	OVSBridge          *string     `json:"ovs_bridge,omitempty" url:"ovs_bridge,omitempty"`
+	OVSOptions         *string     `json:"ovs_options,omitempty" url:"ovs_options,omitempty"`
+	OVSPorts           *string     `json:"ovs_ports,omitempty" url:"ovs_ports,omitempty"`
+	OVSTag             *int        `json:"ovs_tag,omitempty" url:"ovs_tag,omitempty"`
+	Slaves             *string     `json:"slaves,omitempty" url:"slaves,omitempty"`
+	Type               string      `json:"type" url:"type"`
+	VLANID             *int        `json:"vlan-id,omitempty" url:"vlan-id,omitempty"`
+	VLANRawDevice      *string     `json:"vlan-raw-device,omitempty" url:"vlan-raw-device,omitempty"`
+}
+
+// VirtualEnvironmentNodeReloadNetworkResponseBody contains the body from a node network reload response.
+type VirtualEnvironmentNodeReloadNetworkResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentNodeUpdateConfigRequestBody contains the body for a node configuration update request.
+type VirtualEnvironmentNodeUpdateConfigRequestBody struct {
+	ACME        *CustomNodeACME       `json:"acme,omitempty" url:"acme,omitempty"`
+	ACMEDomain0 *CustomNodeACMEDomain `json:"acmedomain0,omitempty" url:"acmedomain0,omitempty"`
+	ACMEDomain1 *CustomNodeACMEDomain `json:"acmedomain1,omitempty" url:"acmedomain1,omitempty"`
+	ACMEDomain2 *CustomNodeACMEDomain `json:"acmedomain2,omitempty" url:"acmedomain2,omitempty"`
+	ACMEDomain3 *CustomNodeACMEDomain `json:"acmedomain3,omitempty" url:"acmedomain3,omitempty"`
+	ACMEDomain4 *CustomNodeACMEDomain `json:"acmedomain4,omitempty" url:"acmedomain4,omitempty"`
+	ACMEDomain5 *CustomNodeACMEDomain `json:"acmedomain5,omitempty" url:"acmedomain5,omitempty"`
+	Delete      []string              `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Digest      *string               `json:"digest,omitempty" url:"digest,omitempty"`
 }
 
 // VirtualEnvironmentNodeUpdateTimeRequestBody contains the body for a node time update request.
@@ -89,6 +215,42 @@ type VirtualEnvironmentNodeUpdateTimeRequestBody struct {
 	TimeZone string `json:"timezone" url:"timezone"`
 }
 
+// EncodeValues converts a CustomNodeACME struct to a URL vlaue.
+func (r CustomNodeACME) EncodeValues(key string, v *url.Values) error {
+	values := []string{}
+
+	if r.Account != nil {
+		values = append(values, fmt.Sprintf("account=%s", *r.Account))
+	}
+
+	if len(r.Domains) > 0 {
+		values = append(values, fmt.Sprintf("domains=%s", strings.Join(r.Domains, ";")))
+	}
+
+	v.Add(key, strings.Join(values, ","))
+
+	return nil
+}
+
+// EncodeValues converts a CustomNodeACMEDomain struct to a URL vlaue.
+func (r CustomNodeACMEDomain) EncodeValues(key string, v *url.Values) error {
+	values := []string{
+		fmt.Sprintf("domain=%s", r.Domain),
+	}
+
+	if r.Alias != nil {
+		values = append(values, fmt.Sprintf("alias=%s", *r.Alias))
+	}
+
+	if r.Plugin != nil {
+		values = append(values, fmt.Sprintf("plugin=%s", *r.Plugin))
+	}
+
+	v.Add(key, strings.Join(values, ","))
+
+	return nil
+}
+
 // EncodeValues converts a CustomNodeCommands array to a JSON encoded URL vlaue.
 func (r CustomNodeCommands) EncodeValues(key string, v *url.Values) error {
 	jsonArrayBytes, err := json.Marshal(r)
@@ -101,3 +263,64 @@ func (r CustomNodeCommands) EncodeValues(key string, v *url.Values) error {
 
 	return nil
 }
+
+// UnmarshalJSON converts a CustomNodeACME string to an object.
+func (r *CustomNodeACME) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	pairs := strings.Split(s, ",")
+
+	for _, p := range pairs {
+		v := strings.SplitN(strings.TrimSpace(p), "=", 2)
+
+		if len(v) == 2 {
+			switch v[0] {
+			case "account":
+				r.Account = &v[1]
+			case "domains":
+				r.Domains = strings.Split(v[1], ";")
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts a CustomNodeACMEDomain string to an object.
+func (r *CustomNodeACMEDomain) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	pairs := strings.Split(s, ",")
+
+	for _, p := range pairs {
+		v := strings.SplitN(strings.TrimSpace(p), "=", 2)
+
+		// The domain is the default key of the property string, which is why it may be specified without a key.
+		if len(v) == 1 {
+			r.Domain = v[0]
+		} else if len(v) == 2 {
+			switch v[0] {
+			case "alias":
+				r.Alias = &v[1]
+			case "domain":
+				r.Domain = v[1]
+			case "plugin":
+				r.Plugin = &v[1]
+			}
+		}
+	}
+
+	return nil
+}
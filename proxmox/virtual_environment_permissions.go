@@ -0,0 +1,25 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+)
+
+// GetPermissions retrieves the effective permissions of a user or an API token.
+func (c *VirtualEnvironmentClient) GetPermissions(d *VirtualEnvironmentPermissionsGetRequestBody) (VirtualEnvironmentPermissionsGetResponseData, error) {
+	resBody := &VirtualEnvironmentPermissionsGetResponseBody{}
+	err := c.DoRequest(hmGET, "access/permissions", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
@@ -0,0 +1,20 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentPermissionsGetRequestBody contains the data for a permissions get request.
+type VirtualEnvironmentPermissionsGetRequestBody struct {
+	Path   *string `json:"path,omitempty" url:"path,omitempty"`
+	UserID *string `json:"userid,omitempty" url:"userid,omitempty"`
+}
+
+// VirtualEnvironmentPermissionsGetResponseBody contains the body from a permissions get response.
+type VirtualEnvironmentPermissionsGetResponseBody struct {
+	Data VirtualEnvironmentPermissionsGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentPermissionsGetResponseData contains the data from a permissions get response.
+// It maps paths to privileges and whether the privileges are propagated to child paths.
+type VirtualEnvironmentPermissionsGetResponseData map[string]map[string]CustomBool
@@ -43,5 +43,8 @@ type VirtualEnvironmentPoolListResponseData struct {
 
 // VirtualEnvironmentPoolUpdateRequestBody contains the data for an pool update request.
 type VirtualEnvironmentPoolUpdateRequestBody struct {
-	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	Comment *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Delete  *CustomBool `json:"delete,omitempty" url:"delete,omitempty,int"`
+	Storage []string    `json:"storage,omitempty" url:"storage,omitempty,comma"`
+	VMs     []string    `json:"vms,omitempty" url:"vms,omitempty,comma"`
 }
@@ -0,0 +1,98 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CreateRealm creates an authentication realm.
+func (c *VirtualEnvironmentClient) CreateRealm(d *VirtualEnvironmentRealmCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "access/domains", d, nil)
+}
+
+// DeleteRealm deletes an authentication realm.
+func (c *VirtualEnvironmentClient) DeleteRealm(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("access/domains/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetRealm retrieves an authentication realm.
+func (c *VirtualEnvironmentClient) GetRealm(id string) (*VirtualEnvironmentRealmGetResponseData, error) {
+	resBody := &VirtualEnvironmentRealmGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("access/domains/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListRealms retrieves a list of authentication realms.
+func (c *VirtualEnvironmentClient) ListRealms() ([]*VirtualEnvironmentRealmListResponseData, error) {
+	resBody := &VirtualEnvironmentRealmListResponseBody{}
+	err := c.DoRequest(hmGET, "access/domains", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// SyncRealm synchronizes the users and groups of an authentication realm.
+func (c *VirtualEnvironmentClient) SyncRealm(id string, timeout int, d *VirtualEnvironmentRealmSyncRequestBody) error {
+	upid, err := c.SyncRealmAsync(id, d)
+
+	if err != nil {
+		return err
+	}
+
+	// The task identifier contains the name of the node, which is running the task (UPID:node:...).
+	upidParts := strings.SplitN(*upid, ":", 3)
+
+	if len(upidParts) < 3 {
+		return fmt.Errorf("The server returned an invalid task identifier (%s)", *upid)
+	}
+
+	return c.WaitForNodeTask(upidParts[1], *upid, timeout, 5)
+}
+
+// SyncRealmAsync synchronizes the users and groups of an authentication realm asynchronously.
+func (c *VirtualEnvironmentClient) SyncRealmAsync(id string, d *VirtualEnvironmentRealmSyncRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentRealmSyncResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("access/domains/%s/sync", url.PathEscape(id)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateRealm updates an authentication realm.
+func (c *VirtualEnvironmentClient) UpdateRealm(id string, d *VirtualEnvironmentRealmUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("access/domains/%s", url.PathEscape(id)), d, nil)
+}
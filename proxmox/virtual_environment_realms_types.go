@@ -0,0 +1,142 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentRealmCreateRequestBody contains the data for an authentication realm create request.
+type VirtualEnvironmentRealmCreateRequestBody struct {
+	ACRValues           *string     `json:"acr-values,omitempty" url:"acr-values,omitempty"`
+	AutoCreate          *CustomBool `json:"autocreate,omitempty" url:"autocreate,omitempty,int"`
+	BaseDN              *string     `json:"base_dn,omitempty" url:"base_dn,omitempty"`
+	BindDN              *string     `json:"bind_dn,omitempty" url:"bind_dn,omitempty"`
+	CAPath              *string     `json:"capath,omitempty" url:"capath,omitempty"`
+	CaseSensitive       *CustomBool `json:"case-sensitive,omitempty" url:"case-sensitive,omitempty,int"`
+	ClientID            *string     `json:"client-id,omitempty" url:"client-id,omitempty"`
+	ClientKey           *string     `json:"client-key,omitempty" url:"client-key,omitempty"`
+	Comment             *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Default             *CustomBool `json:"default,omitempty" url:"default,omitempty,int"`
+	Domain              *string     `json:"domain,omitempty" url:"domain,omitempty"`
+	Filter              *string     `json:"filter,omitempty" url:"filter,omitempty"`
+	GroupClasses        *string     `json:"group_classes,omitempty" url:"group_classes,omitempty"`
+	GroupDN             *string     `json:"group_dn,omitempty" url:"group_dn,omitempty"`
+	GroupFilter         *string     `json:"group_filter,omitempty" url:"group_filter,omitempty"`
+	GroupNameAttribute  *string     `json:"group_name_attr,omitempty" url:"group_name_attr,omitempty"`
+	ID                  string      `json:"realm" url:"realm"`
+	IssuerURL           *string     `json:"issuer-url,omitempty" url:"issuer-url,omitempty"`
+	Mode                *string     `json:"mode,omitempty" url:"mode,omitempty"`
+	Password            *string     `json:"password,omitempty" url:"password,omitempty"`
+	Port                *int        `json:"port,omitempty" url:"port,omitempty"`
+	Prompt              *string     `json:"prompt,omitempty" url:"prompt,omitempty"`
+	Scopes              *string     `json:"scopes,omitempty" url:"scopes,omitempty"`
+	Server1             *string     `json:"server1,omitempty" url:"server1,omitempty"`
+	Server2             *string     `json:"server2,omitempty" url:"server2,omitempty"`
+	SyncAttributes      *string     `json:"sync_attributes,omitempty" url:"sync_attributes,omitempty"`
+	SyncDefaultsOptions *string     `json:"sync-defaults-options,omitempty" url:"sync-defaults-options,omitempty"`
+	TFA                 *string     `json:"tfa,omitempty" url:"tfa,omitempty"`
+	Type                string      `json:"type" url:"type"`
+	UserAttribute       *string     `json:"user_attr,omitempty" url:"user_attr,omitempty"`
+	UserClasses         *string     `json:"user_classes,omitempty" url:"user_classes,omitempty"`
+	UsernameClaim       *string     `json:"username-claim,omitempty" url:"username-claim,omitempty"`
+	VerifyCertificate   *CustomBool `json:"verify,omitempty" url:"verify,omitempty,int"`
+}
+
+// VirtualEnvironmentRealmGetResponseBody contains the body from an authentication realm get response.
+type VirtualEnvironmentRealmGetResponseBody struct {
+	Data *VirtualEnvironmentRealmGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentRealmGetResponseData contains the data from an authentication realm get response.
+type VirtualEnvironmentRealmGetResponseData struct {
+	ACRValues           *string     `json:"acr-values,omitempty"`
+	AutoCreate          *CustomBool `json:"autocreate,omitempty"`
+	BaseDN              *string     `json:"base_dn,omitempty"`
+	BindDN              *string     `json:"bind_dn,omitempty"`
+	CAPath              *string     `json:"capath,omitempty"`
+	CaseSensitive       *CustomBool `json:"case-sensitive,omitempty"`
+	ClientID            *string     `json:"client-id,omitempty"`
+	Comment             *string     `json:"comment,omitempty"`
+	Default             *CustomBool `json:"default,omitempty"`
+	Domain              *string     `json:"domain,omitempty"`
+	Filter              *string     `json:"filter,omitempty"`
+	GroupClasses        *string     `json:"group_classes,omitempty"`
+	GroupDN             *string     `json:"group_dn,omitempty"`
+	GroupFilter         *string     `json:"group_filter,omitempty"`
+	GroupNameAttribute  *string     `json:"group_name_attr,omitempty"`
+	IssuerURL           *string     `json:"issuer-url,omitempty"`
+	Mode                *string     `json:"mode,omitempty"`
+	Port                *CustomInt  `json:"port,omitempty"`
+	Prompt              *string     `json:"prompt,omitempty"`
+	Scopes              *string     `json:"scopes,omitempty"`
+	Server1             *string     `json:"server1,omitempty"`
+	Server2             *string     `json:"server2,omitempty"`
+	SyncAttributes      *string     `json:"sync_attributes,omitempty"`
+	SyncDefaultsOptions *string     `json:"sync-defaults-options,omitempty"`
+	TFA                 *string     `json:"tfa,omitempty"`
+	Type                string      `json:"type"`
+	UserAttribute       *string     `json:"user_attr,omitempty"`
+	UserClasses         *string     `json:"user_classes,omitempty"`
+	UsernameClaim       *string     `json:"username-claim,omitempty"`
+	VerifyCertificate   *CustomBool `json:"verify,omitempty"`
+}
+
+// VirtualEnvironmentRealmListResponseBody contains the body from an authentication realm list response.
+type VirtualEnvironmentRealmListResponseBody struct {
+	Data []*VirtualEnvironmentRealmListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentRealmListResponseData contains the data from an authentication realm list response.
+type VirtualEnvironmentRealmListResponseData struct {
+	Comment *string `json:"comment,omitempty"`
+	ID      string  `json:"realm"`
+	TFA     *string `json:"tfa,omitempty"`
+	Type    string  `json:"type"`
+}
+
+// VirtualEnvironmentRealmSyncRequestBody contains the data for an authentication realm sync request.
+type VirtualEnvironmentRealmSyncRequestBody struct {
+	DryRun         *CustomBool `json:"dry-run,omitempty" url:"dry-run,omitempty,int"`
+	EnableNew      *CustomBool `json:"enable-new,omitempty" url:"enable-new,omitempty,int"`
+	RemoveVanished *string     `json:"remove-vanished,omitempty" url:"remove-vanished,omitempty"`
+	Scope          *string     `json:"scope,omitempty" url:"scope,omitempty"`
+}
+
+// VirtualEnvironmentRealmSyncResponseBody contains the body from an authentication realm sync response.
+type VirtualEnvironmentRealmSyncResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentRealmUpdateRequestBody contains the data for an authentication realm update request.
+type VirtualEnvironmentRealmUpdateRequestBody struct {
+	ACRValues           *string     `json:"acr-values,omitempty" url:"acr-values,omitempty"`
+	AutoCreate          *CustomBool `json:"autocreate,omitempty" url:"autocreate,omitempty,int"`
+	BaseDN              *string     `json:"base_dn,omitempty" url:"base_dn,omitempty"`
+	BindDN              *string     `json:"bind_dn,omitempty" url:"bind_dn,omitempty"`
+	CAPath              *string     `json:"capath,omitempty" url:"capath,omitempty"`
+	CaseSensitive       *CustomBool `json:"case-sensitive,omitempty" url:"case-sensitive,omitempty,int"`
+	ClientID            *string     `json:"client-id,omitempty" url:"client-id,omitempty"`
+	ClientKey           *string     `json:"client-key,omitempty" url:"client-key,omitempty"`
+	Comment             *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Default             *CustomBool `json:"default,omitempty" url:"default,omitempty,int"`
+	Delete              []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Domain              *string     `json:"domain,omitempty" url:"domain,omitempty"`
+	Filter              *string     `json:"filter,omitempty" url:"filter,omitempty"`
+	GroupClasses        *string     `json:"group_classes,omitempty" url:"group_classes,omitempty"`
+	GroupDN             *string     `json:"group_dn,omitempty" url:"group_dn,omitempty"`
+	GroupFilter         *string     `json:"group_filter,omitempty" url:"group_filter,omitempty"`
+	GroupNameAttribute  *string     `json:"group_name_attr,omitempty" url:"group_name_attr,omitempty"`
+	IssuerURL           *string     `json:"issuer-url,omitempty" url:"issuer-url,omitempty"`
+	Mode                *string     `json:"mode,omitempty" url:"mode,omitempty"`
+	Password            *string     `json:"password,omitempty" url:"password,omitempty"`
+	Port                *int        `json:"port,omitempty" url:"port,omitempty"`
+	Prompt              *string     `json:"prompt,omitempty" url:"prompt,omitempty"`
+	Scopes              *string     `json:"scopes,omitempty" url:"scopes,omitempty"`
+	Server1             *string     `json:"server1,omitempty" url:"server1,omitempty"`
+	Server2             *string     `json:"server2,omitempty" url:"server2,omitempty"`
+	SyncAttributes      *string     `json:"sync_attributes,omitempty" url:"sync_attributes,omitempty"`
+	SyncDefaultsOptions *string     `json:"sync-defaults-options,omitempty" url:"sync-defaults-options,omitempty"`
+	TFA                 *string     `json:"tfa,omitempty" url:"tfa,omitempty"`
+	UserAttribute       *string     `json:"user_attr,omitempty" url:"user_attr,omitempty"`
+	UserClasses         *string     `json:"user_classes,omitempty" url:"user_classes,omitempty"`
+	VerifyCertificate   *CustomBool `json:"verify,omitempty" url:"verify,omitempty,int"`
+}
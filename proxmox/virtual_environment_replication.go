@@ -0,0 +1,79 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// CreateReplicationJob creates a replication job.
+func (c *VirtualEnvironmentClient) CreateReplicationJob(d *VirtualEnvironmentReplicationJobCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/replication", d, nil)
+}
+
+// DeleteReplicationJob deletes a replication job.
+func (c *VirtualEnvironmentClient) DeleteReplicationJob(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/replication/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetReplicationJob retrieves a replication job.
+func (c *VirtualEnvironmentClient) GetReplicationJob(id string) (*VirtualEnvironmentReplicationJobGetResponseData, error) {
+	resBody := &VirtualEnvironmentReplicationJobGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/replication/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetReplicationJobStatus retrieves the status of a replication job.
+func (c *VirtualEnvironmentClient) GetReplicationJobStatus(nodeName, id string) (*VirtualEnvironmentReplicationJobStatusResponseData, error) {
+	resBody := &VirtualEnvironmentReplicationJobStatusResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/replication/%s/status", url.PathEscape(nodeName), url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListReplicationJobs retrieves a list of replication jobs.
+func (c *VirtualEnvironmentClient) ListReplicationJobs() ([]*VirtualEnvironmentReplicationJobGetResponseData, error) {
+	resBody := &VirtualEnvironmentReplicationJobListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/replication", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateReplicationJob updates a replication job.
+func (c *VirtualEnvironmentClient) UpdateReplicationJob(id string, d *VirtualEnvironmentReplicationJobUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/replication/%s", url.PathEscape(id)), d, nil)
+}
@@ -0,0 +1,67 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentReplicationJobCreateRequestBody contains the data for a replication job create request.
+type VirtualEnvironmentReplicationJobCreateRequestBody struct {
+	Comment  *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Disable  *CustomBool `json:"disable,omitempty" url:"disable,omitempty,int"`
+	ID       string      `json:"id" url:"id"`
+	Rate     *float64    `json:"rate,omitempty" url:"rate,omitempty"`
+	Schedule *string     `json:"schedule,omitempty" url:"schedule,omitempty"`
+	Target   string      `json:"target" url:"target"`
+	Type     string      `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentReplicationJobGetResponseBody contains the body from a replication job get response.
+type VirtualEnvironmentReplicationJobGetResponseBody struct {
+	Data *VirtualEnvironmentReplicationJobGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentReplicationJobGetResponseData contains the data from a replication job get response.
+type VirtualEnvironmentReplicationJobGetResponseData struct {
+	Comment  *string     `json:"comment,omitempty"`
+	Disable  *CustomBool `json:"disable,omitempty"`
+	Guest    int         `json:"guest"`
+	ID       string      `json:"id"`
+	JobNum   int         `json:"jobnum"`
+	Rate     *float64    `json:"rate,omitempty"`
+	Schedule *string     `json:"schedule,omitempty"`
+	Source   *string     `json:"source,omitempty"`
+	Target   string      `json:"target"`
+	Type     string      `json:"type"`
+}
+
+// VirtualEnvironmentReplicationJobListResponseBody contains the body from a replication job list response.
+type VirtualEnvironmentReplicationJobListResponseBody struct {
+	Data []*VirtualEnvironmentReplicationJobGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentReplicationJobStatusResponseBody contains the body from a replication job status response.
+type VirtualEnvironmentReplicationJobStatusResponseBody struct {
+	Data *VirtualEnvironmentReplicationJobStatusResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentReplicationJobStatusResponseData contains the data from a replication job status response.
+type VirtualEnvironmentReplicationJobStatusResponseData struct {
+	Duration  *float64         `json:"duration,omitempty"`
+	Error     *string          `json:"error,omitempty"`
+	FailCount *int             `json:"fail_count,omitempty"`
+	Guest     int              `json:"guest"`
+	ID        string           `json:"id"`
+	LastSync  *CustomTimestamp `json:"last_sync,omitempty"`
+	LastTry   *CustomTimestamp `json:"last_try,omitempty"`
+	NextSync  *CustomTimestamp `json:"next_sync,omitempty"`
+	Target    string           `json:"target"`
+}
+
+// VirtualEnvironmentReplicationJobUpdateRequestBody contains the data for a replication job update request.
+type VirtualEnvironmentReplicationJobUpdateRequestBody struct {
+	Comment  *string     `json:"comment,omitempty" url:"comment,omitempty"`
+	Delete   []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Disable  *CustomBool `json:"disable,omitempty" url:"disable,omitempty,int"`
+	Rate     *float64    `json:"rate,omitempty" url:"rate,omitempty"`
+	Schedule *string     `json:"schedule,omitempty" url:"schedule,omitempty"`
+}
@@ -0,0 +1,235 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ApplySDN applies the pending SDN configuration to all the nodes.
+func (c *VirtualEnvironmentClient) ApplySDN(timeout int) error {
+	upid, err := c.ApplySDNAsync()
+
+	if err != nil {
+		return err
+	}
+
+	// The task identifier contains the name of the node, which is running the task (UPID:node:...).
+	upidParts := strings.SplitN(*upid, ":", 3)
+
+	if len(upidParts) < 3 {
+		return fmt.Errorf("The server returned an invalid task identifier (%s)", *upid)
+	}
+
+	return c.WaitForNodeTask(upidParts[1], *upid, timeout, 5)
+}
+
+// ApplySDNAsync applies the pending SDN configuration to all the nodes asynchronously.
+func (c *VirtualEnvironmentClient) ApplySDNAsync() (*string, error) {
+	resBody := &VirtualEnvironmentSDNApplyResponseBody{}
+	err := c.DoRequest(hmPUT, "cluster/sdn", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateSDNController creates an SDN controller.
+func (c *VirtualEnvironmentClient) CreateSDNController(d *VirtualEnvironmentSDNControllerCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/sdn/controllers", d, nil)
+}
+
+// CreateSDNSubnet creates an SDN subnet.
+func (c *VirtualEnvironmentClient) CreateSDNSubnet(vnetID string, d *VirtualEnvironmentSDNSubnetCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("cluster/sdn/vnets/%s/subnets", url.PathEscape(vnetID)), d, nil)
+}
+
+// CreateSDNVNet creates an SDN VNet.
+func (c *VirtualEnvironmentClient) CreateSDNVNet(d *VirtualEnvironmentSDNVNetCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/sdn/vnets", d, nil)
+}
+
+// CreateSDNZone creates an SDN zone.
+func (c *VirtualEnvironmentClient) CreateSDNZone(d *VirtualEnvironmentSDNZoneCreateRequestBody) error {
+	return c.DoRequest(hmPOST, "cluster/sdn/zones", d, nil)
+}
+
+// DeleteSDNController deletes an SDN controller.
+func (c *VirtualEnvironmentClient) DeleteSDNController(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/sdn/controllers/%s", url.PathEscape(id)), nil, nil)
+}
+
+// DeleteSDNSubnet deletes an SDN subnet.
+func (c *VirtualEnvironmentClient) DeleteSDNSubnet(vnetID, id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/sdn/vnets/%s/subnets/%s", url.PathEscape(vnetID), url.PathEscape(id)), nil, nil)
+}
+
+// DeleteSDNVNet deletes an SDN VNet.
+func (c *VirtualEnvironmentClient) DeleteSDNVNet(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/sdn/vnets/%s", url.PathEscape(id)), nil, nil)
+}
+
+// DeleteSDNZone deletes an SDN zone.
+func (c *VirtualEnvironmentClient) DeleteSDNZone(id string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("cluster/sdn/zones/%s", url.PathEscape(id)), nil, nil)
+}
+
+// GetSDNController retrieves an SDN controller.
+func (c *VirtualEnvironmentClient) GetSDNController(id string) (*VirtualEnvironmentSDNControllerGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNControllerGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/sdn/controllers/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetSDNVNet retrieves an SDN VNet.
+func (c *VirtualEnvironmentClient) GetSDNVNet(id string) (*VirtualEnvironmentSDNVNetGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNVNetGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/sdn/vnets/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetSDNZone retrieves an SDN zone.
+func (c *VirtualEnvironmentClient) GetSDNZone(id string) (*VirtualEnvironmentSDNZoneGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNZoneGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/sdn/zones/%s", url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListSDNControllers retrieves a list of SDN controllers.
+func (c *VirtualEnvironmentClient) ListSDNControllers(d *VirtualEnvironmentSDNListRequestBody) ([]*VirtualEnvironmentSDNControllerGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNControllerListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/sdn/controllers", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// ListSDNSubnets retrieves a list of SDN subnets.
+func (c *VirtualEnvironmentClient) ListSDNSubnets(vnetID string, d *VirtualEnvironmentSDNListRequestBody) ([]*VirtualEnvironmentSDNSubnetListResponseData, error) {
+	resBody := &VirtualEnvironmentSDNSubnetListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("cluster/sdn/vnets/%s/subnets", url.PathEscape(vnetID)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// ListSDNVNets retrieves a list of SDN VNets.
+func (c *VirtualEnvironmentClient) ListSDNVNets(d *VirtualEnvironmentSDNListRequestBody) ([]*VirtualEnvironmentSDNVNetGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNVNetListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/sdn/vnets", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// ListSDNZones retrieves a list of SDN zones.
+func (c *VirtualEnvironmentClient) ListSDNZones(d *VirtualEnvironmentSDNListRequestBody) ([]*VirtualEnvironmentSDNZoneGetResponseData, error) {
+	resBody := &VirtualEnvironmentSDNZoneListResponseBody{}
+	err := c.DoRequest(hmGET, "cluster/sdn/zones", d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateSDNController updates an SDN controller.
+func (c *VirtualEnvironmentClient) UpdateSDNController(id string, d *VirtualEnvironmentSDNControllerUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/sdn/controllers/%s", url.PathEscape(id)), d, nil)
+}
+
+// UpdateSDNSubnet updates an SDN subnet.
+func (c *VirtualEnvironmentClient) UpdateSDNSubnet(vnetID, id string, d *VirtualEnvironmentSDNSubnetUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/sdn/vnets/%s/subnets/%s", url.PathEscape(vnetID), url.PathEscape(id)), d, nil)
+}
+
+// UpdateSDNVNet updates an SDN VNet.
+func (c *VirtualEnvironmentClient) UpdateSDNVNet(id string, d *VirtualEnvironmentSDNVNetUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/sdn/vnets/%s", url.PathEscape(id)), d, nil)
+}
+
+// UpdateSDNZone updates an SDN zone.
+func (c *VirtualEnvironmentClient) UpdateSDNZone(id string, d *VirtualEnvironmentSDNZoneUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("cluster/sdn/zones/%s", url.PathEscape(id)), d, nil)
+}
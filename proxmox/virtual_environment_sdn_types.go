@@ -0,0 +1,274 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// CustomSDNDHCPRange handles SDN subnet DHCP ranges.
+type CustomSDNDHCPRange struct {
+	EndAddress   string `json:"end-address" url:"end-address"`
+	StartAddress string `json:"start-address" url:"start-address"`
+}
+
+// CustomSDNDHCPRanges handles SDN subnet DHCP range lists.
+type CustomSDNDHCPRanges []CustomSDNDHCPRange
+
+// VirtualEnvironmentSDNApplyResponseBody contains the body from an SDN apply response.
+type VirtualEnvironmentSDNApplyResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNControllerCreateRequestBody contains the data for an SDN controller create request.
+type VirtualEnvironmentSDNControllerCreateRequestBody struct {
+	ASN          *int        `json:"asn,omitempty" url:"asn,omitempty"`
+	EBGP         *CustomBool `json:"ebgp,omitempty" url:"ebgp,omitempty,int"`
+	EBGPMultihop *int        `json:"ebgp-multihop,omitempty" url:"ebgp-multihop,omitempty"`
+	ID           string      `json:"controller" url:"controller"`
+	Loopback     *string     `json:"loopback,omitempty" url:"loopback,omitempty"`
+	Node         *string     `json:"node,omitempty" url:"node,omitempty"`
+	Peers        []string    `json:"peers,omitempty" url:"peers,omitempty,comma"`
+	Type         string      `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentSDNControllerGetResponseBody contains the body from an SDN controller get response.
+type VirtualEnvironmentSDNControllerGetResponseBody struct {
+	Data *VirtualEnvironmentSDNControllerGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNControllerGetResponseData contains the data from an SDN controller get response.
+type VirtualEnvironmentSDNControllerGetResponseData struct {
+	ASN          *CustomInt                `json:"asn,omitempty"`
+	EBGP         *CustomBool               `json:"ebgp,omitempty"`
+	EBGPMultihop *CustomInt                `json:"ebgp-multihop,omitempty"`
+	ID           string                    `json:"controller"`
+	Loopback     *string                   `json:"loopback,omitempty"`
+	Node         *string                   `json:"node,omitempty"`
+	Peers        *CustomCommaSeparatedList `json:"peers,omitempty"`
+	State        *string                   `json:"state,omitempty"`
+	Type         string                    `json:"type"`
+}
+
+// VirtualEnvironmentSDNControllerListResponseBody contains the body from an SDN controller list response.
+type VirtualEnvironmentSDNControllerListResponseBody struct {
+	Data []*VirtualEnvironmentSDNControllerGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNControllerUpdateRequestBody contains the data for an SDN controller update request.
+type VirtualEnvironmentSDNControllerUpdateRequestBody struct {
+	ASN          *int        `json:"asn,omitempty" url:"asn,omitempty"`
+	Delete       []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	EBGP         *CustomBool `json:"ebgp,omitempty" url:"ebgp,omitempty,int"`
+	EBGPMultihop *int        `json:"ebgp-multihop,omitempty" url:"ebgp-multihop,omitempty"`
+	Loopback     *string     `json:"loopback,omitempty" url:"loopback,omitempty"`
+	Node         *string     `json:"node,omitempty" url:"node,omitempty"`
+	Peers        []string    `json:"peers,omitempty" url:"peers,omitempty,comma"`
+}
+
+// VirtualEnvironmentSDNListRequestBody contains the data for an SDN list request.
+type VirtualEnvironmentSDNListRequestBody struct {
+	Pending *CustomBool `json:"pending,omitempty" url:"pending,omitempty,int"`
+}
+
+// VirtualEnvironmentSDNSubnetCreateRequestBody contains the data for an SDN subnet create request.
+type VirtualEnvironmentSDNSubnetCreateRequestBody struct {
+	DHCPDNSServer *string             `json:"dhcp-dns-server,omitempty" url:"dhcp-dns-server,omitempty"`
+	DHCPRange     CustomSDNDHCPRanges `json:"dhcp-range,omitempty" url:"dhcp-range,omitempty"`
+	DNSZonePrefix *string             `json:"dnszoneprefix,omitempty" url:"dnszoneprefix,omitempty"`
+	Gateway       *string             `json:"gateway,omitempty" url:"gateway,omitempty"`
+	SNAT          *CustomBool         `json:"snat,omitempty" url:"snat,omitempty,int"`
+	Subnet        string              `json:"subnet" url:"subnet"`
+	Type          string              `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentSDNSubnetListResponseBody contains the body from an SDN subnet list response.
+type VirtualEnvironmentSDNSubnetListResponseBody struct {
+	Data []*VirtualEnvironmentSDNSubnetListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNSubnetListResponseData contains the data from an SDN subnet list response.
+type VirtualEnvironmentSDNSubnetListResponseData struct {
+	CIDR          string              `json:"cidr"`
+	DHCPDNSServer *string             `json:"dhcp-dns-server,omitempty"`
+	DHCPRange     CustomSDNDHCPRanges `json:"dhcp-range,omitempty"`
+	DNSZonePrefix *string             `json:"dnszoneprefix,omitempty"`
+	Gateway       *string             `json:"gateway,omitempty"`
+	ID            string              `json:"subnet"`
+	SNAT          *CustomBool         `json:"snat,omitempty"`
+	State         *string             `json:"state,omitempty"`
+	Zone          *string             `json:"zone,omitempty"`
+}
+
+// VirtualEnvironmentSDNSubnetUpdateRequestBody contains the data for an SDN subnet update request.
+type VirtualEnvironmentSDNSubnetUpdateRequestBody struct {
+	Delete        []string            `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	DHCPDNSServer *string             `json:"dhcp-dns-server,omitempty" url:"dhcp-dns-server,omitempty"`
+	DHCPRange     CustomSDNDHCPRanges `json:"dhcp-range,omitempty" url:"dhcp-range,omitempty"`
+	DNSZonePrefix *string             `json:"dnszoneprefix,omitempty" url:"dnszoneprefix,omitempty"`
+	Gateway       *string             `json:"gateway,omitempty" url:"gateway,omitempty"`
+	SNAT          *CustomBool         `json:"snat,omitempty" url:"snat,omitempty,int"`
+}
+
+// VirtualEnvironmentSDNVNetCreateRequestBody contains the data for an SDN VNet create request.
+type VirtualEnvironmentSDNVNetCreateRequestBody struct {
+	Alias     *string     `json:"alias,omitempty" url:"alias,omitempty"`
+	ID        string      `json:"vnet" url:"vnet"`
+	Tag       *int        `json:"tag,omitempty" url:"tag,omitempty"`
+	VLANAware *CustomBool `json:"vlanaware,omitempty" url:"vlanaware,omitempty,int"`
+	Zone      string      `json:"zone" url:"zone"`
+}
+
+// VirtualEnvironmentSDNVNetGetResponseBody contains the body from an SDN VNet get response.
+type VirtualEnvironmentSDNVNetGetResponseBody struct {
+	Data *VirtualEnvironmentSDNVNetGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNVNetGetResponseData contains the data from an SDN VNet get response.
+type VirtualEnvironmentSDNVNetGetResponseData struct {
+	Alias     *string     `json:"alias,omitempty"`
+	ID        string      `json:"vnet"`
+	State     *string     `json:"state,omitempty"`
+	Tag       *CustomInt  `json:"tag,omitempty"`
+	VLANAware *CustomBool `json:"vlanaware,omitempty"`
+	Zone      string      `json:"zone"`
+}
+
+// VirtualEnvironmentSDNVNetListResponseBody contains the body from an SDN VNet list response.
+type VirtualEnvironmentSDNVNetListResponseBody struct {
+	Data []*VirtualEnvironmentSDNVNetGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNVNetUpdateRequestBody contains the data for an SDN VNet update request.
+type VirtualEnvironmentSDNVNetUpdateRequestBody struct {
+	Alias     *string     `json:"alias,omitempty" url:"alias,omitempty"`
+	Delete    []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Tag       *int        `json:"tag,omitempty" url:"tag,omitempty"`
+	VLANAware *CustomBool `json:"vlanaware,omitempty" url:"vlanaware,omitempty,int"`
+	Zone      *string     `json:"zone,omitempty" url:"zone,omitempty"`
+}
+
+// VirtualEnvironmentSDNZoneCreateRequestBody contains the data for an SDN zone create request.
+type VirtualEnvironmentSDNZoneCreateRequestBody struct {
+	Bridge       *string  `json:"bridge,omitempty" url:"bridge,omitempty"`
+	Controller   *string  `json:"controller,omitempty" url:"controller,omitempty"`
+	DHCP         *string  `json:"dhcp,omitempty" url:"dhcp,omitempty"`
+	DNS          *string  `json:"dns,omitempty" url:"dns,omitempty"`
+	DNSZone      *string  `json:"dnszone,omitempty" url:"dnszone,omitempty"`
+	ExitNodes    []string `json:"exitnodes,omitempty" url:"exitnodes,omitempty,comma"`
+	ID           string   `json:"zone" url:"zone"`
+	IPAM         *string  `json:"ipam,omitempty" url:"ipam,omitempty"`
+	MTU          *int     `json:"mtu,omitempty" url:"mtu,omitempty"`
+	Nodes        []string `json:"nodes,omitempty" url:"nodes,omitempty,comma"`
+	Peers        []string `json:"peers,omitempty" url:"peers,omitempty,comma"`
+	ReverseDNS   *string  `json:"reversedns,omitempty" url:"reversedns,omitempty"`
+	Tag          *int     `json:"tag,omitempty" url:"tag,omitempty"`
+	Type         string   `json:"type" url:"type"`
+	VLANProtocol *string  `json:"vlan-protocol,omitempty" url:"vlan-protocol,omitempty"`
+	VRFVXLAN     *int     `json:"vrf-vxlan,omitempty" url:"vrf-vxlan,omitempty"`
+}
+
+// VirtualEnvironmentSDNZoneGetResponseBody contains the body from an SDN zone get response.
+type VirtualEnvironmentSDNZoneGetResponseBody struct {
+	Data *VirtualEnvironmentSDNZoneGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNZoneGetResponseData contains the data from an SDN zone get response.
+type VirtualEnvironmentSDNZoneGetResponseData struct {
+	Bridge       *string                   `json:"bridge,omitempty"`
+	Controller   *string                   `json:"controller,omitempty"`
+	DHCP         *string                   `json:"dhcp,omitempty"`
+	DNS          *string                   `json:"dns,omitempty"`
+	DNSZone      *string                   `json:"dnszone,omitempty"`
+	ExitNodes    *CustomCommaSeparatedList `json:"exitnodes,omitempty"`
+	ID           string                    `json:"zone"`
+	IPAM         *string                   `json:"ipam,omitempty"`
+	MTU          *CustomInt                `json:"mtu,omitempty"`
+	Nodes        *CustomCommaSeparatedList `json:"nodes,omitempty"`
+	Peers        *CustomCommaSeparatedList `json:"peers,omitempty"`
+	ReverseDNS   *string                   `json:"reversedns,omitempty"`
+	State        *string                   `json:"state,omitempty"`
+	Tag          *CustomInt                `json:"tag,omitempty"`
+	Type         string                    `json:"type"`
+	VLANProtocol *string                   `json:"vlan-protocol,omitempty"`
+	VRFVXLAN     *CustomInt                `json:"vrf-vxlan,omitempty"`
+}
+
+// VirtualEnvironmentSDNZoneListResponseBody contains the body from an SDN zone list response.
+type VirtualEnvironmentSDNZoneListResponseBody struct {
+	Data []*VirtualEnvironmentSDNZoneGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSDNZoneUpdateRequestBody contains the data for an SDN zone update request.
+type VirtualEnvironmentSDNZoneUpdateRequestBody struct {
+	Bridge       *string  `json:"bridge,omitempty" url:"bridge,omitempty"`
+	Controller   *string  `json:"controller,omitempty" url:"controller,omitempty"`
+	Delete       []string `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	DHCP         *string  `json:"dhcp,omitempty" url:"dhcp,omitempty"`
+	DNS          *string  `json:"dns,omitempty" url:"dns,omitempty"`
+	DNSZone      *string  `json:"dnszone,omitempty" url:"dnszone,omitempty"`
+	ExitNodes    []string `json:"exitnodes,omitempty" url:"exitnodes,omitempty,comma"`
+	IPAM         *string  `json:"ipam,omitempty" url:"ipam,omitempty"`
+	MTU          *int     `json:"mtu,omitempty" url:"mtu,omitempty"`
+	Nodes        []string `json:"nodes,omitempty" url:"nodes,omitempty,comma"`
+	Peers        []string `json:"peers,omitempty" url:"peers,omitempty,comma"`
+	ReverseDNS   *string  `json:"reversedns,omitempty" url:"reversedns,omitempty"`
+	Tag          *int     `json:"tag,omitempty" url:"tag,omitempty"`
+	VLANProtocol *string  `json:"vlan-protocol,omitempty" url:"vlan-protocol,omitempty"`
+	VRFVXLAN     *int     `json:"vrf-vxlan,omitempty" url:"vrf-vxlan,omitempty"`
+}
+
+// EncodeValues converts a CustomSDNDHCPRanges array to multiple URL values.
+func (r CustomSDNDHCPRanges) EncodeValues(key string, v *url.Values) error {
+	for _, d := range r {
+		v.Add(key, fmt.Sprintf("start-address=%s,end-address=%s", d.StartAddress, d.EndAddress))
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts a JSON value to a DHCP range.
+func (r *CustomSDNDHCPRange) UnmarshalJSON(b []byte) error {
+	var s string
+
+	// Older API versions return the range as a property string instead of an object.
+	if err := json.Unmarshal(b, &s); err != nil {
+		var o struct {
+			EndAddress   string `json:"end-address"`
+			StartAddress string `json:"start-address"`
+		}
+
+		err = json.Unmarshal(b, &o)
+
+		if err != nil {
+			return err
+		}
+
+		r.EndAddress = o.EndAddress
+		r.StartAddress = o.StartAddress
+
+		return nil
+	}
+
+	for _, option := range strings.Split(s, ",") {
+		kv := strings.SplitN(option, "=", 2)
+
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "end-address":
+			r.EndAddress = kv[1]
+		case "start-address":
+			r.StartAddress = kv[1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ExecuteServiceCommand starts, stops, restarts or reloads a service on a node.
+func (c *VirtualEnvironmentClient) ExecuteServiceCommand(nodeName string, serviceName string, command string, timeout int) error {
+	taskID, err := c.ExecuteServiceCommandAsync(nodeName, serviceName, command)
+
+	if err != nil {
+		return err
+	}
+
+	return c.WaitForNodeTask(nodeName, *taskID, timeout, 1)
+}
+
+// ExecuteServiceCommandAsync starts, stops, restarts or reloads a service on a node asynchronously.
+func (c *VirtualEnvironmentClient) ExecuteServiceCommandAsync(nodeName string, serviceName string, command string) (*string, error) {
+	resBody := &VirtualEnvironmentServiceCommandResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/services/%s/%s", url.PathEscape(nodeName), url.PathEscape(serviceName), url.PathEscape(command)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// GetService retrieves the state of a service on a node.
+func (c *VirtualEnvironmentClient) GetService(nodeName string, serviceName string) (*VirtualEnvironmentServiceGetResponseData, error) {
+	resBody := &VirtualEnvironmentServiceGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/services/%s/state", url.PathEscape(nodeName), url.PathEscape(serviceName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
@@ -0,0 +1,25 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentServiceCommandResponseBody contains the body from a service command response.
+type VirtualEnvironmentServiceCommandResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentServiceGetResponseBody contains the body from a service get response.
+type VirtualEnvironmentServiceGetResponseBody struct {
+	Data *VirtualEnvironmentServiceGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentServiceGetResponseData contains the data from a service get response.
+type VirtualEnvironmentServiceGetResponseData struct {
+	ActiveState *string `json:"active-state,omitempty"`
+	Description *string `json:"desc,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Service     string  `json:"service"`
+	State       *string `json:"state,omitempty"`
+	UnitState   *string `json:"unit-state,omitempty"`
+}
@@ -0,0 +1,42 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// CheckSubscription updates the subscription information of a node from the subscription server.
+func (c *VirtualEnvironmentClient) CheckSubscription(nodeName string, d *VirtualEnvironmentSubscriptionCheckRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/subscription", url.PathEscape(nodeName)), d, nil)
+}
+
+// DeleteSubscription removes the subscription key from a node.
+func (c *VirtualEnvironmentClient) DeleteSubscription(nodeName string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("nodes/%s/subscription", url.PathEscape(nodeName)), nil, nil)
+}
+
+// GetSubscription retrieves the subscription information of a node.
+func (c *VirtualEnvironmentClient) GetSubscription(nodeName string) (*VirtualEnvironmentSubscriptionGetResponseData, error) {
+	resBody := &VirtualEnvironmentSubscriptionGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/subscription", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateSubscription sets the subscription key of a node.
+func (c *VirtualEnvironmentClient) UpdateSubscription(nodeName string, d *VirtualEnvironmentSubscriptionUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/subscription", url.PathEscape(nodeName)), d, nil)
+}
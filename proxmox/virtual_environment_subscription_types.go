@@ -0,0 +1,33 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentSubscriptionCheckRequestBody contains the data for a subscription check request.
+type VirtualEnvironmentSubscriptionCheckRequestBody struct {
+	Force *CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
+
+// VirtualEnvironmentSubscriptionGetResponseBody contains the body from a subscription get response.
+type VirtualEnvironmentSubscriptionGetResponseBody struct {
+	Data *VirtualEnvironmentSubscriptionGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentSubscriptionGetResponseData contains the data from a subscription get response.
+type VirtualEnvironmentSubscriptionGetResponseData struct {
+	Key          *string `json:"key,omitempty"`
+	Level        *string `json:"level,omitempty"`
+	Message      *string `json:"message,omitempty"`
+	NextDueDate  *string `json:"nextduedate,omitempty"`
+	ProductName  *string `json:"productname,omitempty"`
+	RegisterDate *string `json:"regdate,omitempty"`
+	ServerID     *string `json:"serverid,omitempty"`
+	Sockets      *int    `json:"sockets,omitempty"`
+	Status       string  `json:"status"`
+}
+
+// VirtualEnvironmentSubscriptionUpdateRequestBody contains the data for a subscription update request.
+type VirtualEnvironmentSubscriptionUpdateRequestBody struct {
+	Key string `json:"key" url:"key"`
+}
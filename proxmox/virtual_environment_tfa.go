@@ -0,0 +1,178 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPDigits contains the number of digits in a TOTP code.
+	TOTPDigits = 6
+
+	// TOTPPeriod contains the number of seconds a TOTP code is valid for.
+	TOTPPeriod = 30
+)
+
+// CreateUserTFA adds a second factor to a user.
+func (c *VirtualEnvironmentClient) CreateUserTFA(userID string, d *VirtualEnvironmentTFACreateRequestBody) (*VirtualEnvironmentTFACreateResponseData, error) {
+	resBody := &VirtualEnvironmentTFACreateResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("access/tfa/%s", url.PathEscape(userID)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// DeleteUserTFA removes a second factor from a user.
+func (c *VirtualEnvironmentClient) DeleteUserTFA(userID, id string, d *VirtualEnvironmentTFADeleteRequestBody) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("access/tfa/%s/%s", url.PathEscape(userID), url.PathEscape(id)), d, nil)
+}
+
+// GetUserTFA retrieves a second factor.
+func (c *VirtualEnvironmentClient) GetUserTFA(userID, id string) (*VirtualEnvironmentTFAGetResponseData, error) {
+	resBody := &VirtualEnvironmentTFAGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("access/tfa/%s/%s", url.PathEscape(userID), url.PathEscape(id)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListTFA retrieves the second factors of all users.
+func (c *VirtualEnvironmentClient) ListTFA() ([]*VirtualEnvironmentTFAListResponseData, error) {
+	resBody := &VirtualEnvironmentTFAListResponseBody{}
+	err := c.DoRequest(hmGET, "access/tfa", nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].UserID < resBody.Data[j].UserID
+	})
+
+	return resBody.Data, nil
+}
+
+// ListUserTFA retrieves the second factors of a user.
+func (c *VirtualEnvironmentClient) ListUserTFA(userID string) ([]*VirtualEnvironmentTFAGetResponseData, error) {
+	resBody := &VirtualEnvironmentTFAUserListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("access/tfa/%s", url.PathEscape(userID)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateUserTFA updates a second factor.
+func (c *VirtualEnvironmentClient) UpdateUserTFA(userID, id string, d *VirtualEnvironmentTFAUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("access/tfa/%s/%s", url.PathEscape(userID), url.PathEscape(id)), d, nil)
+}
+
+// GenerateTOTPCode generates the TOTP code for a base32 encoded secret at the specified time (RFC 6238).
+func GenerateTOTPCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+
+	if err != nil {
+		return "", err
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(t.Unix()/TOTPPeriod))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, code%1000000), nil
+}
+
+// GenerateTOTPSecret generates a random base32 encoded secret with a length of 160 bits.
+func GenerateTOTPSecret() (string, error) {
+	key := make([]byte, 20)
+	_, err := rand.Read(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(key), nil
+}
+
+// GetTOTPProvisioningURI returns the key URI, which authenticator apps use to enroll a TOTP secret.
+func GetTOTPProvisioningURI(issuer, accountName, secret string) string {
+	values := url.Values{}
+
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprintf("%d", TOTPDigits))
+	values.Set("issuer", issuer)
+	values.Set("period", fmt.Sprintf("%d", TOTPPeriod))
+	values.Set("secret", secret)
+
+	return fmt.Sprintf(
+		"otpauth://totp/%s:%s?%s",
+		url.PathEscape(issuer),
+		url.PathEscape(accountName),
+		strings.Replace(values.Encode(), "+", "%20", -1),
+	)
+}
+
+// ValidateTOTPSecret ensures that a TOTP secret is valid base32.
+func ValidateTOTPSecret(secret string) error {
+	_, err := decodeTOTPSecret(secret)
+
+	return err
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.Replace(secret, " ", "", -1))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(s, "="))
+
+	if err != nil || len(key) == 0 {
+		return nil, errors.New("The TOTP secret must be a base32 encoded string")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,69 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentTFACreateRequestBody contains the data for a TFA create request.
+type VirtualEnvironmentTFACreateRequestBody struct {
+	Challenge   *string `json:"challenge,omitempty" url:"challenge,omitempty"`
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
+	Password    *string `json:"password,omitempty" url:"password,omitempty"`
+	TOTP        *string `json:"totp,omitempty" url:"totp,omitempty"`
+	Type        string  `json:"type" url:"type"`
+	Value       *string `json:"value,omitempty" url:"value,omitempty"`
+}
+
+// VirtualEnvironmentTFACreateResponseBody contains the body from a TFA create response.
+type VirtualEnvironmentTFACreateResponseBody struct {
+	Data *VirtualEnvironmentTFACreateResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentTFACreateResponseData contains the data from a TFA create response.
+type VirtualEnvironmentTFACreateResponseData struct {
+	Challenge *string  `json:"challenge,omitempty"`
+	ID        string   `json:"id"`
+	Recovery  []string `json:"recovery,omitempty"`
+}
+
+// VirtualEnvironmentTFADeleteRequestBody contains the data for a TFA delete request.
+type VirtualEnvironmentTFADeleteRequestBody struct {
+	Password *string `json:"password,omitempty" url:"password,omitempty"`
+}
+
+// VirtualEnvironmentTFAGetResponseBody contains the body from a TFA get response.
+type VirtualEnvironmentTFAGetResponseBody struct {
+	Data *VirtualEnvironmentTFAGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentTFAGetResponseData contains the data from a TFA get response.
+type VirtualEnvironmentTFAGetResponseData struct {
+	CreationDate *CustomTimestamp `json:"created,omitempty"`
+	Description  *string          `json:"description,omitempty"`
+	Enabled      *CustomBool      `json:"enable,omitempty"`
+	ID           string           `json:"id"`
+	Type         string           `json:"type"`
+}
+
+// VirtualEnvironmentTFAListResponseBody contains the body from a TFA list response.
+type VirtualEnvironmentTFAListResponseBody struct {
+	Data []*VirtualEnvironmentTFAListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentTFAListResponseData contains the data from a TFA list response.
+type VirtualEnvironmentTFAListResponseData struct {
+	Entries []*VirtualEnvironmentTFAGetResponseData `json:"entries,omitempty"`
+	UserID  string                                  `json:"userid"`
+}
+
+// VirtualEnvironmentTFAUpdateRequestBody contains the data for a TFA update request.
+type VirtualEnvironmentTFAUpdateRequestBody struct {
+	Description *string     `json:"description,omitempty" url:"description,omitempty"`
+	Enabled     *CustomBool `json:"enable,omitempty" url:"enable,omitempty,int"`
+	Password    *string     `json:"password,omitempty" url:"password,omitempty"`
+}
+
+// VirtualEnvironmentTFAUserListResponseBody contains the body from a user TFA list response.
+type VirtualEnvironmentTFAUserListResponseBody struct {
+	Data []*VirtualEnvironmentTFAGetResponseData `json:"data,omitempty"`
+}
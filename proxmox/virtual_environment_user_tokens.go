@@ -0,0 +1,74 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// CreateUserToken creates an API token and returns its secret, which cannot be retrieved at a later time.
+func (c *VirtualEnvironmentClient) CreateUserToken(userID, tokenID string, d *VirtualEnvironmentUserTokenCreateRequestBody) (*VirtualEnvironmentUserTokenCreateResponseData, error) {
+	resBody := &VirtualEnvironmentUserTokenCreateResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("access/users/%s/token/%s", url.PathEscape(userID), url.PathEscape(tokenID)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// DeleteUserToken deletes an API token.
+func (c *VirtualEnvironmentClient) DeleteUserToken(userID, tokenID string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("access/users/%s/token/%s", url.PathEscape(userID), url.PathEscape(tokenID)), nil, nil)
+}
+
+// GetUserToken retrieves an API token.
+func (c *VirtualEnvironmentClient) GetUserToken(userID, tokenID string) (*VirtualEnvironmentUserTokenGetResponseData, error) {
+	resBody := &VirtualEnvironmentUserTokenGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("access/users/%s/token/%s", url.PathEscape(userID), url.PathEscape(tokenID)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// ListUserTokens retrieves a list of API tokens.
+func (c *VirtualEnvironmentClient) ListUserTokens(userID string) ([]*VirtualEnvironmentUserTokenListResponseData, error) {
+	resBody := &VirtualEnvironmentUserTokenListResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("access/users/%s/token", url.PathEscape(userID)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// UpdateUserToken updates an API token.
+func (c *VirtualEnvironmentClient) UpdateUserToken(userID, tokenID string, d *VirtualEnvironmentUserTokenUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("access/users/%s/token/%s", url.PathEscape(userID), url.PathEscape(tokenID)), d, nil)
+}
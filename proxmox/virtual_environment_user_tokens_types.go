@@ -0,0 +1,56 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmox
+
+// VirtualEnvironmentUserTokenCreateRequestBody contains the data for an API token create request.
+type VirtualEnvironmentUserTokenCreateRequestBody struct {
+	Comment              *string          `json:"comment,omitempty" url:"comment,omitempty"`
+	ExpirationDate       *CustomTimestamp `json:"expire,omitempty" url:"expire,omitempty"`
+	PrivilegesSeparation *CustomBool      `json:"privsep,omitempty" url:"privsep,omitempty,int"`
+}
+
+// VirtualEnvironmentUserTokenCreateResponseBody contains the body from an API token create response.
+type VirtualEnvironmentUserTokenCreateResponseBody struct {
+	Data *VirtualEnvironmentUserTokenCreateResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentUserTokenCreateResponseData contains the data from an API token create response.
+type VirtualEnvironmentUserTokenCreateResponseData struct {
+	FullID string                                      `json:"full-tokenid"`
+	Info   *VirtualEnvironmentUserTokenGetResponseData `json:"info,omitempty"`
+	Value  string                                      `json:"value"`
+}
+
+// VirtualEnvironmentUserTokenGetResponseBody contains the body from an API token get response.
+type VirtualEnvironmentUserTokenGetResponseBody struct {
+	Data *VirtualEnvironmentUserTokenGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentUserTokenGetResponseData contains the data from an API token get response.
+type VirtualEnvironmentUserTokenGetResponseData struct {
+	Comment              *string          `json:"comment,omitempty"`
+	ExpirationDate       *CustomTimestamp `json:"expire,omitempty"`
+	PrivilegesSeparation *CustomBool      `json:"privsep,omitempty"`
+}
+
+// VirtualEnvironmentUserTokenListResponseBody contains the body from an API token list response.
+type VirtualEnvironmentUserTokenListResponseBody struct {
+	Data []*VirtualEnvironmentUserTokenListResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentUserTokenListResponseData contains the data from an API token list response.
+type VirtualEnvironmentUserTokenListResponseData struct {
+	Comment              *string          `json:"comment,omitempty"`
+	ExpirationDate       *CustomTimestamp `json:"expire,omitempty"`
+	ID                   string           `json:"tokenid"`
+	PrivilegesSeparation *CustomBool      `json:"privsep,omitempty"`
+}
+
+// VirtualEnvironmentUserTokenUpdateRequestBody contains the data for an API token update request.
+type VirtualEnvironmentUserTokenUpdateRequestBody struct {
+	Comment              *string          `json:"comment,omitempty" url:"comment,omitempty"`
+	ExpirationDate       *CustomTimestamp `json:"expire,omitempty" url:"expire,omitempty"`
+	PrivilegesSeparation *CustomBool      `json:"privsep,omitempty" url:"privsep,omitempty,int"`
+}
@@ -10,17 +10,12 @@ import (
 	"log"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 )
 
 const (
-	getVMIDStep = 1
-)
-
-var (
-	getVMIDCounter      = -1
-	getVMIDCounterMutex = &sync.Mutex{}
+	vmIDMax = 999999999
+	vmIDMin = 100
 )
 
 // CloneVM clones a virtual machine.
@@ -81,12 +76,16 @@ func (c *VirtualEnvironmentClient) GetVM(nodeName string, vmID int) (*VirtualEnv
 	return resBody.Data, nil
 }
 
-// GetVMID retrieves the next available VM identifier.
+// GetVMID retrieves the next available VM identifier, which has not already been returned by this client.
+// The identifier is only claimed once a guest is created with it, which means that callers must allocate a new identifier,
+// if the creation fails because another client has claimed it in the meantime.
 func (c *VirtualEnvironmentClient) GetVMID() (*int, error) {
-	getVMIDCounterMutex.Lock()
-	defer getVMIDCounterMutex.Unlock()
+	c.vmIDMutex.Lock()
+	defer c.vmIDMutex.Unlock()
+
+	vmID := c.vmIDNext
 
-	if getVMIDCounter < 0 {
+	if vmID < 0 {
 		nextVMID, err := c.GetClusterNextID(nil)
 
 		if err != nil {
@@ -97,32 +96,33 @@ func (c *VirtualEnvironmentClient) GetVMID() (*int, error) {
 			return nil, errors.New("Unable to retrieve the next available VM identifier")
 		}
 
-		getVMIDCounter = *nextVMID + getVMIDStep
-
-		log.Printf("[DEBUG] Determined next available VM identifier to be %d", *nextVMID)
-
-		return nextVMID, nil
+		vmID = *nextVMID
 	}
 
-	vmID := getVMIDCounter
+	if vmID < c.vmIDMin {
+		vmID = c.vmIDMin
+	}
 
-	for vmID <= 2147483637 {
+	for ; vmID <= c.vmIDMax; vmID++ {
 		_, err := c.GetClusterNextID(&vmID)
 
 		if err != nil {
-			vmID += getVMIDStep
+			// Only skip identifiers, which are in use, as other errors will not be resolved by trying the next one.
+			if strings.Contains(err.Error(), "already exists") {
+				continue
+			}
 
-			continue
+			return nil, err
 		}
 
-		getVMIDCounter = vmID + getVMIDStep
+		c.vmIDNext = vmID + 1
 
 		log.Printf("[DEBUG] Determined next available VM identifier to be %d", vmID)
 
 		return &vmID, nil
 	}
 
-	return nil, errors.New("Unable to determine the next available VM identifier")
+	return nil, fmt.Errorf("Unable to determine the next available VM identifier in the range %d-%d", c.vmIDMin, c.vmIDMax)
 }
 
 // GetVMNetworkInterfacesFromAgent retrieves the network interfaces reported by the QEMU agent.
@@ -248,6 +248,55 @@ func (c *VirtualEnvironmentClient) ResizeVMDisk(nodeName string, vmID int, d *Vi
 	return err
 }
 
+// RestoreVM restores a virtual machine from a backup.
+func (c *VirtualEnvironmentClient) RestoreVM(nodeName string, timeout int, d *VirtualEnvironmentVMCreateRequestBody) error {
+	taskID, err := c.RestoreVMAsync(nodeName, d)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.WaitForNodeTask(nodeName, *taskID, timeout, 5)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreVMAsync restores a virtual machine from a backup asynchronously.
+func (c *VirtualEnvironmentClient) RestoreVMAsync(nodeName string, d *VirtualEnvironmentVMCreateRequestBody) (*string, error) {
+	resBody := &VirtualEnvironmentVMRestoreResponseBody{}
+	err := c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/qemu", url.PathEscape(nodeName)), d, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// SetVMIDRange limits the identifiers returned by GetVMID to the specified range.
+func (c *VirtualEnvironmentClient) SetVMIDRange(min int, max int) error {
+	if min < vmIDMin || max > vmIDMax || min > max {
+		return fmt.Errorf("The VM identifier range must be within %d-%d with a minimum less than or equal to the maximum (got %d-%d)", vmIDMin, vmIDMax, min, max)
+	}
+
+	c.vmIDMutex.Lock()
+	defer c.vmIDMutex.Unlock()
+
+	c.vmIDMax = max
+	c.vmIDMin = min
+	c.vmIDNext = -1
+
+	return nil
+}
+
 // ShutdownVM shuts down a virtual machine.
 func (c *VirtualEnvironmentClient) ShutdownVM(nodeName string, vmID int, d *VirtualEnvironmentVMShutdownRequestBody) error {
 	taskID, err := c.ShutdownVMAsync(nodeName, vmID, d)
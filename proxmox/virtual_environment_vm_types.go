@@ -253,6 +253,7 @@ type VirtualEnvironmentVMCreateRequestBody struct {
 	KeyboardLayout       *string                      `json:"keyboard,omitempty" url:"keyboard,omitempty"`
 	KVMArguments         CustomLineBreakSeparatedList `json:"args,omitempty" url:"args,omitempty,space"`
 	KVMEnabled           *CustomBool                  `json:"kvm,omitempty" url:"kvm,omitempty,int"`
+	LiveRestore          *CustomBool                  `json:"live-restore,omitempty" url:"live-restore,omitempty,int"`
 	LocalTime            *CustomBool                  `json:"localtime,omitempty" url:"localtime,omitempty,int"`
 	Lock                 *string                      `json:"lock,omitempty" url:"lock,omitempty"`
 	MachineType          *string                      `json:"machine,omitempty" url:"machine,omitempty"`
@@ -280,8 +281,10 @@ type VirtualEnvironmentVMCreateRequestBody struct {
 	StartupOrder         *CustomStartupOrder          `json:"startup,omitempty" url:"startup,omitempty"`
 	TabletDeviceEnabled  *CustomBool                  `json:"tablet,omitempty" url:"tablet,omitempty,int"`
 	Tags                 *string                      `json:"tags,omitempty" url:"tags,omitempty"`
+	TargetStorage        *string                      `json:"storage,omitempty" url:"storage,omitempty"`
 	Template             *CustomBool                  `json:"template,omitempty" url:"template,omitempty,int"`
 	TimeDriftFixEnabled  *CustomBool                  `json:"tdf,omitempty" url:"tdf,omitempty,int"`
+	Unique               *CustomBool                  `json:"unique,omitempty" url:"unique,omitempty,int"`
 	USBDevices           CustomUSBDevices             `json:"usb,omitempty" url:"usb,omitempty"`
 	VGADevice            *CustomVGADevice             `json:"vga,omitempty" url:"vga,omitempty"`
 	VirtualCPUCount      *int                         `json:"vcpus,omitempty" url:"vcpus,omitempty"`
@@ -530,6 +533,11 @@ type VirtualEnvironmentVMResizeDiskRequestBody struct {
 	SkipLock *CustomBool `json:"skiplock,omitempty,int" url:"skiplock,omitempty,int"`
 }
 
+// VirtualEnvironmentVMRestoreResponseBody contains the body from a VM restore response.
+type VirtualEnvironmentVMRestoreResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
 // VirtualEnvironmentVMShutdownRequestBody contains the body for a VM shutdown request.
 type VirtualEnvironmentVMShutdownRequestBody struct {
 	ForceStop  *CustomBool `json:"forceStop,omitempty,int" url:"forceStop,omitempty,int"`
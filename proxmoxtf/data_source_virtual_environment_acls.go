@@ -0,0 +1,154 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvDataSourceVirtualEnvironmentACLsGroupID = ""
+	dvDataSourceVirtualEnvironmentACLsPath    = ""
+	dvDataSourceVirtualEnvironmentACLsTokenID = ""
+	dvDataSourceVirtualEnvironmentACLsUserID  = ""
+
+	mkDataSourceVirtualEnvironmentACLsGroupID        = "group_id"
+	mkDataSourceVirtualEnvironmentACLsPath           = "path"
+	mkDataSourceVirtualEnvironmentACLsPaths          = "paths"
+	mkDataSourceVirtualEnvironmentACLsPrincipalIDs   = "principal_ids"
+	mkDataSourceVirtualEnvironmentACLsPrincipalTypes = "principal_types"
+	mkDataSourceVirtualEnvironmentACLsPropagates     = "propagates"
+	mkDataSourceVirtualEnvironmentACLsRoleIDs        = "role_ids"
+	mkDataSourceVirtualEnvironmentACLsTokenID        = "token_id"
+	mkDataSourceVirtualEnvironmentACLsUserID         = "user_id"
+)
+
+func dataSourceVirtualEnvironmentACLs() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentACLsGroupID: {
+				Type:        schema.TypeString,
+				Description: "The group id to filter by",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentACLsGroupID,
+			},
+			mkDataSourceVirtualEnvironmentACLsPath: {
+				Type:        schema.TypeString,
+				Description: "The path to filter by",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentACLsPath,
+			},
+			mkDataSourceVirtualEnvironmentACLsPaths: {
+				Type:        schema.TypeList,
+				Description: "The paths",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentACLsPrincipalIDs: {
+				Type:        schema.TypeList,
+				Description: "The principal ids",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentACLsPrincipalTypes: {
+				Type:        schema.TypeList,
+				Description: "The principal types",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentACLsPropagates: {
+				Type:        schema.TypeList,
+				Description: "Whether to propagate to child paths",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			mkDataSourceVirtualEnvironmentACLsRoleIDs: {
+				Type:        schema.TypeList,
+				Description: "The role ids",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentACLsTokenID: {
+				Type:        schema.TypeString,
+				Description: "The API token id to filter by",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentACLsTokenID,
+			},
+			mkDataSourceVirtualEnvironmentACLsUserID: {
+				Type:        schema.TypeString,
+				Description: "The user id to filter by",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentACLsUserID,
+			},
+		},
+		Read: dataSourceVirtualEnvironmentACLsRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentACLsRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	acl, err := veClient.GetACL()
+
+	if err != nil {
+		return err
+	}
+
+	groupID := d.Get(mkDataSourceVirtualEnvironmentACLsGroupID).(string)
+	path := d.Get(mkDataSourceVirtualEnvironmentACLsPath).(string)
+	tokenID := d.Get(mkDataSourceVirtualEnvironmentACLsTokenID).(string)
+	userID := d.Get(mkDataSourceVirtualEnvironmentACLsUserID).(string)
+
+	paths := []interface{}{}
+	principalIDs := []interface{}{}
+	principalTypes := []interface{}{}
+	propagates := []interface{}{}
+	roleIDs := []interface{}{}
+
+	for _, v := range acl {
+		if path != "" && v.Path != path {
+			continue
+		}
+
+		if groupID != "" && (v.Type != "group" || v.UserOrGroupID != groupID) {
+			continue
+		}
+
+		if tokenID != "" && (v.Type != "token" || v.UserOrGroupID != tokenID) {
+			continue
+		}
+
+		if userID != "" && (v.Type != "user" || v.UserOrGroupID != userID) {
+			continue
+		}
+
+		paths = append(paths, v.Path)
+		principalIDs = append(principalIDs, v.UserOrGroupID)
+		principalTypes = append(principalTypes, v.Type)
+
+		if v.Propagate != nil {
+			propagates = append(propagates, bool(*v.Propagate))
+		} else {
+			propagates = append(propagates, false)
+		}
+
+		roleIDs = append(roleIDs, v.RoleID)
+	}
+
+	d.SetId("acls")
+
+	d.Set(mkDataSourceVirtualEnvironmentACLsPaths, paths)
+	d.Set(mkDataSourceVirtualEnvironmentACLsPrincipalIDs, principalIDs)
+	d.Set(mkDataSourceVirtualEnvironmentACLsPrincipalTypes, principalTypes)
+	d.Set(mkDataSourceVirtualEnvironmentACLsPropagates, propagates)
+	d.Set(mkDataSourceVirtualEnvironmentACLsRoleIDs, roleIDs)
+
+	return nil
+}
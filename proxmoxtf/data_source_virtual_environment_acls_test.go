@@ -0,0 +1,52 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentACLsInstantiation tests whether the DataSourceVirtualEnvironmentACLs instance can be instantiated.
+func TestDataSourceVirtualEnvironmentACLsInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentACLs()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentACLs")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentACLsSchema tests the dataSourceVirtualEnvironmentACLs schema.
+func TestDataSourceVirtualEnvironmentACLsSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentACLs()
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentACLsGroupID,
+		mkDataSourceVirtualEnvironmentACLsPath,
+		mkDataSourceVirtualEnvironmentACLsTokenID,
+		mkDataSourceVirtualEnvironmentACLsUserID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentACLsPaths,
+		mkDataSourceVirtualEnvironmentACLsPrincipalIDs,
+		mkDataSourceVirtualEnvironmentACLsPrincipalTypes,
+		mkDataSourceVirtualEnvironmentACLsPropagates,
+		mkDataSourceVirtualEnvironmentACLsRoleIDs,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentACLsGroupID:        schema.TypeString,
+		mkDataSourceVirtualEnvironmentACLsPath:           schema.TypeString,
+		mkDataSourceVirtualEnvironmentACLsPaths:          schema.TypeList,
+		mkDataSourceVirtualEnvironmentACLsPrincipalIDs:   schema.TypeList,
+		mkDataSourceVirtualEnvironmentACLsPrincipalTypes: schema.TypeList,
+		mkDataSourceVirtualEnvironmentACLsPropagates:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentACLsRoleIDs:        schema.TypeList,
+		mkDataSourceVirtualEnvironmentACLsTokenID:        schema.TypeString,
+		mkDataSourceVirtualEnvironmentACLsUserID:         schema.TypeString,
+	})
+}
@@ -0,0 +1,151 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvDataSourceVirtualEnvironmentAPTUpdatesRefresh = false
+	dvDataSourceVirtualEnvironmentAPTUpdatesTimeout = 600
+
+	mkDataSourceVirtualEnvironmentAPTUpdatesNewVersions  = "new_versions"
+	mkDataSourceVirtualEnvironmentAPTUpdatesNodeName     = "node_name"
+	mkDataSourceVirtualEnvironmentAPTUpdatesOldVersions  = "old_versions"
+	mkDataSourceVirtualEnvironmentAPTUpdatesOrigins      = "origins"
+	mkDataSourceVirtualEnvironmentAPTUpdatesPackageNames = "package_names"
+	mkDataSourceVirtualEnvironmentAPTUpdatesPriorities   = "priorities"
+	mkDataSourceVirtualEnvironmentAPTUpdatesRefresh      = "refresh"
+	mkDataSourceVirtualEnvironmentAPTUpdatesSections     = "sections"
+	mkDataSourceVirtualEnvironmentAPTUpdatesTitles       = "titles"
+)
+
+func dataSourceVirtualEnvironmentAPTUpdates() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentAPTUpdatesNewVersions: {
+				Type:        schema.TypeList,
+				Description: "The new version of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesOldVersions: {
+				Type:        schema.TypeList,
+				Description: "The installed version of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesOrigins: {
+				Type:        schema.TypeList,
+				Description: "The origin of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesPackageNames: {
+				Type:        schema.TypeList,
+				Description: "The package names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesPriorities: {
+				Type:        schema.TypeList,
+				Description: "The priority of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesRefresh: {
+				Type:        schema.TypeBool,
+				Description: "Whether to refresh the package index before listing the updates",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentAPTUpdatesRefresh,
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesSections: {
+				Type:        schema.TypeList,
+				Description: "The section of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentAPTUpdatesTitles: {
+				Type:        schema.TypeList,
+				Description: "The title of each package",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentAPTUpdatesRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentAPTUpdatesRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkDataSourceVirtualEnvironmentAPTUpdatesNodeName).(string)
+	refresh := d.Get(mkDataSourceVirtualEnvironmentAPTUpdatesRefresh).(bool)
+
+	if refresh {
+		err = veClient.RefreshAPTUpdates(nodeName, dvDataSourceVirtualEnvironmentAPTUpdatesTimeout)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	list, err := veClient.ListAPTUpdates(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	newVersions := make([]interface{}, len(list))
+	oldVersions := make([]interface{}, len(list))
+	origins := make([]interface{}, len(list))
+	packageNames := make([]interface{}, len(list))
+	priorities := make([]interface{}, len(list))
+	sections := make([]interface{}, len(list))
+	titles := make([]interface{}, len(list))
+
+	optionalString := func(v *string) string {
+		if v != nil {
+			return *v
+		}
+
+		return ""
+	}
+
+	for i, v := range list {
+		newVersions[i] = v.NewVersion
+		oldVersions[i] = optionalString(v.OldVersion)
+		origins[i] = optionalString(v.Origin)
+		packageNames[i] = v.Package
+		priorities[i] = optionalString(v.Priority)
+		sections[i] = optionalString(v.Section)
+		titles[i] = optionalString(v.Title)
+	}
+
+	d.SetId(fmt.Sprintf("%s_apt_updates", nodeName))
+
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesNewVersions, newVersions)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesOldVersions, oldVersions)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesOrigins, origins)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesPackageNames, packageNames)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesPriorities, priorities)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesSections, sections)
+	d.Set(mkDataSourceVirtualEnvironmentAPTUpdatesTitles, titles)
+
+	return nil
+}
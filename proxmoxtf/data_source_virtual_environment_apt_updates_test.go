@@ -0,0 +1,55 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentAPTUpdatesInstantiation tests whether the DataSourceVirtualEnvironmentAPTUpdates instance can be instantiated.
+func TestDataSourceVirtualEnvironmentAPTUpdatesInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentAPTUpdates()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentAPTUpdates")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentAPTUpdatesSchema tests the dataSourceVirtualEnvironmentAPTUpdates schema.
+func TestDataSourceVirtualEnvironmentAPTUpdatesSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentAPTUpdates()
+
+	testRequiredArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentAPTUpdatesNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentAPTUpdatesRefresh,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentAPTUpdatesNewVersions,
+		mkDataSourceVirtualEnvironmentAPTUpdatesOldVersions,
+		mkDataSourceVirtualEnvironmentAPTUpdatesOrigins,
+		mkDataSourceVirtualEnvironmentAPTUpdatesPackageNames,
+		mkDataSourceVirtualEnvironmentAPTUpdatesPriorities,
+		mkDataSourceVirtualEnvironmentAPTUpdatesSections,
+		mkDataSourceVirtualEnvironmentAPTUpdatesTitles,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentAPTUpdatesNewVersions:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesNodeName:     schema.TypeString,
+		mkDataSourceVirtualEnvironmentAPTUpdatesOldVersions:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesOrigins:      schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesPackageNames: schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesPriorities:   schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesRefresh:      schema.TypeBool,
+		mkDataSourceVirtualEnvironmentAPTUpdatesSections:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentAPTUpdatesTitles:       schema.TypeList,
+	})
+}
@@ -0,0 +1,372 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	mkDataSourceVirtualEnvironmentClusterResourcesCPUCount        = "cpu_count"
+	mkDataSourceVirtualEnvironmentClusterResourcesCPUUtilization  = "cpu_utilization"
+	mkDataSourceVirtualEnvironmentClusterResourcesDiskAvailable   = "disk_available"
+	mkDataSourceVirtualEnvironmentClusterResourcesDiskUsed        = "disk_used"
+	mkDataSourceVirtualEnvironmentClusterResourcesIDs             = "ids"
+	mkDataSourceVirtualEnvironmentClusterResourcesMemoryAvailable = "memory_available"
+	mkDataSourceVirtualEnvironmentClusterResourcesMemoryUsed      = "memory_used"
+	mkDataSourceVirtualEnvironmentClusterResourcesNameRegex       = "name_regex"
+	mkDataSourceVirtualEnvironmentClusterResourcesNames           = "names"
+	mkDataSourceVirtualEnvironmentClusterResourcesNodeNames       = "node_names"
+	mkDataSourceVirtualEnvironmentClusterResourcesPoolIDs         = "pool_ids"
+	mkDataSourceVirtualEnvironmentClusterResourcesResourceTags    = "resource_tags"
+	mkDataSourceVirtualEnvironmentClusterResourcesStatuses        = "statuses"
+	mkDataSourceVirtualEnvironmentClusterResourcesTags            = "tags"
+	mkDataSourceVirtualEnvironmentClusterResourcesTemplates       = "templates"
+	mkDataSourceVirtualEnvironmentClusterResourcesType            = "type"
+	mkDataSourceVirtualEnvironmentClusterResourcesTypes           = "types"
+	mkDataSourceVirtualEnvironmentClusterResourcesUptime          = "uptime"
+	mkDataSourceVirtualEnvironmentClusterResourcesVMIDs           = "vm_ids"
+)
+
+func dataSourceVirtualEnvironmentClusterResources() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentClusterResourcesCPUCount: {
+				Type:        schema.TypeList,
+				Description: "The CPU count for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesCPUUtilization: {
+				Type:        schema.TypeList,
+				Description: "The CPU utilization of each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesDiskAvailable: {
+				Type:        schema.TypeList,
+				Description: "The available disk space in bytes for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesDiskUsed: {
+				Type:        schema.TypeList,
+				Description: "The used disk space in bytes for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesIDs: {
+				Type:        schema.TypeList,
+				Description: "The resource ids",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesMemoryAvailable: {
+				Type:        schema.TypeList,
+				Description: "The available memory in bytes for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesMemoryUsed: {
+				Type:        schema.TypeList,
+				Description: "The used memory in bytes for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesNameRegex: {
+				Type:         schema.TypeString,
+				Description:  "The regular expression, which the resource names must match",
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesNames: {
+				Type:        schema.TypeList,
+				Description: "The resource names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesNodeNames: {
+				Type:        schema.TypeList,
+				Description: "The name of the node hosting each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesPoolIDs: {
+				Type:        schema.TypeList,
+				Description: "The pool id for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesResourceTags: {
+				Type:        schema.TypeList,
+				Description: "The tags for each resource",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeList,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesStatuses: {
+				Type:        schema.TypeList,
+				Description: "The status of each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesTags: {
+				Type:        schema.TypeList,
+				Description: "The tags, which the resources must have",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesTemplates: {
+				Type:        schema.TypeList,
+				Description: "Whether a resource is a template",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesType: {
+				Type:         schema.TypeString,
+				Description:  "The resource type",
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringInSlice([]string{"", "node", "pool", "sdn", "storage", "vm"}, false),
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesTypes: {
+				Type:        schema.TypeList,
+				Description: "The type of each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesUptime: {
+				Type:        schema.TypeList,
+				Description: "The uptime in seconds for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentClusterResourcesVMIDs: {
+				Type:        schema.TypeList,
+				Description: "The VM id for each resource",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentClusterResourcesRead,
+	}
+}
+
+// dataSourceVirtualEnvironmentClusterResourcesGetName returns the name of a resource, as only guests have an actual name.
+func dataSourceVirtualEnvironmentClusterResourcesGetName(v *proxmox.VirtualEnvironmentClusterResourceListResponseData) string {
+	for _, name := range []*string{v.Name, v.Storage, v.SDN, v.PoolID, v.NodeName} {
+		if name != nil {
+			return *name
+		}
+	}
+
+	return ""
+}
+
+// dataSourceVirtualEnvironmentClusterResourcesGetTags splits the tags of a resource.
+func dataSourceVirtualEnvironmentClusterResourcesGetTags(v *proxmox.VirtualEnvironmentClusterResourceListResponseData) []string {
+	if v.Tags == nil {
+		return []string{}
+	}
+
+	return getTagList(*v.Tags)
+}
+
+func dataSourceVirtualEnvironmentClusterResourcesRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nameRegex := regexp.MustCompile(d.Get(mkDataSourceVirtualEnvironmentClusterResourcesNameRegex).(string))
+	resourceType := d.Get(mkDataSourceVirtualEnvironmentClusterResourcesType).(string)
+	tags := d.Get(mkDataSourceVirtualEnvironmentClusterResourcesTags).([]interface{})
+
+	body := &proxmox.VirtualEnvironmentClusterResourceListRequestBody{}
+
+	// Older API versions do not support filtering pools, which is why they are filtered on our end.
+	if resourceType != "" && resourceType != "pool" {
+		body.Type = &resourceType
+	}
+
+	list, err := veClient.ListClusterResources(body)
+
+	if err != nil {
+		return err
+	}
+
+	cpuCount := []interface{}{}
+	cpuUtilization := []interface{}{}
+	diskAvailable := []interface{}{}
+	diskUsed := []interface{}{}
+	ids := []interface{}{}
+	memoryAvailable := []interface{}{}
+	memoryUsed := []interface{}{}
+	names := []interface{}{}
+	nodeNames := []interface{}{}
+	poolIDs := []interface{}{}
+	resourceTags := []interface{}{}
+	statuses := []interface{}{}
+	templates := []interface{}{}
+	types := []interface{}{}
+	uptime := []interface{}{}
+	vmIDs := []interface{}{}
+
+	for _, v := range list {
+		if resourceType == "pool" && v.Type != "pool" {
+			continue
+		}
+
+		name := dataSourceVirtualEnvironmentClusterResourcesGetName(v)
+
+		if !nameRegex.MatchString(name) {
+			continue
+		}
+
+		currentTags := dataSourceVirtualEnvironmentClusterResourcesGetTags(v)
+		tagsMatch := true
+
+		for _, t := range tags {
+			found := false
+
+			for _, ct := range currentTags {
+				if ct == strings.ToLower(t.(string)) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				tagsMatch = false
+				break
+			}
+		}
+
+		if !tagsMatch {
+			continue
+		}
+
+		if v.CPUCount != nil {
+			cpuCount = append(cpuCount, int(*v.CPUCount))
+		} else {
+			cpuCount = append(cpuCount, 0)
+		}
+
+		if v.CPUUtilization != nil {
+			cpuUtilization = append(cpuUtilization, math.Round(*v.CPUUtilization*100)/100)
+		} else {
+			cpuUtilization = append(cpuUtilization, 0)
+		}
+
+		if v.DiskAvailable != nil {
+			diskAvailable = append(diskAvailable, *v.DiskAvailable)
+		} else {
+			diskAvailable = append(diskAvailable, 0)
+		}
+
+		if v.DiskUsed != nil {
+			diskUsed = append(diskUsed, *v.DiskUsed)
+		} else {
+			diskUsed = append(diskUsed, 0)
+		}
+
+		ids = append(ids, v.ID)
+
+		if v.MemoryAvailable != nil {
+			memoryAvailable = append(memoryAvailable, *v.MemoryAvailable)
+		} else {
+			memoryAvailable = append(memoryAvailable, 0)
+		}
+
+		if v.MemoryUsed != nil {
+			memoryUsed = append(memoryUsed, *v.MemoryUsed)
+		} else {
+			memoryUsed = append(memoryUsed, 0)
+		}
+
+		names = append(names, name)
+
+		if v.NodeName != nil {
+			nodeNames = append(nodeNames, *v.NodeName)
+		} else {
+			nodeNames = append(nodeNames, "")
+		}
+
+		if v.PoolID != nil {
+			poolIDs = append(poolIDs, *v.PoolID)
+		} else {
+			poolIDs = append(poolIDs, "")
+		}
+
+		currentTagsList := make([]interface{}, len(currentTags))
+
+		for i, t := range currentTags {
+			currentTagsList[i] = t
+		}
+
+		resourceTags = append(resourceTags, currentTagsList)
+
+		if v.Status != nil {
+			statuses = append(statuses, *v.Status)
+		} else {
+			statuses = append(statuses, "")
+		}
+
+		if v.Template != nil {
+			templates = append(templates, bool(*v.Template))
+		} else {
+			templates = append(templates, false)
+		}
+
+		types = append(types, v.Type)
+
+		if v.Uptime != nil {
+			uptime = append(uptime, *v.Uptime)
+		} else {
+			uptime = append(uptime, 0)
+		}
+
+		if v.VMID != nil {
+			vmIDs = append(vmIDs, *v.VMID)
+		} else {
+			vmIDs = append(vmIDs, 0)
+		}
+	}
+
+	d.SetId("cluster_resources")
+
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesCPUCount, cpuCount)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesCPUUtilization, cpuUtilization)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesDiskAvailable, diskAvailable)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesDiskUsed, diskUsed)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesIDs, ids)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesMemoryAvailable, memoryAvailable)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesMemoryUsed, memoryUsed)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesNames, names)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesNodeNames, nodeNames)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesPoolIDs, poolIDs)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesResourceTags, resourceTags)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesStatuses, statuses)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesTemplates, templates)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesTypes, types)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesUptime, uptime)
+	d.Set(mkDataSourceVirtualEnvironmentClusterResourcesVMIDs, vmIDs)
+
+	return nil
+}
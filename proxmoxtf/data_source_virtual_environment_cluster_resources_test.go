@@ -0,0 +1,72 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentClusterResourcesInstantiation tests whether the DataSourceVirtualEnvironmentClusterResources instance can be instantiated.
+func TestDataSourceVirtualEnvironmentClusterResourcesInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentClusterResources()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentClusterResources")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentClusterResourcesSchema tests the dataSourceVirtualEnvironmentClusterResources schema.
+func TestDataSourceVirtualEnvironmentClusterResourcesSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentClusterResources()
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentClusterResourcesNameRegex,
+		mkDataSourceVirtualEnvironmentClusterResourcesTags,
+		mkDataSourceVirtualEnvironmentClusterResourcesType,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentClusterResourcesCPUCount,
+		mkDataSourceVirtualEnvironmentClusterResourcesCPUUtilization,
+		mkDataSourceVirtualEnvironmentClusterResourcesDiskAvailable,
+		mkDataSourceVirtualEnvironmentClusterResourcesDiskUsed,
+		mkDataSourceVirtualEnvironmentClusterResourcesIDs,
+		mkDataSourceVirtualEnvironmentClusterResourcesMemoryAvailable,
+		mkDataSourceVirtualEnvironmentClusterResourcesMemoryUsed,
+		mkDataSourceVirtualEnvironmentClusterResourcesNames,
+		mkDataSourceVirtualEnvironmentClusterResourcesNodeNames,
+		mkDataSourceVirtualEnvironmentClusterResourcesPoolIDs,
+		mkDataSourceVirtualEnvironmentClusterResourcesResourceTags,
+		mkDataSourceVirtualEnvironmentClusterResourcesStatuses,
+		mkDataSourceVirtualEnvironmentClusterResourcesTemplates,
+		mkDataSourceVirtualEnvironmentClusterResourcesTypes,
+		mkDataSourceVirtualEnvironmentClusterResourcesUptime,
+		mkDataSourceVirtualEnvironmentClusterResourcesVMIDs,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentClusterResourcesCPUCount:        schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesCPUUtilization:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesDiskAvailable:   schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesDiskUsed:        schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesIDs:             schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesMemoryAvailable: schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesMemoryUsed:      schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesNameRegex:       schema.TypeString,
+		mkDataSourceVirtualEnvironmentClusterResourcesNames:           schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesNodeNames:       schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesPoolIDs:         schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesResourceTags:    schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesStatuses:        schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesTags:            schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesTemplates:       schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesType:            schema.TypeString,
+		mkDataSourceVirtualEnvironmentClusterResourcesTypes:           schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesUptime:          schema.TypeList,
+		mkDataSourceVirtualEnvironmentClusterResourcesVMIDs:           schema.TypeList,
+	})
+}
@@ -0,0 +1,255 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvDataSourceVirtualEnvironmentDatastoreFilesContentType   = ""
+	dvDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex = ""
+	dvDataSourceVirtualEnvironmentDatastoreFilesVMID          = -1
+
+	mkDataSourceVirtualEnvironmentDatastoreFilesContentType   = "content_type"
+	mkDataSourceVirtualEnvironmentDatastoreFilesContentTypes  = "content_types"
+	mkDataSourceVirtualEnvironmentDatastoreFilesCreationTimes = "creation_times"
+	mkDataSourceVirtualEnvironmentDatastoreFilesDatastoreID   = "datastore_id"
+	mkDataSourceVirtualEnvironmentDatastoreFilesFileFormats   = "file_formats"
+	mkDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex = "file_name_regex"
+	mkDataSourceVirtualEnvironmentDatastoreFilesFileNames     = "file_names"
+	mkDataSourceVirtualEnvironmentDatastoreFilesFileSizes     = "file_sizes"
+	mkDataSourceVirtualEnvironmentDatastoreFilesLatestVolume  = "latest_volume_id"
+	mkDataSourceVirtualEnvironmentDatastoreFilesNodeName      = "node_name"
+	mkDataSourceVirtualEnvironmentDatastoreFilesNotes         = "notes"
+	mkDataSourceVirtualEnvironmentDatastoreFilesVMID          = "vm_id"
+	mkDataSourceVirtualEnvironmentDatastoreFilesVMIDs         = "vm_ids"
+	mkDataSourceVirtualEnvironmentDatastoreFilesVolumeIDs     = "volume_ids"
+)
+
+func dataSourceVirtualEnvironmentDatastoreFiles() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentDatastoreFilesContentType: {
+				Type:         schema.TypeString,
+				Description:  "The content type to filter by",
+				Optional:     true,
+				Default:      dvDataSourceVirtualEnvironmentDatastoreFilesContentType,
+				ValidateFunc: getDatastoreContentTypeValidator(),
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesContentTypes: {
+				Type:        schema.TypeList,
+				Description: "The content types",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesCreationTimes: {
+				Type:        schema.TypeList,
+				Description: "The creation times",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesDatastoreID: {
+				Type:        schema.TypeString,
+				Description: "The datastore id",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesFileFormats: {
+				Type:        schema.TypeList,
+				Description: "The file formats",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex: {
+				Type:         schema.TypeString,
+				Description:  "The regular expression to filter file names by",
+				Optional:     true,
+				Default:      dvDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesFileNames: {
+				Type:        schema.TypeList,
+				Description: "The file names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesFileSizes: {
+				Type:        schema.TypeList,
+				Description: "The file sizes in bytes",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesLatestVolume: {
+				Type:        schema.TypeString,
+				Description: "The volume id of the most recently created file",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesNotes: {
+				Type:        schema.TypeList,
+				Description: "The notes",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesVMID: {
+				Type:         schema.TypeInt,
+				Description:  "The VM identifier to filter by",
+				Optional:     true,
+				Default:      dvDataSourceVirtualEnvironmentDatastoreFilesVMID,
+				ValidateFunc: getVMIDValidator(),
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesVMIDs: {
+				Type:        schema.TypeList,
+				Description: "The VM identifiers of the owners",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentDatastoreFilesVolumeIDs: {
+				Type:        schema.TypeList,
+				Description: "The volume ids",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentDatastoreFilesRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentDatastoreFilesRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	contentType := d.Get(mkDataSourceVirtualEnvironmentDatastoreFilesContentType).(string)
+	datastoreID := d.Get(mkDataSourceVirtualEnvironmentDatastoreFilesDatastoreID).(string)
+	fileNameRegex := d.Get(mkDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex).(string)
+	nodeName := d.Get(mkDataSourceVirtualEnvironmentDatastoreFilesNodeName).(string)
+	vmID := d.Get(mkDataSourceVirtualEnvironmentDatastoreFilesVMID).(int)
+
+	body := &proxmox.VirtualEnvironmentDatastoreFileListRequestBody{}
+
+	if contentType != "" {
+		body.ContentType = &contentType
+	}
+
+	if vmID != -1 {
+		body.VMID = &vmID
+	}
+
+	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID, body)
+
+	if err != nil {
+		return err
+	}
+
+	var fileNameRegexp *regexp.Regexp
+
+	if fileNameRegex != "" {
+		fileNameRegexp, err = regexp.Compile(fileNameRegex)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	contentTypes := []interface{}{}
+	creationTimes := []interface{}{}
+	fileFormats := []interface{}{}
+	fileNames := []interface{}{}
+	fileSizes := []interface{}{}
+	notes := []interface{}{}
+	vmIDs := []interface{}{}
+	volumeIDs := []interface{}{}
+
+	latestCreationTime := time.Time{}
+	latestVolumeID := ""
+
+	for _, v := range list {
+		fileName := dataSourceVirtualEnvironmentDatastoreFilesGetFileName(v.VolumeID)
+
+		if fileNameRegexp != nil && !fileNameRegexp.MatchString(fileName) {
+			continue
+		}
+
+		contentTypes = append(contentTypes, v.ContentType)
+
+		if v.CreationTime != nil {
+			creationTime := time.Time(*v.CreationTime)
+			creationTimes = append(creationTimes, creationTime.Format(time.RFC3339))
+
+			if latestVolumeID == "" || creationTime.After(latestCreationTime) {
+				latestCreationTime = creationTime
+				latestVolumeID = v.VolumeID
+			}
+		} else {
+			creationTimes = append(creationTimes, "")
+
+			if latestVolumeID == "" {
+				latestVolumeID = v.VolumeID
+			}
+		}
+
+		fileFormats = append(fileFormats, v.FileFormat)
+		fileNames = append(fileNames, fileName)
+		fileSizes = append(fileSizes, v.FileSize)
+
+		if v.Notes != nil {
+			notes = append(notes, *v.Notes)
+		} else {
+			notes = append(notes, "")
+		}
+
+		if v.VMID != nil {
+			vmIDs = append(vmIDs, *v.VMID)
+		} else {
+			vmIDs = append(vmIDs, 0)
+		}
+
+		volumeIDs = append(volumeIDs, v.VolumeID)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_files", nodeName, datastoreID))
+
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesContentTypes, contentTypes)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesCreationTimes, creationTimes)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesFileFormats, fileFormats)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesFileNames, fileNames)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesFileSizes, fileSizes)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesLatestVolume, latestVolumeID)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesNotes, notes)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesVMIDs, vmIDs)
+	d.Set(mkDataSourceVirtualEnvironmentDatastoreFilesVolumeIDs, volumeIDs)
+
+	return nil
+}
+
+func dataSourceVirtualEnvironmentDatastoreFilesGetFileName(volumeID string) string {
+	// Volume identifiers are either of the form "datastore:content/file" or "datastore:file".
+	fileName := volumeID
+
+	if i := strings.Index(fileName, ":"); i >= 0 {
+		fileName = fileName[i+1:]
+	}
+
+	if i := strings.LastIndex(fileName, "/"); i >= 0 {
+		fileName = fileName[i+1:]
+	}
+
+	return fileName
+}
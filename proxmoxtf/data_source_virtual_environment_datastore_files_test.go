@@ -0,0 +1,65 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentDatastoreFilesInstantiation tests whether the DataSourceVirtualEnvironmentDatastoreFiles instance can be instantiated.
+func TestDataSourceVirtualEnvironmentDatastoreFilesInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentDatastoreFiles()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentDatastoreFiles")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentDatastoreFilesSchema tests the dataSourceVirtualEnvironmentDatastoreFiles schema.
+func TestDataSourceVirtualEnvironmentDatastoreFilesSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentDatastoreFiles()
+
+	testRequiredArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentDatastoreFilesDatastoreID,
+		mkDataSourceVirtualEnvironmentDatastoreFilesNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentDatastoreFilesContentType,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVMID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentDatastoreFilesContentTypes,
+		mkDataSourceVirtualEnvironmentDatastoreFilesCreationTimes,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileFormats,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileNames,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileSizes,
+		mkDataSourceVirtualEnvironmentDatastoreFilesLatestVolume,
+		mkDataSourceVirtualEnvironmentDatastoreFilesNotes,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVMIDs,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVolumeIDs,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentDatastoreFilesContentType:   schema.TypeString,
+		mkDataSourceVirtualEnvironmentDatastoreFilesContentTypes:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesCreationTimes: schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesDatastoreID:   schema.TypeString,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileFormats:   schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileNameRegex: schema.TypeString,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileNames:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesFileSizes:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesLatestVolume:  schema.TypeString,
+		mkDataSourceVirtualEnvironmentDatastoreFilesNodeName:      schema.TypeString,
+		mkDataSourceVirtualEnvironmentDatastoreFilesNotes:         schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVMID:          schema.TypeInt,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVMIDs:         schema.TypeList,
+		mkDataSourceVirtualEnvironmentDatastoreFilesVolumeIDs:     schema.TypeList,
+	})
+}
@@ -0,0 +1,216 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	mkDataSourceVirtualEnvironmentGuestsGuestTags = "guest_tags"
+	mkDataSourceVirtualEnvironmentGuestsNames     = "names"
+	mkDataSourceVirtualEnvironmentGuestsNodeName  = "node_name"
+	mkDataSourceVirtualEnvironmentGuestsNodeNames = "node_names"
+	mkDataSourceVirtualEnvironmentGuestsStatuses  = "statuses"
+	mkDataSourceVirtualEnvironmentGuestsTags      = "tags"
+	mkDataSourceVirtualEnvironmentGuestsTemplates = "templates"
+	mkDataSourceVirtualEnvironmentGuestsType      = "type"
+	mkDataSourceVirtualEnvironmentGuestsTypes     = "types"
+	mkDataSourceVirtualEnvironmentGuestsVMIDs     = "vm_ids"
+)
+
+func dataSourceVirtualEnvironmentGuests() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentGuestsGuestTags: {
+				Type:        schema.TypeList,
+				Description: "The tags for each guest",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeList,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			mkDataSourceVirtualEnvironmentGuestsNames: {
+				Type:        schema.TypeList,
+				Description: "The guest names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentGuestsNodeName: {
+				Type:        schema.TypeString,
+				Description: "The name of the node, which the guests must be hosted on",
+				Optional:    true,
+				Default:     "",
+			},
+			mkDataSourceVirtualEnvironmentGuestsNodeNames: {
+				Type:        schema.TypeList,
+				Description: "The name of the node hosting each guest",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentGuestsStatuses: {
+				Type:        schema.TypeList,
+				Description: "The status of each guest",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentGuestsTags: {
+				Type:        schema.TypeList,
+				Description: "The tags, which the guests must have",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentGuestsTemplates: {
+				Type:        schema.TypeList,
+				Description: "Whether a guest is a template",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			mkDataSourceVirtualEnvironmentGuestsType: {
+				Type:         schema.TypeString,
+				Description:  "The guest type",
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringInSlice([]string{"", "lxc", "qemu"}, false),
+			},
+			mkDataSourceVirtualEnvironmentGuestsTypes: {
+				Type:        schema.TypeList,
+				Description: "The type of each guest",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentGuestsVMIDs: {
+				Type:        schema.TypeList,
+				Description: "The VM id for each guest",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentGuestsRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentGuestsRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	guestType := d.Get(mkDataSourceVirtualEnvironmentGuestsType).(string)
+	nodeName := d.Get(mkDataSourceVirtualEnvironmentGuestsNodeName).(string)
+	tags := d.Get(mkDataSourceVirtualEnvironmentGuestsTags).([]interface{})
+
+	resourceType := "vm"
+	list, err := veClient.ListClusterResources(&proxmox.VirtualEnvironmentClusterResourceListRequestBody{
+		Type: &resourceType,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	guestTags := []interface{}{}
+	names := []interface{}{}
+	nodeNames := []interface{}{}
+	statuses := []interface{}{}
+	templates := []interface{}{}
+	types := []interface{}{}
+	vmIDs := []interface{}{}
+
+	for _, v := range list {
+		if v.VMID == nil || (guestType != "" && v.Type != guestType) {
+			continue
+		}
+
+		if nodeName != "" && (v.NodeName == nil || *v.NodeName != nodeName) {
+			continue
+		}
+
+		currentTags := []string{}
+
+		if v.Tags != nil {
+			currentTags = getTagList(*v.Tags)
+		}
+
+		tagsMatch := true
+
+		for _, t := range tags {
+			found := false
+
+			for _, ct := range currentTags {
+				if ct == strings.ToLower(t.(string)) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				tagsMatch = false
+				break
+			}
+		}
+
+		if !tagsMatch {
+			continue
+		}
+
+		currentTagsList := make([]interface{}, len(currentTags))
+
+		for i, t := range currentTags {
+			currentTagsList[i] = t
+		}
+
+		guestTags = append(guestTags, currentTagsList)
+
+		if v.Name != nil {
+			names = append(names, *v.Name)
+		} else {
+			names = append(names, "")
+		}
+
+		if v.NodeName != nil {
+			nodeNames = append(nodeNames, *v.NodeName)
+		} else {
+			nodeNames = append(nodeNames, "")
+		}
+
+		if v.Status != nil {
+			statuses = append(statuses, *v.Status)
+		} else {
+			statuses = append(statuses, "")
+		}
+
+		if v.Template != nil {
+			templates = append(templates, bool(*v.Template))
+		} else {
+			templates = append(templates, false)
+		}
+
+		types = append(types, v.Type)
+		vmIDs = append(vmIDs, *v.VMID)
+	}
+
+	d.SetId("guests")
+
+	d.Set(mkDataSourceVirtualEnvironmentGuestsGuestTags, guestTags)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsNames, names)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsNodeNames, nodeNames)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsStatuses, statuses)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsTemplates, templates)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsTypes, types)
+	d.Set(mkDataSourceVirtualEnvironmentGuestsVMIDs, vmIDs)
+
+	return nil
+}
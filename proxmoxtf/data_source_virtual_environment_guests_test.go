@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentGuestsInstantiation tests whether the DataSourceVirtualEnvironmentGuests instance can be instantiated.
+func TestDataSourceVirtualEnvironmentGuestsInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentGuests()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentGuests")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentGuestsSchema tests the dataSourceVirtualEnvironmentGuests schema.
+func TestDataSourceVirtualEnvironmentGuestsSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentGuests()
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentGuestsNodeName,
+		mkDataSourceVirtualEnvironmentGuestsTags,
+		mkDataSourceVirtualEnvironmentGuestsType,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentGuestsGuestTags,
+		mkDataSourceVirtualEnvironmentGuestsNames,
+		mkDataSourceVirtualEnvironmentGuestsNodeNames,
+		mkDataSourceVirtualEnvironmentGuestsStatuses,
+		mkDataSourceVirtualEnvironmentGuestsTemplates,
+		mkDataSourceVirtualEnvironmentGuestsTypes,
+		mkDataSourceVirtualEnvironmentGuestsVMIDs,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentGuestsGuestTags: schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsNames:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsNodeName:  schema.TypeString,
+		mkDataSourceVirtualEnvironmentGuestsNodeNames: schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsStatuses:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsTags:      schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsTemplates: schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsType:      schema.TypeString,
+		mkDataSourceVirtualEnvironmentGuestsTypes:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentGuestsVMIDs:     schema.TypeList,
+	})
+}
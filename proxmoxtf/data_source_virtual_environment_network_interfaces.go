@@ -0,0 +1,194 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	mkDataSourceVirtualEnvironmentNetworkInterfacesActive    = "active"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesAutostart = "autostart"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs     = "cidrs"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs6    = "cidrs6"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesComments  = "comments"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesGateways  = "gateways"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesGateways6 = "gateways6"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesMTUs      = "mtus"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesNames     = "names"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesNodeName  = "node_name"
+	mkDataSourceVirtualEnvironmentNetworkInterfacesTypes     = "types"
+)
+
+func dataSourceVirtualEnvironmentNetworkInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentNetworkInterfacesActive: {
+				Type:        schema.TypeList,
+				Description: "Whether an interface is active",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesAutostart: {
+				Type:        schema.TypeList,
+				Description: "Whether an interface is started on boot",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs: {
+				Type:        schema.TypeList,
+				Description: "The IPv4 addresses in CIDR notation",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs6: {
+				Type:        schema.TypeList,
+				Description: "The IPv6 addresses in CIDR notation",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesComments: {
+				Type:        schema.TypeList,
+				Description: "The comments",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesGateways: {
+				Type:        schema.TypeList,
+				Description: "The IPv4 gateways",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesGateways6: {
+				Type:        schema.TypeList,
+				Description: "The IPv6 gateways",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesMTUs: {
+				Type:        schema.TypeList,
+				Description: "The MTUs",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesNames: {
+				Type:        schema.TypeList,
+				Description: "The interface names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentNetworkInterfacesTypes: {
+				Type:        schema.TypeList,
+				Description: "The interface types",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentNetworkInterfacesRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentNetworkInterfacesRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkDataSourceVirtualEnvironmentNetworkInterfacesNodeName).(string)
+	list, err := veClient.ListNodeNetworkDevices(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	active := make([]interface{}, len(list))
+	autostart := make([]interface{}, len(list))
+	cidrs := make([]interface{}, len(list))
+	cidrs6 := make([]interface{}, len(list))
+	comments := make([]interface{}, len(list))
+	gateways := make([]interface{}, len(list))
+	gateways6 := make([]interface{}, len(list))
+	mtus := make([]interface{}, len(list))
+	names := make([]interface{}, len(list))
+	types := make([]interface{}, len(list))
+
+	for i, v := range list {
+		if v.Active != nil {
+			active[i] = bool(*v.Active)
+		} else {
+			active[i] = false
+		}
+
+		if v.Autostart != nil {
+			autostart[i] = bool(*v.Autostart)
+		} else {
+			autostart[i] = false
+		}
+
+		if v.CIDR != nil {
+			cidrs[i] = *v.CIDR
+		} else {
+			cidrs[i] = ""
+		}
+
+		if v.CIDR6 != nil {
+			cidrs6[i] = *v.CIDR6
+		} else {
+			cidrs6[i] = ""
+		}
+
+		if v.Comments != nil {
+			comments[i] = strings.TrimSpace(*v.Comments)
+		} else {
+			comments[i] = ""
+		}
+
+		if v.Gateway != nil {
+			gateways[i] = *v.Gateway
+		} else {
+			gateways[i] = ""
+		}
+
+		if v.Gateway6 != nil {
+			gateways6[i] = *v.Gateway6
+		} else {
+			gateways6[i] = ""
+		}
+
+		if v.MTU != nil {
+			mtus[i] = int(*v.MTU)
+		} else {
+			mtus[i] = 0
+		}
+
+		names[i] = v.Iface
+		types[i] = v.Type
+	}
+
+	d.SetId(fmt.Sprintf("%s_network_interfaces", nodeName))
+
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesActive, active)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesAutostart, autostart)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs, cidrs)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs6, cidrs6)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesComments, comments)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesGateways, gateways)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesGateways6, gateways6)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesMTUs, mtus)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesNames, names)
+	d.Set(mkDataSourceVirtualEnvironmentNetworkInterfacesTypes, types)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentNetworkInterfacesInstantiation tests whether the DataSourceVirtualEnvironmentNetworkInterfaces instance can be instantiated.
+func TestDataSourceVirtualEnvironmentNetworkInterfacesInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentNetworkInterfaces()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentNetworkInterfaces")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentNetworkInterfacesSchema tests the dataSourceVirtualEnvironmentNetworkInterfaces schema.
+func TestDataSourceVirtualEnvironmentNetworkInterfacesSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentNetworkInterfaces()
+
+	testRequiredArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentNetworkInterfacesNodeName,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentNetworkInterfacesActive,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesAutostart,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs6,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesComments,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesGateways,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesGateways6,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesMTUs,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesNames,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesTypes,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentNetworkInterfacesActive:    schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesAutostart: schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesCIDRs6:    schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesComments:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesGateways:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesGateways6: schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesMTUs:      schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesNames:     schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesNodeName:  schema.TypeString,
+		mkDataSourceVirtualEnvironmentNetworkInterfacesTypes:     schema.TypeList,
+	})
+}
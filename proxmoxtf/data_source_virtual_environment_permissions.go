@@ -0,0 +1,197 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvDataSourceVirtualEnvironmentPermissionsPath    = ""
+	dvDataSourceVirtualEnvironmentPermissionsTokenID = ""
+	dvDataSourceVirtualEnvironmentPermissionsUserID  = ""
+
+	mkDataSourceVirtualEnvironmentPermissionsExcessPrivileges      = "excess_privileges"
+	mkDataSourceVirtualEnvironmentPermissionsHasRequiredPrivileges = "has_required_privileges"
+	mkDataSourceVirtualEnvironmentPermissionsMissingPrivileges     = "missing_privileges"
+	mkDataSourceVirtualEnvironmentPermissionsPath                  = "path"
+	mkDataSourceVirtualEnvironmentPermissionsPaths                 = "paths"
+	mkDataSourceVirtualEnvironmentPermissionsPrivileges            = "privileges"
+	mkDataSourceVirtualEnvironmentPermissionsRequiredPrivileges    = "required_privileges"
+	mkDataSourceVirtualEnvironmentPermissionsTokenID               = "token_id"
+	mkDataSourceVirtualEnvironmentPermissionsUserID                = "user_id"
+)
+
+func dataSourceVirtualEnvironmentPermissions() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentPermissionsExcessPrivileges: {
+				Type:        schema.TypeSet,
+				Description: "The privileges on the path, which are not required",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsHasRequiredPrivileges: {
+				Type:        schema.TypeBool,
+				Description: "Whether all the required privileges are granted on the path",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentPermissionsMissingPrivileges: {
+				Type:        schema.TypeSet,
+				Description: "The required privileges, which are not granted on the path",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsPath: {
+				Type:        schema.TypeString,
+				Description: "The path to retrieve the permissions for",
+				Optional:    true,
+				Default:     dvDataSourceVirtualEnvironmentPermissionsPath,
+			},
+			mkDataSourceVirtualEnvironmentPermissionsPaths: {
+				Type:        schema.TypeList,
+				Description: "The paths",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsPrivileges: {
+				Type:        schema.TypeList,
+				Description: "The effective privileges",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsRequiredPrivileges: {
+				Type:        schema.TypeSet,
+				Description: "The privileges to check for on the path",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsTokenID: {
+				Type:          schema.TypeString,
+				Description:   "The API token id",
+				Optional:      true,
+				Default:       dvDataSourceVirtualEnvironmentPermissionsTokenID,
+				ConflictsWith: []string{mkDataSourceVirtualEnvironmentPermissionsUserID},
+			},
+			mkDataSourceVirtualEnvironmentPermissionsUserID: {
+				Type:          schema.TypeString,
+				Description:   "The user id",
+				Optional:      true,
+				Default:       dvDataSourceVirtualEnvironmentPermissionsUserID,
+				ConflictsWith: []string{mkDataSourceVirtualEnvironmentPermissionsTokenID},
+			},
+		},
+		Read: dataSourceVirtualEnvironmentPermissionsRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentPermissionsRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	path := d.Get(mkDataSourceVirtualEnvironmentPermissionsPath).(string)
+	tokenID := d.Get(mkDataSourceVirtualEnvironmentPermissionsTokenID).(string)
+	userID := d.Get(mkDataSourceVirtualEnvironmentPermissionsUserID).(string)
+
+	body := &proxmox.VirtualEnvironmentPermissionsGetRequestBody{}
+
+	if path != "" {
+		body.Path = &path
+	}
+
+	// The API accepts both user and token identifiers and defaults to the authenticated user.
+	principalID := userID
+
+	if tokenID != "" {
+		principalID = tokenID
+	}
+
+	if principalID != "" {
+		body.UserID = &principalID
+	}
+
+	permissions, err := veClient.GetPermissions(body)
+
+	if err != nil {
+		return err
+	}
+
+	paths := []interface{}{}
+	privileges := []interface{}{}
+
+	for p := range permissions {
+		paths = append(paths, p)
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].(string) < paths[j].(string)
+	})
+
+	for _, v := range paths {
+		p := schema.NewSet(schema.HashString, []interface{}{})
+
+		for k := range permissions[v.(string)] {
+			p.Add(k)
+		}
+
+		privileges = append(privileges, p)
+	}
+
+	checkPath := path
+
+	if checkPath == "" {
+		checkPath = "/"
+	}
+
+	granted := map[string]bool{}
+
+	for k := range permissions[checkPath] {
+		granted[k] = true
+	}
+
+	required := map[string]bool{}
+	missingPrivileges := []interface{}{}
+
+	for _, v := range d.Get(mkDataSourceVirtualEnvironmentPermissionsRequiredPrivileges).(*schema.Set).List() {
+		required[v.(string)] = true
+
+		if !granted[v.(string)] {
+			missingPrivileges = append(missingPrivileges, v)
+		}
+	}
+
+	excessPrivileges := []interface{}{}
+
+	for k := range granted {
+		if !required[k] {
+			excessPrivileges = append(excessPrivileges, k)
+		}
+	}
+
+	if principalID != "" {
+		d.SetId(fmt.Sprintf("%s_permissions", principalID))
+	} else {
+		d.SetId("permissions")
+	}
+
+	d.Set(mkDataSourceVirtualEnvironmentPermissionsExcessPrivileges, excessPrivileges)
+	d.Set(mkDataSourceVirtualEnvironmentPermissionsHasRequiredPrivileges, len(missingPrivileges) == 0)
+	d.Set(mkDataSourceVirtualEnvironmentPermissionsMissingPrivileges, missingPrivileges)
+	d.Set(mkDataSourceVirtualEnvironmentPermissionsPaths, paths)
+	d.Set(mkDataSourceVirtualEnvironmentPermissionsPrivileges, privileges)
+
+	return nil
+}
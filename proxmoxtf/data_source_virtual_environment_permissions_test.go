@@ -0,0 +1,52 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentPermissionsInstantiation tests whether the DataSourceVirtualEnvironmentPermissions instance can be instantiated.
+func TestDataSourceVirtualEnvironmentPermissionsInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentPermissions()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentPermissions")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentPermissionsSchema tests the dataSourceVirtualEnvironmentPermissions schema.
+func TestDataSourceVirtualEnvironmentPermissionsSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentPermissions()
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentPermissionsPath,
+		mkDataSourceVirtualEnvironmentPermissionsRequiredPrivileges,
+		mkDataSourceVirtualEnvironmentPermissionsTokenID,
+		mkDataSourceVirtualEnvironmentPermissionsUserID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentPermissionsExcessPrivileges,
+		mkDataSourceVirtualEnvironmentPermissionsHasRequiredPrivileges,
+		mkDataSourceVirtualEnvironmentPermissionsMissingPrivileges,
+		mkDataSourceVirtualEnvironmentPermissionsPaths,
+		mkDataSourceVirtualEnvironmentPermissionsPrivileges,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentPermissionsExcessPrivileges:      schema.TypeSet,
+		mkDataSourceVirtualEnvironmentPermissionsHasRequiredPrivileges: schema.TypeBool,
+		mkDataSourceVirtualEnvironmentPermissionsMissingPrivileges:     schema.TypeSet,
+		mkDataSourceVirtualEnvironmentPermissionsPath:                  schema.TypeString,
+		mkDataSourceVirtualEnvironmentPermissionsPaths:                 schema.TypeList,
+		mkDataSourceVirtualEnvironmentPermissionsPrivileges:            schema.TypeList,
+		mkDataSourceVirtualEnvironmentPermissionsRequiredPrivileges:    schema.TypeSet,
+		mkDataSourceVirtualEnvironmentPermissionsTokenID:               schema.TypeString,
+		mkDataSourceVirtualEnvironmentPermissionsUserID:                schema.TypeString,
+	})
+}
@@ -0,0 +1,137 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	mkDataSourceVirtualEnvironmentReplicationStatusDuration      = "duration"
+	mkDataSourceVirtualEnvironmentReplicationStatusError         = "error"
+	mkDataSourceVirtualEnvironmentReplicationStatusFailCount     = "fail_count"
+	mkDataSourceVirtualEnvironmentReplicationStatusLastSync      = "last_sync"
+	mkDataSourceVirtualEnvironmentReplicationStatusLastTry       = "last_try"
+	mkDataSourceVirtualEnvironmentReplicationStatusNextSync      = "next_sync"
+	mkDataSourceVirtualEnvironmentReplicationStatusNodeName      = "node_name"
+	mkDataSourceVirtualEnvironmentReplicationStatusReplicationID = "replication_id"
+	mkDataSourceVirtualEnvironmentReplicationStatusTargetNode    = "target_node"
+	mkDataSourceVirtualEnvironmentReplicationStatusVMID          = "vm_id"
+)
+
+func dataSourceVirtualEnvironmentReplicationStatus() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkDataSourceVirtualEnvironmentReplicationStatusDuration: {
+				Type:        schema.TypeFloat,
+				Description: "The duration of the last synchronization in seconds",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusError: {
+				Type:        schema.TypeString,
+				Description: "The error message of the last failed synchronization",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusFailCount: {
+				Type:        schema.TypeInt,
+				Description: "The number of consecutive failed synchronizations",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusLastSync: {
+				Type:        schema.TypeString,
+				Description: "The timestamp of the last successful synchronization",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusLastTry: {
+				Type:        schema.TypeString,
+				Description: "The timestamp of the last synchronization attempt",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusNextSync: {
+				Type:        schema.TypeString,
+				Description: "The timestamp of the next scheduled synchronization",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusNodeName: {
+				Type:        schema.TypeString,
+				Description: "The name of the source node",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusReplicationID: {
+				Type:        schema.TypeString,
+				Description: "The replication job id",
+				Required:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusTargetNode: {
+				Type:        schema.TypeString,
+				Description: "The name of the target node",
+				Computed:    true,
+			},
+			mkDataSourceVirtualEnvironmentReplicationStatusVMID: {
+				Type:        schema.TypeInt,
+				Description: "The id of the replicated guest",
+				Computed:    true,
+			},
+		},
+		Read: dataSourceVirtualEnvironmentReplicationStatusRead,
+	}
+}
+
+func dataSourceVirtualEnvironmentReplicationStatusFormatTimestamp(t *proxmox.CustomTimestamp) string {
+	if t != nil {
+		return time.Time(*t).UTC().Format(time.RFC3339)
+	}
+
+	return time.Unix(0, 0).UTC().Format(time.RFC3339)
+}
+
+func dataSourceVirtualEnvironmentReplicationStatusRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkDataSourceVirtualEnvironmentReplicationStatusNodeName).(string)
+	replicationID := d.Get(mkDataSourceVirtualEnvironmentReplicationStatusReplicationID).(string)
+	status, err := veClient.GetReplicationJobStatus(nodeName, replicationID)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s_replication_%s", nodeName, replicationID))
+
+	if status.Duration != nil {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusDuration, *status.Duration)
+	} else {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusDuration, 0)
+	}
+
+	if status.Error != nil {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusError, *status.Error)
+	} else {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusError, "")
+	}
+
+	if status.FailCount != nil {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusFailCount, *status.FailCount)
+	} else {
+		d.Set(mkDataSourceVirtualEnvironmentReplicationStatusFailCount, 0)
+	}
+
+	d.Set(mkDataSourceVirtualEnvironmentReplicationStatusLastSync, dataSourceVirtualEnvironmentReplicationStatusFormatTimestamp(status.LastSync))
+	d.Set(mkDataSourceVirtualEnvironmentReplicationStatusLastTry, dataSourceVirtualEnvironmentReplicationStatusFormatTimestamp(status.LastTry))
+	d.Set(mkDataSourceVirtualEnvironmentReplicationStatusNextSync, dataSourceVirtualEnvironmentReplicationStatusFormatTimestamp(status.NextSync))
+	d.Set(mkDataSourceVirtualEnvironmentReplicationStatusTargetNode, status.Target)
+	d.Set(mkDataSourceVirtualEnvironmentReplicationStatusVMID, status.Guest)
+
+	return nil
+}
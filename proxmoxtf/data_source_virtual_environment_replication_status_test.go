@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentReplicationStatusInstantiation tests whether the DataSourceVirtualEnvironmentReplicationStatus instance can be instantiated.
+func TestDataSourceVirtualEnvironmentReplicationStatusInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentReplicationStatus()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentReplicationStatus")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentReplicationStatusSchema tests the dataSourceVirtualEnvironmentReplicationStatus schema.
+func TestDataSourceVirtualEnvironmentReplicationStatusSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentReplicationStatus()
+
+	testRequiredArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentReplicationStatusNodeName,
+		mkDataSourceVirtualEnvironmentReplicationStatusReplicationID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentReplicationStatusDuration,
+		mkDataSourceVirtualEnvironmentReplicationStatusError,
+		mkDataSourceVirtualEnvironmentReplicationStatusFailCount,
+		mkDataSourceVirtualEnvironmentReplicationStatusLastSync,
+		mkDataSourceVirtualEnvironmentReplicationStatusLastTry,
+		mkDataSourceVirtualEnvironmentReplicationStatusNextSync,
+		mkDataSourceVirtualEnvironmentReplicationStatusTargetNode,
+		mkDataSourceVirtualEnvironmentReplicationStatusVMID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentReplicationStatusDuration:      schema.TypeFloat,
+		mkDataSourceVirtualEnvironmentReplicationStatusError:         schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusFailCount:     schema.TypeInt,
+		mkDataSourceVirtualEnvironmentReplicationStatusLastSync:      schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusLastTry:       schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusNextSync:      schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusNodeName:      schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusReplicationID: schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusTargetNode:    schema.TypeString,
+		mkDataSourceVirtualEnvironmentReplicationStatusVMID:          schema.TypeInt,
+	})
+}
@@ -6,25 +6,34 @@ package proxmoxtf
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"os"
 
 	"github.com/danitso/terraform-provider-proxmox/proxmox"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 const (
-	dvProviderVirtualEnvironmentEndpoint = ""
-	dvProviderVirtualEnvironmentOTP      = ""
-	dvProviderVirtualEnvironmentPassword = ""
-	dvProviderVirtualEnvironmentUsername = ""
-
-	mkProviderVirtualEnvironment         = "virtual_environment"
-	mkProviderVirtualEnvironmentEndpoint = "endpoint"
-	mkProviderVirtualEnvironmentInsecure = "insecure"
-	mkProviderVirtualEnvironmentOTP      = "otp"
-	mkProviderVirtualEnvironmentPassword = "password"
-	mkProviderVirtualEnvironmentUsername = "username"
+	dvProviderVirtualEnvironmentEndpoint     = ""
+	dvProviderVirtualEnvironmentOTP          = ""
+	dvProviderVirtualEnvironmentPassword     = ""
+	dvProviderVirtualEnvironmentTOTPSecret   = ""
+	dvProviderVirtualEnvironmentUsername     = ""
+	dvProviderVirtualEnvironmentVMIDRangeMax = 999999999
+	dvProviderVirtualEnvironmentVMIDRangeMin = 100
+
+	mkProviderVirtualEnvironment             = "virtual_environment"
+	mkProviderVirtualEnvironmentEndpoint     = "endpoint"
+	mkProviderVirtualEnvironmentInsecure     = "insecure"
+	mkProviderVirtualEnvironmentOTP          = "otp"
+	mkProviderVirtualEnvironmentPassword     = "password"
+	mkProviderVirtualEnvironmentTOTPSecret   = "totp_secret"
+	mkProviderVirtualEnvironmentUsername     = "username"
+	mkProviderVirtualEnvironmentVMIDRange    = "vm_id_range"
+	mkProviderVirtualEnvironmentVMIDRangeMax = "max"
+	mkProviderVirtualEnvironmentVMIDRangeMin = "min"
 )
 
 type providerConfiguration struct {
@@ -36,33 +45,72 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		ConfigureFunc: providerConfigure,
 		DataSourcesMap: map[string]*schema.Resource{
-			"proxmox_virtual_environment_datastores": dataSourceVirtualEnvironmentDatastores(),
-			"proxmox_virtual_environment_dns":        dataSourceVirtualEnvironmentDNS(),
-			"proxmox_virtual_environment_group":      dataSourceVirtualEnvironmentGroup(),
-			"proxmox_virtual_environment_groups":     dataSourceVirtualEnvironmentGroups(),
-			"proxmox_virtual_environment_hosts":      dataSourceVirtualEnvironmentHosts(),
-			"proxmox_virtual_environment_nodes":      dataSourceVirtualEnvironmentNodes(),
-			"proxmox_virtual_environment_pool":       dataSourceVirtualEnvironmentPool(),
-			"proxmox_virtual_environment_pools":      dataSourceVirtualEnvironmentPools(),
-			"proxmox_virtual_environment_role":       dataSourceVirtualEnvironmentRole(),
-			"proxmox_virtual_environment_roles":      dataSourceVirtualEnvironmentRoles(),
-			"proxmox_virtual_environment_time":       dataSourceVirtualEnvironmentTime(),
-			"proxmox_virtual_environment_user":       dataSourceVirtualEnvironmentUser(),
-			"proxmox_virtual_environment_users":      dataSourceVirtualEnvironmentUsers(),
-			"proxmox_virtual_environment_version":    dataSourceVirtualEnvironmentVersion(),
+			"proxmox_virtual_environment_acls":               dataSourceVirtualEnvironmentACLs(),
+			"proxmox_virtual_environment_apt_updates":        dataSourceVirtualEnvironmentAPTUpdates(),
+			"proxmox_virtual_environment_cluster_resources":  dataSourceVirtualEnvironmentClusterResources(),
+			"proxmox_virtual_environment_datastore_files":    dataSourceVirtualEnvironmentDatastoreFiles(),
+			"proxmox_virtual_environment_datastores":         dataSourceVirtualEnvironmentDatastores(),
+			"proxmox_virtual_environment_dns":                dataSourceVirtualEnvironmentDNS(),
+			"proxmox_virtual_environment_group":              dataSourceVirtualEnvironmentGroup(),
+			"proxmox_virtual_environment_groups":             dataSourceVirtualEnvironmentGroups(),
+			"proxmox_virtual_environment_guests":             dataSourceVirtualEnvironmentGuests(),
+			"proxmox_virtual_environment_hosts":              dataSourceVirtualEnvironmentHosts(),
+			"proxmox_virtual_environment_network_interfaces": dataSourceVirtualEnvironmentNetworkInterfaces(),
+			"proxmox_virtual_environment_nodes":              dataSourceVirtualEnvironmentNodes(),
+			"proxmox_virtual_environment_permissions":        dataSourceVirtualEnvironmentPermissions(),
+			"proxmox_virtual_environment_pool":               dataSourceVirtualEnvironmentPool(),
+			"proxmox_virtual_environment_pools":              dataSourceVirtualEnvironmentPools(),
+			"proxmox_virtual_environment_replication_status": dataSourceVirtualEnvironmentReplicationStatus(),
+			"proxmox_virtual_environment_role":               dataSourceVirtualEnvironmentRole(),
+			"proxmox_virtual_environment_roles":              dataSourceVirtualEnvironmentRoles(),
+			"proxmox_virtual_environment_time":               dataSourceVirtualEnvironmentTime(),
+			"proxmox_virtual_environment_user":               dataSourceVirtualEnvironmentUser(),
+			"proxmox_virtual_environment_users":              dataSourceVirtualEnvironmentUsers(),
+			"proxmox_virtual_environment_version":            dataSourceVirtualEnvironmentVersion(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"proxmox_virtual_environment_certificate": resourceVirtualEnvironmentCertificate(),
-			"proxmox_virtual_environment_container":   resourceVirtualEnvironmentContainer(),
-			"proxmox_virtual_environment_dns":         resourceVirtualEnvironmentDNS(),
-			"proxmox_virtual_environment_file":        resourceVirtualEnvironmentFile(),
-			"proxmox_virtual_environment_group":       resourceVirtualEnvironmentGroup(),
-			"proxmox_virtual_environment_hosts":       resourceVirtualEnvironmentHosts(),
-			"proxmox_virtual_environment_pool":        resourceVirtualEnvironmentPool(),
-			"proxmox_virtual_environment_role":        resourceVirtualEnvironmentRole(),
-			"proxmox_virtual_environment_time":        resourceVirtualEnvironmentTime(),
-			"proxmox_virtual_environment_user":        resourceVirtualEnvironmentUser(),
-			"proxmox_virtual_environment_vm":          resourceVirtualEnvironmentVM(),
+			"proxmox_virtual_environment_acl":                     resourceVirtualEnvironmentACL(),
+			"proxmox_virtual_environment_acme_account":            resourceVirtualEnvironmentACMEAccount(),
+			"proxmox_virtual_environment_acme_certificate":        resourceVirtualEnvironmentACMECertificate(),
+			"proxmox_virtual_environment_acme_plugin":             resourceVirtualEnvironmentACMEPlugin(),
+			"proxmox_virtual_environment_apt_repository":          resourceVirtualEnvironmentAPTRepository(),
+			"proxmox_virtual_environment_apt_standard_repository": resourceVirtualEnvironmentAPTStandardRepository(),
+			"proxmox_virtual_environment_backup":                  resourceVirtualEnvironmentBackup(),
+			"proxmox_virtual_environment_backup_job":              resourceVirtualEnvironmentBackupJob(),
+			"proxmox_virtual_environment_certificate":             resourceVirtualEnvironmentCertificate(),
+			"proxmox_virtual_environment_cloud_init_snippet":      resourceVirtualEnvironmentCloudInitSnippet(),
+			"proxmox_virtual_environment_cluster_options":         resourceVirtualEnvironmentClusterOptions(),
+			"proxmox_virtual_environment_container":               resourceVirtualEnvironmentContainer(),
+			"proxmox_virtual_environment_dns":                     resourceVirtualEnvironmentDNS(),
+			"proxmox_virtual_environment_file":                    resourceVirtualEnvironmentFile(),
+			"proxmox_virtual_environment_firewall_alias":          resourceVirtualEnvironmentFirewallAlias(),
+			"proxmox_virtual_environment_firewall_ipset":          resourceVirtualEnvironmentFirewallIPSet(),
+			"proxmox_virtual_environment_firewall_options":        resourceVirtualEnvironmentFirewallOptions(),
+			"proxmox_virtual_environment_firewall_rules":          resourceVirtualEnvironmentFirewallRules(),
+			"proxmox_virtual_environment_firewall_security_group": resourceVirtualEnvironmentFirewallSecurityGroup(),
+			"proxmox_virtual_environment_group":                   resourceVirtualEnvironmentGroup(),
+			"proxmox_virtual_environment_ha_group":                resourceVirtualEnvironmentHAGroup(),
+			"proxmox_virtual_environment_ha_resource":             resourceVirtualEnvironmentHAResource(),
+			"proxmox_virtual_environment_hosts":                   resourceVirtualEnvironmentHosts(),
+			"proxmox_virtual_environment_network_apply":           resourceVirtualEnvironmentNetworkApply(),
+			"proxmox_virtual_environment_network_interface":       resourceVirtualEnvironmentNetworkInterface(),
+			"proxmox_virtual_environment_pool":                    resourceVirtualEnvironmentPool(),
+			"proxmox_virtual_environment_pool_membership":         resourceVirtualEnvironmentPoolMembership(),
+			"proxmox_virtual_environment_realm":                   resourceVirtualEnvironmentRealm(),
+			"proxmox_virtual_environment_replication":             resourceVirtualEnvironmentReplication(),
+			"proxmox_virtual_environment_role":                    resourceVirtualEnvironmentRole(),
+			"proxmox_virtual_environment_sdn_apply":               resourceVirtualEnvironmentSDNApply(),
+			"proxmox_virtual_environment_sdn_controller":          resourceVirtualEnvironmentSDNController(),
+			"proxmox_virtual_environment_sdn_subnet":              resourceVirtualEnvironmentSDNSubnet(),
+			"proxmox_virtual_environment_sdn_vnet":                resourceVirtualEnvironmentSDNVNet(),
+			"proxmox_virtual_environment_sdn_zone":                resourceVirtualEnvironmentSDNZone(),
+			"proxmox_virtual_environment_service":                 resourceVirtualEnvironmentService(),
+			"proxmox_virtual_environment_subscription":            resourceVirtualEnvironmentSubscription(),
+			"proxmox_virtual_environment_time":                    resourceVirtualEnvironmentTime(),
+			"proxmox_virtual_environment_user":                    resourceVirtualEnvironmentUser(),
+			"proxmox_virtual_environment_user_token":              resourceVirtualEnvironmentUserToken(),
+			"proxmox_virtual_environment_user_totp":               resourceVirtualEnvironmentUserTOTP(),
+			"proxmox_virtual_environment_vm":                      resourceVirtualEnvironmentVM(),
 		},
 		Schema: map[string]*schema.Schema{
 			mkProviderVirtualEnvironment: {
@@ -122,6 +170,9 @@ func Provider() *schema.Provider {
 								[]string{"PROXMOX_VE_OTP", "PM_VE_OTP"},
 								dvProviderVirtualEnvironmentOTP,
 							),
+							ConflictsWith: []string{
+								fmt.Sprintf("%s.0.%s", mkProviderVirtualEnvironment, mkProviderVirtualEnvironmentTOTPSecret),
+							},
 						},
 						mkProviderVirtualEnvironmentPassword: {
 							Type:        schema.TypeString,
@@ -143,6 +194,34 @@ func Provider() *schema.Provider {
 								return []string{}, []error{}
 							},
 						},
+						mkProviderVirtualEnvironmentTOTPSecret: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The TOTP secret used to generate one-time passwords for the Proxmox Virtual Environment API",
+							DefaultFunc: schema.MultiEnvDefaultFunc(
+								[]string{"PROXMOX_VE_TOTP_SECRET", "PM_VE_TOTP_SECRET"},
+								dvProviderVirtualEnvironmentTOTPSecret,
+							),
+							ConflictsWith: []string{
+								fmt.Sprintf("%s.0.%s", mkProviderVirtualEnvironment, mkProviderVirtualEnvironmentOTP),
+							},
+							ValidateFunc: func(v interface{}, k string) (warns []string, errs []error) {
+								value := v.(string)
+
+								if value == "" {
+									return []string{}, []error{}
+								}
+
+								err := proxmox.ValidateTOTPSecret(value)
+
+								if err != nil {
+									return []string{}, []error{err}
+								}
+
+								return []string{}, []error{}
+							},
+						},
 						mkProviderVirtualEnvironmentUsername: {
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -163,6 +242,39 @@ func Provider() *schema.Provider {
 								return []string{}, []error{}
 							},
 						},
+						mkProviderVirtualEnvironmentVMIDRange: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The range of identifiers to allocate for new containers and virtual machines",
+							DefaultFunc: func() (interface{}, error) {
+								return []interface{}{
+									map[string]interface{}{
+										mkProviderVirtualEnvironmentVMIDRangeMax: dvProviderVirtualEnvironmentVMIDRangeMax,
+										mkProviderVirtualEnvironmentVMIDRangeMin: dvProviderVirtualEnvironmentVMIDRangeMin,
+									},
+								}, nil
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									mkProviderVirtualEnvironmentVMIDRangeMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Description:  "The highest identifier to allocate",
+										Default:      dvProviderVirtualEnvironmentVMIDRangeMax,
+										ValidateFunc: validation.IntBetween(dvProviderVirtualEnvironmentVMIDRangeMin, dvProviderVirtualEnvironmentVMIDRangeMax),
+									},
+									mkProviderVirtualEnvironmentVMIDRangeMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Description:  "The lowest identifier to allocate",
+										Default:      dvProviderVirtualEnvironmentVMIDRangeMin,
+										ValidateFunc: validation.IntBetween(dvProviderVirtualEnvironmentVMIDRangeMin, dvProviderVirtualEnvironmentVMIDRangeMax),
+									},
+								},
+							},
+							MaxItems: 1,
+							MinItems: 0,
+						},
 					},
 				},
 				MaxItems: 1,
@@ -186,12 +298,28 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			veConfig[mkProviderVirtualEnvironmentUsername].(string),
 			veConfig[mkProviderVirtualEnvironmentPassword].(string),
 			veConfig[mkProviderVirtualEnvironmentOTP].(string),
+			veConfig[mkProviderVirtualEnvironmentTOTPSecret].(string),
 			veConfig[mkProviderVirtualEnvironmentInsecure].(bool),
 		)
 
 		if err != nil {
 			return nil, err
 		}
+
+		vmIDRange := veConfig[mkProviderVirtualEnvironmentVMIDRange].([]interface{})
+
+		if len(vmIDRange) > 0 {
+			vmIDRangeBlock := vmIDRange[0].(map[string]interface{})
+
+			err = veClient.SetVMIDRange(
+				vmIDRangeBlock[mkProviderVirtualEnvironmentVMIDRangeMin].(int),
+				vmIDRangeBlock[mkProviderVirtualEnvironmentVMIDRangeMax].(int),
+			)
+
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	config := providerConfiguration{
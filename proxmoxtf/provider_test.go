@@ -40,14 +40,30 @@ func TestProviderSchema(t *testing.T) {
 		mkProviderVirtualEnvironmentInsecure,
 		mkProviderVirtualEnvironmentOTP,
 		mkProviderVirtualEnvironmentPassword,
+		mkProviderVirtualEnvironmentTOTPSecret,
 		mkProviderVirtualEnvironmentUsername,
+		mkProviderVirtualEnvironmentVMIDRange,
 	})
 
 	testValueTypes(t, veSchema, map[string]schema.ValueType{
-		mkProviderVirtualEnvironmentEndpoint: schema.TypeString,
-		mkProviderVirtualEnvironmentInsecure: schema.TypeBool,
-		mkProviderVirtualEnvironmentOTP:      schema.TypeString,
-		mkProviderVirtualEnvironmentPassword: schema.TypeString,
-		mkProviderVirtualEnvironmentUsername: schema.TypeString,
+		mkProviderVirtualEnvironmentEndpoint:   schema.TypeString,
+		mkProviderVirtualEnvironmentInsecure:   schema.TypeBool,
+		mkProviderVirtualEnvironmentOTP:        schema.TypeString,
+		mkProviderVirtualEnvironmentPassword:   schema.TypeString,
+		mkProviderVirtualEnvironmentTOTPSecret: schema.TypeString,
+		mkProviderVirtualEnvironmentUsername:   schema.TypeString,
+		mkProviderVirtualEnvironmentVMIDRange:  schema.TypeList,
+	})
+
+	vmIDRangeSchema := testNestedSchemaExistence(t, veSchema, mkProviderVirtualEnvironmentVMIDRange)
+
+	testOptionalArguments(t, vmIDRangeSchema, []string{
+		mkProviderVirtualEnvironmentVMIDRangeMax,
+		mkProviderVirtualEnvironmentVMIDRangeMin,
+	})
+
+	testValueTypes(t, vmIDRangeSchema, map[string]schema.ValueType{
+		mkProviderVirtualEnvironmentVMIDRangeMax: schema.TypeInt,
+		mkProviderVirtualEnvironmentVMIDRangeMin: schema.TypeInt,
 	})
 }
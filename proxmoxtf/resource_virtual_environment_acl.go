@@ -0,0 +1,258 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentACLGroupID   = ""
+	dvResourceVirtualEnvironmentACLPropagate = false
+	dvResourceVirtualEnvironmentACLTokenID   = ""
+	dvResourceVirtualEnvironmentACLUserID    = ""
+
+	mkResourceVirtualEnvironmentACLGroupID   = "group_id"
+	mkResourceVirtualEnvironmentACLPath      = "path"
+	mkResourceVirtualEnvironmentACLPropagate = "propagate"
+	mkResourceVirtualEnvironmentACLRoleID    = "role_id"
+	mkResourceVirtualEnvironmentACLTokenID   = "token_id"
+	mkResourceVirtualEnvironmentACLUserID    = "user_id"
+)
+
+func resourceVirtualEnvironmentACL() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentACLGroupID: {
+				Type:        schema.TypeString,
+				Description: "The group id",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACLGroupID,
+				ConflictsWith: []string{
+					mkResourceVirtualEnvironmentACLTokenID,
+					mkResourceVirtualEnvironmentACLUserID,
+				},
+			},
+			mkResourceVirtualEnvironmentACLPath: {
+				Type:        schema.TypeString,
+				Description: "The path",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentACLPropagate: {
+				Type:        schema.TypeBool,
+				Description: "Whether to propagate to child paths",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACLPropagate,
+			},
+			mkResourceVirtualEnvironmentACLRoleID: {
+				Type:        schema.TypeString,
+				Description: "The role id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentACLTokenID: {
+				Type:        schema.TypeString,
+				Description: "The API token id",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACLTokenID,
+				ConflictsWith: []string{
+					mkResourceVirtualEnvironmentACLGroupID,
+					mkResourceVirtualEnvironmentACLUserID,
+				},
+			},
+			mkResourceVirtualEnvironmentACLUserID: {
+				Type:        schema.TypeString,
+				Description: "The user id",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACLUserID,
+				ConflictsWith: []string{
+					mkResourceVirtualEnvironmentACLGroupID,
+					mkResourceVirtualEnvironmentACLTokenID,
+				},
+			},
+		},
+		Create: resourceVirtualEnvironmentACLCreate,
+		Read:   resourceVirtualEnvironmentACLRead,
+		Delete: resourceVirtualEnvironmentACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentACLImport,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentACLCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentACLGetUpdateBody(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.UpdateACL(body)
+
+	if err != nil {
+		return err
+	}
+
+	principalType, principalID := resourceVirtualEnvironmentACLGetPrincipal(d)
+
+	d.SetId(resourceVirtualEnvironmentACLGetID(body.Path, principalType, principalID, body.Roles[0]))
+
+	return resourceVirtualEnvironmentACLRead(d, m)
+}
+
+func resourceVirtualEnvironmentACLGetID(path, principalType, principalID, roleID string) string {
+	return fmt.Sprintf("%s|%s|%s|%s", path, principalType, principalID, roleID)
+}
+
+func resourceVirtualEnvironmentACLGetPrincipal(d *schema.ResourceData) (string, string) {
+	groupID := d.Get(mkResourceVirtualEnvironmentACLGroupID).(string)
+	tokenID := d.Get(mkResourceVirtualEnvironmentACLTokenID).(string)
+	userID := d.Get(mkResourceVirtualEnvironmentACLUserID).(string)
+
+	if groupID != "" {
+		return "group", groupID
+	} else if tokenID != "" {
+		return "token", tokenID
+	}
+
+	return "user", userID
+}
+
+func resourceVirtualEnvironmentACLGetUpdateBody(d *schema.ResourceData, delete bool) (*proxmox.VirtualEnvironmentACLUpdateRequestBody, error) {
+	principalType, principalID := resourceVirtualEnvironmentACLGetPrincipal(d)
+
+	if principalID == "" {
+		return nil, fmt.Errorf(
+			"One of the \"%s\", \"%s\" or \"%s\" arguments must be specified",
+			mkResourceVirtualEnvironmentACLGroupID,
+			mkResourceVirtualEnvironmentACLTokenID,
+			mkResourceVirtualEnvironmentACLUserID,
+		)
+	}
+
+	aclDelete := proxmox.CustomBool(delete)
+	aclPropagate := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentACLPropagate).(bool))
+
+	body := &proxmox.VirtualEnvironmentACLUpdateRequestBody{
+		Delete:    &aclDelete,
+		Path:      d.Get(mkResourceVirtualEnvironmentACLPath).(string),
+		Propagate: &aclPropagate,
+		Roles:     []string{d.Get(mkResourceVirtualEnvironmentACLRoleID).(string)},
+	}
+
+	switch principalType {
+	case "group":
+		body.Groups = []string{principalID}
+	case "token":
+		body.Tokens = []string{principalID}
+	default:
+		body.Users = []string{principalID}
+	}
+
+	return body, nil
+}
+
+func resourceVirtualEnvironmentACLImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+
+	if len(parts) != 4 || parts[0] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, errors.New("The identifier must be of the form \"path|type|principal|role\" (e.g. \"/vms/100|user|admin@pve|PVEVMAdmin\")")
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACLPath, parts[0])
+	d.Set(mkResourceVirtualEnvironmentACLRoleID, parts[3])
+
+	switch parts[1] {
+	case "group":
+		d.Set(mkResourceVirtualEnvironmentACLGroupID, parts[2])
+	case "token":
+		d.Set(mkResourceVirtualEnvironmentACLTokenID, parts[2])
+	case "user":
+		d.Set(mkResourceVirtualEnvironmentACLUserID, parts[2])
+	default:
+		return nil, fmt.Errorf("Unsupported principal type \"%s\" (must be group, token or user)", parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentACLRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	acl, err := veClient.GetACL()
+
+	if err != nil {
+		return err
+	}
+
+	path := d.Get(mkResourceVirtualEnvironmentACLPath).(string)
+	principalType, principalID := resourceVirtualEnvironmentACLGetPrincipal(d)
+	roleID := d.Get(mkResourceVirtualEnvironmentACLRoleID).(string)
+
+	for _, v := range acl {
+		if v.Path != path || v.Type != principalType || v.UserOrGroupID != principalID || v.RoleID != roleID {
+			continue
+		}
+
+		if v.Propagate != nil {
+			d.Set(mkResourceVirtualEnvironmentACLPropagate, bool(*v.Propagate))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACLPropagate, false)
+		}
+
+		return nil
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentACLDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentACLGetUpdateBody(d, true)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.UpdateACL(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentACLInstantiation tests whether the ResourceVirtualEnvironmentACL instance can be instantiated.
+func TestResourceVirtualEnvironmentACLInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentACL()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentACL")
+	}
+}
+
+// TestResourceVirtualEnvironmentACLSchema tests the resourceVirtualEnvironmentACL schema.
+func TestResourceVirtualEnvironmentACLSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentACL()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACLPath,
+		mkResourceVirtualEnvironmentACLRoleID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACLGroupID,
+		mkResourceVirtualEnvironmentACLPropagate,
+		mkResourceVirtualEnvironmentACLTokenID,
+		mkResourceVirtualEnvironmentACLUserID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentACLGroupID:   schema.TypeString,
+		mkResourceVirtualEnvironmentACLPath:      schema.TypeString,
+		mkResourceVirtualEnvironmentACLPropagate: schema.TypeBool,
+		mkResourceVirtualEnvironmentACLRoleID:    schema.TypeString,
+		mkResourceVirtualEnvironmentACLTokenID:   schema.TypeString,
+		mkResourceVirtualEnvironmentACLUserID:    schema.TypeString,
+	})
+}
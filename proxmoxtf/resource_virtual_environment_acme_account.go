@@ -0,0 +1,252 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentACMEAccountDirectory  = "https://acme-v02.api.letsencrypt.org/directory"
+	dvResourceVirtualEnvironmentACMEAccountEABHMACKey = ""
+	dvResourceVirtualEnvironmentACMEAccountEABKID     = ""
+	dvResourceVirtualEnvironmentACMEAccountName       = "default"
+	dvResourceVirtualEnvironmentACMEAccountTimeout    = 300
+	dvResourceVirtualEnvironmentACMEAccountTOSURL     = ""
+
+	mkResourceVirtualEnvironmentACMEAccountContact    = "contact"
+	mkResourceVirtualEnvironmentACMEAccountCreatedAt  = "created_at"
+	mkResourceVirtualEnvironmentACMEAccountDirectory  = "directory"
+	mkResourceVirtualEnvironmentACMEAccountEABHMACKey = "eab_hmac_key"
+	mkResourceVirtualEnvironmentACMEAccountEABKID     = "eab_kid"
+	mkResourceVirtualEnvironmentACMEAccountLocation   = "location"
+	mkResourceVirtualEnvironmentACMEAccountName       = "name"
+	mkResourceVirtualEnvironmentACMEAccountStatus     = "status"
+	mkResourceVirtualEnvironmentACMEAccountTOSURL     = "tos_url"
+)
+
+func resourceVirtualEnvironmentACMEAccount() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentACMEAccountContact: {
+				Type:        schema.TypeString,
+				Description: "The contact email address",
+				Required:    true,
+			},
+			mkResourceVirtualEnvironmentACMEAccountCreatedAt: {
+				Type:        schema.TypeString,
+				Description: "The creation date",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMEAccountDirectory: {
+				Type:        schema.TypeString,
+				Description: "The URL of the ACME directory",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACMEAccountDirectory,
+			},
+			mkResourceVirtualEnvironmentACMEAccountEABHMACKey: {
+				Type:        schema.TypeString,
+				Description: "The HMAC key for external account binding",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Default:     dvResourceVirtualEnvironmentACMEAccountEABHMACKey,
+			},
+			mkResourceVirtualEnvironmentACMEAccountEABKID: {
+				Type:        schema.TypeString,
+				Description: "The key identifier for external account binding",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACMEAccountEABKID,
+			},
+			mkResourceVirtualEnvironmentACMEAccountLocation: {
+				Type:        schema.TypeString,
+				Description: "The URL of the account",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMEAccountName: {
+				Type:         schema.TypeString,
+				Description:  "The account name",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentACMEAccountName,
+				ValidateFunc: getACMEIDValidator(),
+			},
+			mkResourceVirtualEnvironmentACMEAccountStatus: {
+				Type:        schema.TypeString,
+				Description: "The account status",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMEAccountTOSURL: {
+				Type:        schema.TypeString,
+				Description: "The URL of the terms of service, which indicates that they have been accepted",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentACMEAccountTOSURL,
+			},
+		},
+		Create: resourceVirtualEnvironmentACMEAccountCreate,
+		Read:   resourceVirtualEnvironmentACMEAccountRead,
+		Update: resourceVirtualEnvironmentACMEAccountUpdate,
+		Delete: resourceVirtualEnvironmentACMEAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentACMEAccountCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	contact := d.Get(mkResourceVirtualEnvironmentACMEAccountContact).(string)
+	directory := d.Get(mkResourceVirtualEnvironmentACMEAccountDirectory).(string)
+	eabHMACKey := d.Get(mkResourceVirtualEnvironmentACMEAccountEABHMACKey).(string)
+	eabKID := d.Get(mkResourceVirtualEnvironmentACMEAccountEABKID).(string)
+	name := d.Get(mkResourceVirtualEnvironmentACMEAccountName).(string)
+	tosURL := d.Get(mkResourceVirtualEnvironmentACMEAccountTOSURL).(string)
+
+	body := &proxmox.VirtualEnvironmentACMEAccountCreateRequestBody{
+		Contact:   contact,
+		Directory: &directory,
+		Name:      &name,
+	}
+
+	if eabHMACKey != "" {
+		body.EABHMACKey = &eabHMACKey
+	}
+
+	if eabKID != "" {
+		body.EABKID = &eabKID
+	}
+
+	if tosURL != "" {
+		body.TOSURL = &tosURL
+	}
+
+	err = veClient.CreateACMEAccount(body, dvResourceVirtualEnvironmentACMEAccountTimeout)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+
+	return resourceVirtualEnvironmentACMEAccountRead(d, m)
+}
+
+func resourceVirtualEnvironmentACMEAccountRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Id()
+	account, err := veClient.GetACMEAccount(name)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "does not exist") ||
+			strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACMEAccountName, name)
+
+	if account.Account != nil {
+		contacts := make([]string, len(account.Account.Contact))
+
+		for i, c := range account.Account.Contact {
+			contacts[i] = strings.TrimPrefix(c, "mailto:")
+		}
+
+		d.Set(mkResourceVirtualEnvironmentACMEAccountContact, strings.Join(contacts, ","))
+
+		if account.Account.CreatedAt != nil {
+			d.Set(mkResourceVirtualEnvironmentACMEAccountCreatedAt, *account.Account.CreatedAt)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMEAccountCreatedAt, "")
+		}
+
+		if account.Account.Status != nil {
+			d.Set(mkResourceVirtualEnvironmentACMEAccountStatus, *account.Account.Status)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMEAccountStatus, "")
+		}
+	}
+
+	if account.Directory != nil {
+		d.Set(mkResourceVirtualEnvironmentACMEAccountDirectory, *account.Directory)
+	}
+
+	if account.Location != nil {
+		d.Set(mkResourceVirtualEnvironmentACMEAccountLocation, *account.Location)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentACMEAccountLocation, "")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentACMEAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	contact := d.Get(mkResourceVirtualEnvironmentACMEAccountContact).(string)
+
+	err = veClient.UpdateACMEAccount(d.Id(), &proxmox.VirtualEnvironmentACMEAccountUpdateRequestBody{
+		Contact: &contact,
+	}, dvResourceVirtualEnvironmentACMEAccountTimeout)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentACMEAccountRead(d, m)
+}
+
+func resourceVirtualEnvironmentACMEAccountDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeactivateACMEAccount(d.Id(), dvResourceVirtualEnvironmentACMEAccountTimeout)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,55 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentACMEAccountInstantiation tests whether the ResourceVirtualEnvironmentACMEAccount instance can be instantiated.
+func TestResourceVirtualEnvironmentACMEAccountInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentACMEAccount()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentACMEAccount")
+	}
+}
+
+// TestResourceVirtualEnvironmentACMEAccountSchema tests the resourceVirtualEnvironmentACMEAccount schema.
+func TestResourceVirtualEnvironmentACMEAccountSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentACMEAccount()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMEAccountContact,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMEAccountDirectory,
+		mkResourceVirtualEnvironmentACMEAccountEABHMACKey,
+		mkResourceVirtualEnvironmentACMEAccountEABKID,
+		mkResourceVirtualEnvironmentACMEAccountName,
+		mkResourceVirtualEnvironmentACMEAccountTOSURL,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentACMEAccountCreatedAt,
+		mkResourceVirtualEnvironmentACMEAccountLocation,
+		mkResourceVirtualEnvironmentACMEAccountStatus,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentACMEAccountContact:    schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountCreatedAt:  schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountDirectory:  schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountEABHMACKey: schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountEABKID:     schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountLocation:   schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountName:       schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountStatus:     schema.TypeString,
+		mkResourceVirtualEnvironmentACMEAccountTOSURL:     schema.TypeString,
+	})
+}
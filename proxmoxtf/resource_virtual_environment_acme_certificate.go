@@ -0,0 +1,447 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentACMECertificateAccount      = "default"
+	dvResourceVirtualEnvironmentACMECertificateDomainAlias  = ""
+	dvResourceVirtualEnvironmentACMECertificateDomainPlugin = ""
+	dvResourceVirtualEnvironmentACMECertificateOverwrite    = false
+	dvResourceVirtualEnvironmentACMECertificateRenewBefore  = "720h"
+	dvResourceVirtualEnvironmentACMECertificateTimeout      = 1800
+
+	maxResourceVirtualEnvironmentACMECertificateDomains = 6
+
+	mkResourceVirtualEnvironmentACMECertificateAccount                 = "account"
+	mkResourceVirtualEnvironmentACMECertificateCertificate             = "certificate"
+	mkResourceVirtualEnvironmentACMECertificateDomain                  = "domain"
+	mkResourceVirtualEnvironmentACMECertificateDomainAlias             = "alias"
+	mkResourceVirtualEnvironmentACMECertificateDomainDomain            = "domain"
+	mkResourceVirtualEnvironmentACMECertificateDomainPlugin            = "plugin"
+	mkResourceVirtualEnvironmentACMECertificateExpirationDate          = "expiration_date"
+	mkResourceVirtualEnvironmentACMECertificateIssuer                  = "issuer"
+	mkResourceVirtualEnvironmentACMECertificateNodeName                = "node_name"
+	mkResourceVirtualEnvironmentACMECertificateOverwrite               = "overwrite"
+	mkResourceVirtualEnvironmentACMECertificateRenewBefore             = "renew_before"
+	mkResourceVirtualEnvironmentACMECertificateSSLFingerprint          = "ssl_fingerprint"
+	mkResourceVirtualEnvironmentACMECertificateStartDate               = "start_date"
+	mkResourceVirtualEnvironmentACMECertificateSubject                 = "subject"
+	mkResourceVirtualEnvironmentACMECertificateSubjectAlternativeNames = "subject_alternative_names"
+)
+
+func resourceVirtualEnvironmentACMECertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentACMECertificateAccount: {
+				Type:         schema.TypeString,
+				Description:  "The name of the ACME account",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentACMECertificateAccount,
+				ValidateFunc: getACMEIDValidator(),
+			},
+			mkResourceVirtualEnvironmentACMECertificateCertificate: {
+				Type:        schema.TypeString,
+				Description: "The PEM encoded certificate",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateDomain: {
+				Type:        schema.TypeList,
+				Description: "The domains",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentACMECertificateDomainAlias: {
+							Type:        schema.TypeString,
+							Description: "The domain, which the DNS challenge is delegated to",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentACMECertificateDomainAlias,
+						},
+						mkResourceVirtualEnvironmentACMECertificateDomainDomain: {
+							Type:        schema.TypeString,
+							Description: "The domain name",
+							Required:    true,
+						},
+						mkResourceVirtualEnvironmentACMECertificateDomainPlugin: {
+							Type:        schema.TypeString,
+							Description: "The ACME plugin, which validates the domain",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentACMECertificateDomainPlugin,
+						},
+					},
+				},
+				MaxItems: maxResourceVirtualEnvironmentACMECertificateDomains,
+				MinItems: 1,
+			},
+			mkResourceVirtualEnvironmentACMECertificateExpirationDate: {
+				Type:        schema.TypeString,
+				Description: "The expiration date",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateIssuer: {
+				Type:        schema.TypeString,
+				Description: "The issuer",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateOverwrite: {
+				Type:        schema.TypeBool,
+				Description: "Whether to overwrite an existing custom certificate",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentACMECertificateOverwrite,
+			},
+			mkResourceVirtualEnvironmentACMECertificateRenewBefore: {
+				Type:         schema.TypeString,
+				Description:  "The time before the expiration date, where the certificate is renewed",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentACMECertificateRenewBefore,
+				ValidateFunc: getTimeoutValidator(),
+			},
+			mkResourceVirtualEnvironmentACMECertificateSSLFingerprint: {
+				Type:        schema.TypeString,
+				Description: "The SSL fingerprint",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateStartDate: {
+				Type:        schema.TypeString,
+				Description: "The start date",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateSubject: {
+				Type:        schema.TypeString,
+				Description: "The subject",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentACMECertificateSubjectAlternativeNames: {
+				Type:        schema.TypeList,
+				Description: "The subject alternative names",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: resourceVirtualEnvironmentACMECertificateCustomizeDiff,
+		Create:        resourceVirtualEnvironmentACMECertificateCreate,
+		Read:          resourceVirtualEnvironmentACMECertificateRead,
+		Update:        resourceVirtualEnvironmentACMECertificateUpdate,
+		Delete:        resourceVirtualEnvironmentACMECertificateDelete,
+	}
+}
+
+func resourceVirtualEnvironmentACMECertificateCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentACMECertificateOrder(d, m, d.Get(mkResourceVirtualEnvironmentACMECertificateOverwrite).(bool))
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentACMECertificateNodeName).(string)
+
+	d.SetId(fmt.Sprintf("%s_acme_certificate", nodeName))
+
+	return resourceVirtualEnvironmentACMECertificateRead(d, m)
+}
+
+func resourceVirtualEnvironmentACMECertificateCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	expirationDate := d.Get(mkResourceVirtualEnvironmentACMECertificateExpirationDate).(string)
+	renewBefore := d.Get(mkResourceVirtualEnvironmentACMECertificateRenewBefore).(string)
+
+	if !isCertificateExpiring(expirationDate, renewBefore) {
+		return nil
+	}
+
+	for _, k := range []string{
+		mkResourceVirtualEnvironmentACMECertificateCertificate,
+		mkResourceVirtualEnvironmentACMECertificateExpirationDate,
+		mkResourceVirtualEnvironmentACMECertificateSSLFingerprint,
+		mkResourceVirtualEnvironmentACMECertificateStartDate,
+	} {
+		err := d.SetNewComputed(k)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceVirtualEnvironmentACMECertificateOrder configures the ACME domains of the node and orders a new certificate.
+func resourceVirtualEnvironmentACMECertificateOrder(d *schema.ResourceData, m interface{}, force bool) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	account := d.Get(mkResourceVirtualEnvironmentACMECertificateAccount).(string)
+	domain := d.Get(mkResourceVirtualEnvironmentACMECertificateDomain).([]interface{})
+	nodeName := d.Get(mkResourceVirtualEnvironmentACMECertificateNodeName).(string)
+
+	domains := make([]*proxmox.CustomNodeACMEDomain, maxResourceVirtualEnvironmentACMECertificateDomains)
+
+	for i, v := range domain {
+		block := v.(map[string]interface{})
+
+		alias := block[mkResourceVirtualEnvironmentACMECertificateDomainAlias].(string)
+		plugin := block[mkResourceVirtualEnvironmentACMECertificateDomainPlugin].(string)
+
+		domains[i] = &proxmox.CustomNodeACMEDomain{
+			Domain: block[mkResourceVirtualEnvironmentACMECertificateDomainDomain].(string),
+		}
+
+		if alias != "" {
+			domains[i].Alias = &alias
+		}
+
+		if plugin != "" {
+			domains[i].Plugin = &plugin
+		}
+	}
+
+	body := &proxmox.VirtualEnvironmentNodeUpdateConfigRequestBody{
+		ACME: &proxmox.CustomNodeACME{
+			Account: &account,
+		},
+		ACMEDomain0: domains[0],
+		ACMEDomain1: domains[1],
+		ACMEDomain2: domains[2],
+		ACMEDomain3: domains[3],
+		ACMEDomain4: domains[4],
+		ACMEDomain5: domains[5],
+	}
+
+	for i := len(domain); i < maxResourceVirtualEnvironmentACMECertificateDomains; i++ {
+		body.Delete = append(body.Delete, fmt.Sprintf("acmedomain%d", i))
+	}
+
+	err = veClient.UpdateNodeConfig(nodeName, body)
+
+	if err != nil {
+		return err
+	}
+
+	forceOrder := proxmox.CustomBool(force)
+
+	return veClient.OrderACMECertificate(nodeName, &proxmox.VirtualEnvironmentACMECertificateOrderRequestBody{
+		Force: &forceOrder,
+	}, dvResourceVirtualEnvironmentACMECertificateTimeout)
+}
+
+func resourceVirtualEnvironmentACMECertificateRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentACMECertificateNodeName).(string)
+	nodeConfig, err := veClient.GetNodeConfig(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	domain := []interface{}{}
+
+	for _, v := range []*proxmox.CustomNodeACMEDomain{
+		nodeConfig.ACMEDomain0,
+		nodeConfig.ACMEDomain1,
+		nodeConfig.ACMEDomain2,
+		nodeConfig.ACMEDomain3,
+		nodeConfig.ACMEDomain4,
+		nodeConfig.ACMEDomain5,
+	} {
+		if v == nil {
+			continue
+		}
+
+		block := map[string]interface{}{
+			mkResourceVirtualEnvironmentACMECertificateDomainAlias:  dvResourceVirtualEnvironmentACMECertificateDomainAlias,
+			mkResourceVirtualEnvironmentACMECertificateDomainDomain: v.Domain,
+			mkResourceVirtualEnvironmentACMECertificateDomainPlugin: dvResourceVirtualEnvironmentACMECertificateDomainPlugin,
+		}
+
+		if v.Alias != nil {
+			block[mkResourceVirtualEnvironmentACMECertificateDomainAlias] = *v.Alias
+		}
+
+		if v.Plugin != nil {
+			block[mkResourceVirtualEnvironmentACMECertificateDomainPlugin] = *v.Plugin
+		}
+
+		domain = append(domain, block)
+	}
+
+	if len(domain) == 0 {
+		d.SetId("")
+
+		return nil
+	}
+
+	if nodeConfig.ACME != nil && nodeConfig.ACME.Account != nil {
+		d.Set(mkResourceVirtualEnvironmentACMECertificateAccount, *nodeConfig.ACME.Account)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentACMECertificateAccount, dvResourceVirtualEnvironmentACMECertificateAccount)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACMECertificateDomain, domain)
+
+	list, err := veClient.ListCertificates(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, c := range *list {
+		if c.FileName == nil || *c.FileName != "pveproxy-ssl.pem" {
+			continue
+		}
+
+		if c.Certificates != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateCertificate, *c.Certificates)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateCertificate, "")
+		}
+
+		if c.NotAfter != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateExpirationDate, time.Time(*c.NotAfter).UTC().Format(time.RFC3339))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateExpirationDate, "")
+		}
+
+		if c.Issuer != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateIssuer, *c.Issuer)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateIssuer, "")
+		}
+
+		if c.Fingerprint != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateSSLFingerprint, *c.Fingerprint)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateSSLFingerprint, "")
+		}
+
+		if c.NotBefore != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateStartDate, time.Time(*c.NotBefore).UTC().Format(time.RFC3339))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateStartDate, "")
+		}
+
+		if c.Subject != nil {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateSubject, *c.Subject)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentACMECertificateSubject, "")
+		}
+
+		sanList := []interface{}{}
+
+		if c.SubjectAlternativeNames != nil {
+			for _, san := range *c.SubjectAlternativeNames {
+				sanList = append(sanList, san)
+			}
+		}
+
+		d.Set(mkResourceVirtualEnvironmentACMECertificateSubjectAlternativeNames, sanList)
+
+		return nil
+	}
+
+	// The certificate has been removed from the node, which is why a new one must be ordered.
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentACMECertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange(mkResourceVirtualEnvironmentACMECertificateAccount) || d.HasChange(mkResourceVirtualEnvironmentACMECertificateDomain) {
+		err := resourceVirtualEnvironmentACMECertificateOrder(d, m, true)
+
+		if err != nil {
+			return err
+		}
+
+		return resourceVirtualEnvironmentACMECertificateRead(d, m)
+	}
+
+	// The expiration date in the plan is unknown, when the certificate is due for renewal.
+	expirationDate, _ := d.GetChange(mkResourceVirtualEnvironmentACMECertificateExpirationDate)
+	renewBefore := d.Get(mkResourceVirtualEnvironmentACMECertificateRenewBefore).(string)
+
+	if isCertificateExpiring(expirationDate.(string), renewBefore) {
+		config := m.(providerConfiguration)
+		veClient, err := config.GetVEClient()
+
+		if err != nil {
+			return err
+		}
+
+		nodeName := d.Get(mkResourceVirtualEnvironmentACMECertificateNodeName).(string)
+		force := proxmox.CustomBool(true)
+
+		err = veClient.RenewACMECertificate(nodeName, &proxmox.VirtualEnvironmentACMECertificateRenewRequestBody{
+			Force: &force,
+		}, dvResourceVirtualEnvironmentACMECertificateTimeout)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceVirtualEnvironmentACMECertificateRead(d, m)
+}
+
+func resourceVirtualEnvironmentACMECertificateDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentACMECertificateNodeName).(string)
+
+	err = veClient.RevokeACMECertificate(nodeName, dvResourceVirtualEnvironmentACMECertificateTimeout)
+
+	if err != nil && !strings.Contains(err.Error(), "HTTP 404") && !strings.Contains(err.Error(), "no certificate") {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentNodeUpdateConfigRequestBody{
+		Delete: []string{"acme"},
+	}
+
+	for i := 0; i < maxResourceVirtualEnvironmentACMECertificateDomains; i++ {
+		body.Delete = append(body.Delete, fmt.Sprintf("acmedomain%d", i))
+	}
+
+	err = veClient.UpdateNodeConfig(nodeName, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentACMECertificateInstantiation tests whether the ResourceVirtualEnvironmentACMECertificate instance can be instantiated.
+func TestResourceVirtualEnvironmentACMECertificateInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentACMECertificate()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentACMECertificate")
+	}
+}
+
+// TestResourceVirtualEnvironmentACMECertificateSchema tests the resourceVirtualEnvironmentACMECertificate schema.
+func TestResourceVirtualEnvironmentACMECertificateSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentACMECertificate()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMECertificateDomain,
+		mkResourceVirtualEnvironmentACMECertificateNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMECertificateAccount,
+		mkResourceVirtualEnvironmentACMECertificateOverwrite,
+		mkResourceVirtualEnvironmentACMECertificateRenewBefore,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentACMECertificateCertificate,
+		mkResourceVirtualEnvironmentACMECertificateExpirationDate,
+		mkResourceVirtualEnvironmentACMECertificateIssuer,
+		mkResourceVirtualEnvironmentACMECertificateSSLFingerprint,
+		mkResourceVirtualEnvironmentACMECertificateStartDate,
+		mkResourceVirtualEnvironmentACMECertificateSubject,
+		mkResourceVirtualEnvironmentACMECertificateSubjectAlternativeNames,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentACMECertificateAccount:                 schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateCertificate:             schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateDomain:                  schema.TypeList,
+		mkResourceVirtualEnvironmentACMECertificateExpirationDate:          schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateIssuer:                  schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateNodeName:                schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateOverwrite:               schema.TypeBool,
+		mkResourceVirtualEnvironmentACMECertificateRenewBefore:             schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateSSLFingerprint:          schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateStartDate:               schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateSubject:                 schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateSubjectAlternativeNames: schema.TypeList,
+	})
+
+	domainSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentACMECertificateDomain)
+
+	testRequiredArguments(t, domainSchema, []string{
+		mkResourceVirtualEnvironmentACMECertificateDomainDomain,
+	})
+
+	testOptionalArguments(t, domainSchema, []string{
+		mkResourceVirtualEnvironmentACMECertificateDomainAlias,
+		mkResourceVirtualEnvironmentACMECertificateDomainPlugin,
+	})
+
+	testValueTypes(t, domainSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentACMECertificateDomainAlias:  schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateDomainDomain: schema.TypeString,
+		mkResourceVirtualEnvironmentACMECertificateDomainPlugin: schema.TypeString,
+	})
+}
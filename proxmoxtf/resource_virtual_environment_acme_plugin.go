@@ -0,0 +1,299 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentACMEPluginAPI             = ""
+	dvResourceVirtualEnvironmentACMEPluginEnabled         = true
+	dvResourceVirtualEnvironmentACMEPluginType            = "dns"
+	dvResourceVirtualEnvironmentACMEPluginValidationDelay = 30
+
+	mkResourceVirtualEnvironmentACMEPluginAPI             = "api"
+	mkResourceVirtualEnvironmentACMEPluginData            = "data"
+	mkResourceVirtualEnvironmentACMEPluginEnabled         = "enabled"
+	mkResourceVirtualEnvironmentACMEPluginNodes           = "nodes"
+	mkResourceVirtualEnvironmentACMEPluginPlugin          = "plugin"
+	mkResourceVirtualEnvironmentACMEPluginType            = "type"
+	mkResourceVirtualEnvironmentACMEPluginValidationDelay = "validation_delay"
+)
+
+func resourceVirtualEnvironmentACMEPlugin() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentACMEPluginAPI: {
+				Type:        schema.TypeString,
+				Description: "The DNS API, which the plugin uses (e.g. cf or route53)",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentACMEPluginAPI,
+			},
+			mkResourceVirtualEnvironmentACMEPluginData: {
+				Type:        schema.TypeMap,
+				Description: "The DNS API data (e.g. credentials)",
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentACMEPluginEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the plugin is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentACMEPluginEnabled,
+			},
+			mkResourceVirtualEnvironmentACMEPluginNodes: {
+				Type:        schema.TypeList,
+				Description: "The nodes, which may use the plugin",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentACMEPluginPlugin: {
+				Type:         schema.TypeString,
+				Description:  "The plugin id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getACMEIDValidator(),
+			},
+			mkResourceVirtualEnvironmentACMEPluginType: {
+				Type:         schema.TypeString,
+				Description:  "The plugin type",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentACMEPluginType,
+				ValidateFunc: validation.StringInSlice([]string{"dns", "standalone"}, false),
+			},
+			mkResourceVirtualEnvironmentACMEPluginValidationDelay: {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds to wait before requesting validation",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentACMEPluginValidationDelay,
+				ValidateFunc: validation.IntBetween(0, 172800),
+			},
+		},
+		Create: resourceVirtualEnvironmentACMEPluginCreate,
+		Read:   resourceVirtualEnvironmentACMEPluginRead,
+		Update: resourceVirtualEnvironmentACMEPluginUpdate,
+		Delete: resourceVirtualEnvironmentACMEPluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentACMEPluginCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	pluginID := d.Get(mkResourceVirtualEnvironmentACMEPluginPlugin).(string)
+	pluginType := d.Get(mkResourceVirtualEnvironmentACMEPluginType).(string)
+
+	api, data, disable, nodes, validationDelay := resourceVirtualEnvironmentACMEPluginGetValues(d)
+
+	body := &proxmox.VirtualEnvironmentACMEPluginCreateRequestBody{
+		Disable: &disable,
+		ID:      pluginID,
+		Type:    pluginType,
+	}
+
+	// The standalone plugin does not support any of the DNS specific settings.
+	if pluginType == "dns" {
+		body.ValidationDelay = &validationDelay
+
+		if api != "" {
+			body.API = &api
+		}
+
+		if len(data) > 0 {
+			body.Data = &data
+		}
+	}
+
+	if nodes != "" {
+		body.Nodes = &nodes
+	}
+
+	err = veClient.CreateACMEPlugin(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(pluginID)
+
+	return resourceVirtualEnvironmentACMEPluginRead(d, m)
+}
+
+// resourceVirtualEnvironmentACMEPluginGetValues returns the values, which are shared by the create and update requests.
+func resourceVirtualEnvironmentACMEPluginGetValues(d *schema.ResourceData) (string, proxmox.CustomACMEPluginData, proxmox.CustomBool, string, int) {
+	api := d.Get(mkResourceVirtualEnvironmentACMEPluginAPI).(string)
+	data := d.Get(mkResourceVirtualEnvironmentACMEPluginData).(map[string]interface{})
+	enabled := d.Get(mkResourceVirtualEnvironmentACMEPluginEnabled).(bool)
+	nodes := d.Get(mkResourceVirtualEnvironmentACMEPluginNodes).([]interface{})
+	validationDelay := d.Get(mkResourceVirtualEnvironmentACMEPluginValidationDelay).(int)
+
+	pluginData := proxmox.CustomACMEPluginData{}
+
+	for k, v := range data {
+		pluginData[k] = v.(string)
+	}
+
+	nodeNames := make([]string, len(nodes))
+
+	for i, v := range nodes {
+		nodeNames[i] = v.(string)
+	}
+
+	return api, pluginData, proxmox.CustomBool(!enabled), strings.Join(nodeNames, ","), validationDelay
+}
+
+func resourceVirtualEnvironmentACMEPluginRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	pluginID := d.Id()
+	plugin, err := veClient.GetACMEPlugin(pluginID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "does not exist") ||
+			strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACMEPluginPlugin, pluginID)
+	d.Set(mkResourceVirtualEnvironmentACMEPluginType, plugin.Type)
+
+	if plugin.API != nil {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginAPI, *plugin.API)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginAPI, dvResourceVirtualEnvironmentACMEPluginAPI)
+	}
+
+	data := map[string]interface{}{}
+
+	if plugin.Data != nil {
+		for k, v := range *plugin.Data {
+			data[k] = v
+		}
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACMEPluginData, data)
+
+	if plugin.Disable != nil {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginEnabled, !bool(*plugin.Disable))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginEnabled, true)
+	}
+
+	nodes := []interface{}{}
+
+	if plugin.Nodes != nil && *plugin.Nodes != "" {
+		for _, v := range strings.Split(*plugin.Nodes, ",") {
+			nodes = append(nodes, strings.TrimSpace(v))
+		}
+	}
+
+	d.Set(mkResourceVirtualEnvironmentACMEPluginNodes, nodes)
+
+	if plugin.ValidationDelay != nil {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginValidationDelay, *plugin.ValidationDelay)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentACMEPluginValidationDelay, dvResourceVirtualEnvironmentACMEPluginValidationDelay)
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentACMEPluginUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	pluginType := d.Get(mkResourceVirtualEnvironmentACMEPluginType).(string)
+
+	api, data, disable, nodes, validationDelay := resourceVirtualEnvironmentACMEPluginGetValues(d)
+
+	body := &proxmox.VirtualEnvironmentACMEPluginUpdateRequestBody{
+		Disable: &disable,
+	}
+
+	if pluginType == "dns" {
+		body.ValidationDelay = &validationDelay
+
+		if api != "" {
+			body.API = &api
+		}
+
+		if len(data) > 0 {
+			body.Data = &data
+		} else {
+			body.Delete = append(body.Delete, "data")
+		}
+	}
+
+	if nodes != "" {
+		body.Nodes = &nodes
+	} else {
+		body.Delete = append(body.Delete, "nodes")
+	}
+
+	err = veClient.UpdateACMEPlugin(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentACMEPluginRead(d, m)
+}
+
+func resourceVirtualEnvironmentACMEPluginDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteACMEPlugin(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
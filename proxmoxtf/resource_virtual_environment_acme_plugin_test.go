@@ -0,0 +1,48 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentACMEPluginInstantiation tests whether the ResourceVirtualEnvironmentACMEPlugin instance can be instantiated.
+func TestResourceVirtualEnvironmentACMEPluginInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentACMEPlugin()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentACMEPlugin")
+	}
+}
+
+// TestResourceVirtualEnvironmentACMEPluginSchema tests the resourceVirtualEnvironmentACMEPlugin schema.
+func TestResourceVirtualEnvironmentACMEPluginSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentACMEPlugin()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMEPluginPlugin,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentACMEPluginAPI,
+		mkResourceVirtualEnvironmentACMEPluginData,
+		mkResourceVirtualEnvironmentACMEPluginEnabled,
+		mkResourceVirtualEnvironmentACMEPluginNodes,
+		mkResourceVirtualEnvironmentACMEPluginType,
+		mkResourceVirtualEnvironmentACMEPluginValidationDelay,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentACMEPluginAPI:             schema.TypeString,
+		mkResourceVirtualEnvironmentACMEPluginData:            schema.TypeMap,
+		mkResourceVirtualEnvironmentACMEPluginEnabled:         schema.TypeBool,
+		mkResourceVirtualEnvironmentACMEPluginNodes:           schema.TypeList,
+		mkResourceVirtualEnvironmentACMEPluginPlugin:          schema.TypeString,
+		mkResourceVirtualEnvironmentACMEPluginType:            schema.TypeString,
+		mkResourceVirtualEnvironmentACMEPluginValidationDelay: schema.TypeInt,
+	})
+}
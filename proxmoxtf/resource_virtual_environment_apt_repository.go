@@ -0,0 +1,276 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentAPTRepositoryComment  = ""
+	dvResourceVirtualEnvironmentAPTRepositoryEnabled  = true
+	dvResourceVirtualEnvironmentAPTRepositorySignedBy = ""
+
+	mkResourceVirtualEnvironmentAPTRepositoryComment    = "comment"
+	mkResourceVirtualEnvironmentAPTRepositoryComponents = "components"
+	mkResourceVirtualEnvironmentAPTRepositoryEnabled    = "enabled"
+	mkResourceVirtualEnvironmentAPTRepositoryFilePath   = "file_path"
+	mkResourceVirtualEnvironmentAPTRepositoryName       = "name"
+	mkResourceVirtualEnvironmentAPTRepositoryNodeName   = "node_name"
+	mkResourceVirtualEnvironmentAPTRepositorySignedBy   = "signed_by"
+	mkResourceVirtualEnvironmentAPTRepositorySuites     = "suites"
+	mkResourceVirtualEnvironmentAPTRepositoryTypes      = "types"
+	mkResourceVirtualEnvironmentAPTRepositoryURIs       = "uris"
+)
+
+func resourceVirtualEnvironmentAPTRepository() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentAPTRepositoryComment: {
+				Type:        schema.TypeString,
+				Description: "The repository comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentAPTRepositoryComment,
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryComponents: {
+				Type:        schema.TypeList,
+				Description: "The repository components",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the repository is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentAPTRepositoryEnabled,
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryFilePath: {
+				Type:        schema.TypeString,
+				Description: "The path to the repository file",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryName: {
+				Type:        schema.TypeString,
+				Description: "The repository name, which is used as the file name",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\-_.]*$`),
+					"must begin with a letter or digit and only contain letters, digits, dashes, underscores and periods",
+				),
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentAPTRepositorySignedBy: {
+				Type:        schema.TypeString,
+				Description: "The path to the keyring, which signs the repository",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentAPTRepositorySignedBy,
+			},
+			mkResourceVirtualEnvironmentAPTRepositorySuites: {
+				Type:        schema.TypeList,
+				Description: "The repository suites",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryTypes: {
+				Type:        schema.TypeList,
+				Description: "The package types",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{"deb"}, nil
+				},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"deb", "deb-src"}, false),
+				},
+			},
+			mkResourceVirtualEnvironmentAPTRepositoryURIs: {
+				Type:        schema.TypeList,
+				Description: "The repository URIs",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: resourceVirtualEnvironmentAPTRepositoryCreate,
+		Read:   resourceVirtualEnvironmentAPTRepositoryRead,
+		Update: resourceVirtualEnvironmentAPTRepositoryUpdate,
+		Delete: resourceVirtualEnvironmentAPTRepositoryDelete,
+	}
+}
+
+func resourceVirtualEnvironmentAPTRepositoryCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentAPTRepositoryWrite(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentAPTRepositoryName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTRepositoryNodeName).(string)
+
+	d.SetId(fmt.Sprintf("%s_%s", nodeName, name))
+
+	return resourceVirtualEnvironmentAPTRepositoryRead(d, m)
+}
+
+// resourceVirtualEnvironmentAPTRepositoryGetFileName returns the name of the repository file in the deb822 format.
+func resourceVirtualEnvironmentAPTRepositoryGetFileName(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s.sources", d.Get(mkResourceVirtualEnvironmentAPTRepositoryName).(string))
+}
+
+func resourceVirtualEnvironmentAPTRepositoryRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTRepositoryNodeName).(string)
+	filePath := veClient.GetAPTRepositoryFilePath(resourceVirtualEnvironmentAPTRepositoryGetFileName(d))
+
+	list, err := veClient.ListAPTRepositories(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, f := range list.Files {
+		if f.Path != filePath || len(f.Repositories) == 0 {
+			continue
+		}
+
+		r := f.Repositories[0]
+
+		if r.Comment != nil {
+			d.Set(mkResourceVirtualEnvironmentAPTRepositoryComment, strings.TrimSpace(*r.Comment))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentAPTRepositoryComment, dvResourceVirtualEnvironmentAPTRepositoryComment)
+		}
+
+		signedBy := dvResourceVirtualEnvironmentAPTRepositorySignedBy
+
+		for _, o := range r.Options {
+			if strings.EqualFold(o.Key, "Signed-By") && len(o.Values) > 0 {
+				signedBy = strings.Join(o.Values, " ")
+			}
+		}
+
+		d.Set(mkResourceVirtualEnvironmentAPTRepositoryComponents, r.Components)
+		d.Set(mkResourceVirtualEnvironmentAPTRepositoryEnabled, bool(r.Enabled))
+		d.Set(mkResourceVirtualEnvironmentAPTRepositoryFilePath, f.Path)
+		d.Set(mkResourceVirtualEnvironmentAPTRepositorySignedBy, signedBy)
+		d.Set(mkResourceVirtualEnvironmentAPTRepositorySuites, r.Suites)
+		d.Set(mkResourceVirtualEnvironmentAPTRepositoryTypes, r.Types)
+		d.Set(mkResourceVirtualEnvironmentAPTRepositoryURIs, r.URIs)
+
+		return nil
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentAPTRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentAPTRepositoryWrite(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentAPTRepositoryRead(d, m)
+}
+
+// resourceVirtualEnvironmentAPTRepositoryWrite writes the repository file in the deb822 format.
+func resourceVirtualEnvironmentAPTRepositoryWrite(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentAPTRepositoryComment).(string)
+	components := d.Get(mkResourceVirtualEnvironmentAPTRepositoryComponents).([]interface{})
+	enabled := d.Get(mkResourceVirtualEnvironmentAPTRepositoryEnabled).(bool)
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTRepositoryNodeName).(string)
+	signedBy := d.Get(mkResourceVirtualEnvironmentAPTRepositorySignedBy).(string)
+	suites := d.Get(mkResourceVirtualEnvironmentAPTRepositorySuites).([]interface{})
+	types := d.Get(mkResourceVirtualEnvironmentAPTRepositoryTypes).([]interface{})
+	uris := d.Get(mkResourceVirtualEnvironmentAPTRepositoryURIs).([]interface{})
+
+	joinValues := func(values []interface{}) string {
+		s := make([]string, len(values))
+
+		for i, v := range values {
+			s[i] = v.(string)
+		}
+
+		return strings.Join(s, " ")
+	}
+
+	var content strings.Builder
+
+	if comment != "" {
+		for _, line := range strings.Split(comment, "\n") {
+			content.WriteString(fmt.Sprintf("# %s\n", line))
+		}
+	}
+
+	content.WriteString(fmt.Sprintf("Types: %s\n", joinValues(types)))
+	content.WriteString(fmt.Sprintf("URIs: %s\n", joinValues(uris)))
+	content.WriteString(fmt.Sprintf("Suites: %s\n", joinValues(suites)))
+
+	if len(components) > 0 {
+		content.WriteString(fmt.Sprintf("Components: %s\n", joinValues(components)))
+	}
+
+	if signedBy != "" {
+		content.WriteString(fmt.Sprintf("Signed-By: %s\n", signedBy))
+	}
+
+	if !enabled {
+		content.WriteString("Enabled: no\n")
+	}
+
+	return veClient.WriteAPTRepositoryFile(nodeName, resourceVirtualEnvironmentAPTRepositoryGetFileName(d), content.String())
+}
+
+func resourceVirtualEnvironmentAPTRepositoryDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTRepositoryNodeName).(string)
+
+	err = veClient.DeleteAPTRepositoryFile(nodeName, resourceVirtualEnvironmentAPTRepositoryGetFileName(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
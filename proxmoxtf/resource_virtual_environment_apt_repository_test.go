@@ -0,0 +1,57 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentAPTRepositoryInstantiation tests whether the ResourceVirtualEnvironmentAPTRepository instance can be instantiated.
+func TestResourceVirtualEnvironmentAPTRepositoryInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentAPTRepository()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentAPTRepository")
+	}
+}
+
+// TestResourceVirtualEnvironmentAPTRepositorySchema tests the resourceVirtualEnvironmentAPTRepository schema.
+func TestResourceVirtualEnvironmentAPTRepositorySchema(t *testing.T) {
+	s := resourceVirtualEnvironmentAPTRepository()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentAPTRepositoryName,
+		mkResourceVirtualEnvironmentAPTRepositoryNodeName,
+		mkResourceVirtualEnvironmentAPTRepositorySuites,
+		mkResourceVirtualEnvironmentAPTRepositoryURIs,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentAPTRepositoryComment,
+		mkResourceVirtualEnvironmentAPTRepositoryComponents,
+		mkResourceVirtualEnvironmentAPTRepositoryEnabled,
+		mkResourceVirtualEnvironmentAPTRepositorySignedBy,
+		mkResourceVirtualEnvironmentAPTRepositoryTypes,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentAPTRepositoryFilePath,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentAPTRepositoryComment:    schema.TypeString,
+		mkResourceVirtualEnvironmentAPTRepositoryComponents: schema.TypeList,
+		mkResourceVirtualEnvironmentAPTRepositoryEnabled:    schema.TypeBool,
+		mkResourceVirtualEnvironmentAPTRepositoryFilePath:   schema.TypeString,
+		mkResourceVirtualEnvironmentAPTRepositoryName:       schema.TypeString,
+		mkResourceVirtualEnvironmentAPTRepositoryNodeName:   schema.TypeString,
+		mkResourceVirtualEnvironmentAPTRepositorySignedBy:   schema.TypeString,
+		mkResourceVirtualEnvironmentAPTRepositorySuites:     schema.TypeList,
+		mkResourceVirtualEnvironmentAPTRepositoryTypes:      schema.TypeList,
+		mkResourceVirtualEnvironmentAPTRepositoryURIs:       schema.TypeList,
+	})
+}
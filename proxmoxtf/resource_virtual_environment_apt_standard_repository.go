@@ -0,0 +1,279 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentAPTStandardRepositoryEnabled = true
+
+	mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled  = "enabled"
+	mkResourceVirtualEnvironmentAPTStandardRepositoryFilePath = "file_path"
+	mkResourceVirtualEnvironmentAPTStandardRepositoryHandle   = "handle"
+	mkResourceVirtualEnvironmentAPTStandardRepositoryIndex    = "index"
+	mkResourceVirtualEnvironmentAPTStandardRepositoryName     = "name"
+	mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName = "node_name"
+)
+
+func resourceVirtualEnvironmentAPTStandardRepository() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the repository is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentAPTStandardRepositoryEnabled,
+			},
+			mkResourceVirtualEnvironmentAPTStandardRepositoryFilePath: {
+				Type:        schema.TypeString,
+				Description: "The path to the file, which contains the repository",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentAPTStandardRepositoryHandle: {
+				Type:        schema.TypeString,
+				Description: "The repository handle",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(ceph-[a-z]+-)?(enterprise|no-subscription|test)$`),
+					"must be one of enterprise, no-subscription or test, optionally prefixed with ceph-<release>-",
+				),
+			},
+			mkResourceVirtualEnvironmentAPTStandardRepositoryIndex: {
+				Type:        schema.TypeInt,
+				Description: "The index of the repository within the file",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentAPTStandardRepositoryName: {
+				Type:        schema.TypeString,
+				Description: "The repository name",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentAPTStandardRepositoryCreate,
+		Read:   resourceVirtualEnvironmentAPTStandardRepositoryRead,
+		Update: resourceVirtualEnvironmentAPTStandardRepositoryUpdate,
+		Delete: resourceVirtualEnvironmentAPTStandardRepositoryDelete,
+	}
+}
+
+func resourceVirtualEnvironmentAPTStandardRepositoryCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	handle := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryHandle).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName).(string)
+
+	list, err := veClient.ListAPTRepositories(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	standardRepo := resourceVirtualEnvironmentAPTStandardRepositoryGetStandardRepo(list, handle)
+
+	if standardRepo == nil {
+		return fmt.Errorf("The standard repository %s is not available on node %s", handle, nodeName)
+	}
+
+	if standardRepo.Status == nil {
+		err = veClient.AddAPTStandardRepository(nodeName, &proxmox.VirtualEnvironmentAPTRepositoryAddRequestBody{
+			Handle: handle,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", nodeName, handle))
+
+	return resourceVirtualEnvironmentAPTStandardRepositoryUpdate(d, m)
+}
+
+// resourceVirtualEnvironmentAPTStandardRepositoryGetLocation determines the file and index of a standard repository, as the API only reports its status.
+func resourceVirtualEnvironmentAPTStandardRepositoryGetLocation(list *proxmox.VirtualEnvironmentAPTRepositoryListResponseData, handle string) (string, int, bool) {
+	uriSuffix := "/debian/pve"
+	component := handle
+
+	switch handle {
+	case "enterprise":
+		component = "pve-enterprise"
+	case "no-subscription":
+		component = "pve-no-subscription"
+	case "test":
+		component = "pvetest"
+	default:
+		i := strings.LastIndex(handle, "-enterprise")
+
+		if i < 0 {
+			i = strings.LastIndex(handle, "-no-subscription")
+		}
+
+		if i < 0 {
+			i = strings.LastIndex(handle, "-test")
+		}
+
+		if i < 0 {
+			return "", 0, false
+		}
+
+		uriSuffix = "/debian/" + handle[:i]
+		component = handle[i+1:]
+	}
+
+	for _, f := range list.Files {
+		for i, r := range f.Repositories {
+			uriMatch := false
+
+			for _, u := range r.URIs {
+				if strings.HasSuffix(strings.TrimRight(u, "/"), uriSuffix) {
+					uriMatch = true
+					break
+				}
+			}
+
+			if !uriMatch {
+				continue
+			}
+
+			for _, c := range r.Components {
+				if c == component || (component == "no-subscription" && c == "main") {
+					return f.Path, i, true
+				}
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+// resourceVirtualEnvironmentAPTStandardRepositoryGetStandardRepo returns the standard repository with the specified handle.
+func resourceVirtualEnvironmentAPTStandardRepositoryGetStandardRepo(list *proxmox.VirtualEnvironmentAPTRepositoryListResponseData, handle string) *proxmox.VirtualEnvironmentAPTRepositoryListResponseStandardRepo {
+	for i, r := range list.StandardRepos {
+		if r.Handle == handle {
+			return &list.StandardRepos[i]
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentAPTStandardRepositoryRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	handle := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryHandle).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName).(string)
+
+	list, err := veClient.ListAPTRepositories(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	standardRepo := resourceVirtualEnvironmentAPTStandardRepositoryGetStandardRepo(list, handle)
+
+	if standardRepo == nil || standardRepo.Status == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	filePath, index, _ := resourceVirtualEnvironmentAPTStandardRepositoryGetLocation(list, handle)
+
+	d.Set(mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled, bool(*standardRepo.Status))
+	d.Set(mkResourceVirtualEnvironmentAPTStandardRepositoryFilePath, filePath)
+	d.Set(mkResourceVirtualEnvironmentAPTStandardRepositoryIndex, index)
+	d.Set(mkResourceVirtualEnvironmentAPTStandardRepositoryName, standardRepo.Name)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentAPTStandardRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
+	enabled := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled).(bool)
+
+	err := resourceVirtualEnvironmentAPTStandardRepositorySetEnabled(d, m, enabled)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentAPTStandardRepositoryRead(d, m)
+}
+
+// resourceVirtualEnvironmentAPTStandardRepositorySetEnabled enables or disables a standard repository, unless it is already in the desired state.
+func resourceVirtualEnvironmentAPTStandardRepositorySetEnabled(d *schema.ResourceData, m interface{}, enabled bool) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	handle := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryHandle).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName).(string)
+
+	list, err := veClient.ListAPTRepositories(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	standardRepo := resourceVirtualEnvironmentAPTStandardRepositoryGetStandardRepo(list, handle)
+
+	if standardRepo == nil || standardRepo.Status == nil || bool(*standardRepo.Status) == enabled {
+		return nil
+	}
+
+	filePath, index, ok := resourceVirtualEnvironmentAPTStandardRepositoryGetLocation(list, handle)
+
+	if !ok {
+		return fmt.Errorf("Failed to determine the location of the standard repository %s on node %s", handle, nodeName)
+	}
+
+	enabledFlag := proxmox.CustomBool(enabled)
+
+	return veClient.UpdateAPTRepository(nodeName, &proxmox.VirtualEnvironmentAPTRepositoryUpdateRequestBody{
+		Enabled: &enabledFlag,
+		Index:   index,
+		Path:    filePath,
+	})
+}
+
+func resourceVirtualEnvironmentAPTStandardRepositoryDelete(d *schema.ResourceData, m interface{}) error {
+	// The API does not support removing repositories, which is why the repository is disabled instead.
+	err := resourceVirtualEnvironmentAPTStandardRepositorySetEnabled(d, m, false)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentAPTStandardRepositoryInstantiation tests whether the ResourceVirtualEnvironmentAPTStandardRepository instance can be instantiated.
+func TestResourceVirtualEnvironmentAPTStandardRepositoryInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentAPTStandardRepository()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentAPTStandardRepository")
+	}
+}
+
+// TestResourceVirtualEnvironmentAPTStandardRepositorySchema tests the resourceVirtualEnvironmentAPTStandardRepository schema.
+func TestResourceVirtualEnvironmentAPTStandardRepositorySchema(t *testing.T) {
+	s := resourceVirtualEnvironmentAPTStandardRepository()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentAPTStandardRepositoryHandle,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentAPTStandardRepositoryFilePath,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryIndex,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryName,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentAPTStandardRepositoryEnabled:  schema.TypeBool,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryFilePath: schema.TypeString,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryHandle:   schema.TypeString,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryIndex:    schema.TypeInt,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryName:     schema.TypeString,
+		mkResourceVirtualEnvironmentAPTStandardRepositoryNodeName: schema.TypeString,
+	})
+}
@@ -0,0 +1,228 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentBackupCompression   = "zstd"
+	dvResourceVirtualEnvironmentBackupMode          = "snapshot"
+	dvResourceVirtualEnvironmentBackupNotesTemplate = ""
+	dvResourceVirtualEnvironmentBackupProtected     = false
+	dvResourceVirtualEnvironmentBackupTimeout       = 3600
+
+	mkResourceVirtualEnvironmentBackupCompression   = "compression"
+	mkResourceVirtualEnvironmentBackupDatastoreID   = "datastore_id"
+	mkResourceVirtualEnvironmentBackupExists        = "exists"
+	mkResourceVirtualEnvironmentBackupMode          = "mode"
+	mkResourceVirtualEnvironmentBackupNodeName      = "node_name"
+	mkResourceVirtualEnvironmentBackupNotesTemplate = "notes_template"
+	mkResourceVirtualEnvironmentBackupProtected     = "protected"
+	mkResourceVirtualEnvironmentBackupTriggers      = "triggers"
+	mkResourceVirtualEnvironmentBackupVMID          = "vm_id"
+	mkResourceVirtualEnvironmentBackupVolumeID      = "volume_id"
+)
+
+func resourceVirtualEnvironmentBackup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentBackupCompression: {
+				Type:         schema.TypeString,
+				Description:  "The compression algorithm",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentBackupCompression,
+				ValidateFunc: validation.StringInSlice([]string{"gzip", "lzo", "none", "zstd"}, false),
+			},
+			mkResourceVirtualEnvironmentBackupDatastoreID: {
+				Type:        schema.TypeString,
+				Description: "The datastore id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentBackupExists: {
+				Type:        schema.TypeBool,
+				Description: "Whether the backup still exists in the datastore",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentBackupMode: {
+				Type:         schema.TypeString,
+				Description:  "The backup mode",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentBackupMode,
+				ValidateFunc: getBackupModeValidator(),
+			},
+			mkResourceVirtualEnvironmentBackupNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentBackupNotesTemplate: {
+				Type:        schema.TypeString,
+				Description: "The template for the backup notes",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentBackupNotesTemplate,
+			},
+			mkResourceVirtualEnvironmentBackupProtected: {
+				Type:        schema.TypeBool,
+				Description: "Whether to protect the backup from being pruned",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentBackupProtected,
+			},
+			mkResourceVirtualEnvironmentBackupTriggers: {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values, which trigger a new backup when changed",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentBackupVMID: {
+				Type:         schema.TypeInt,
+				Description:  "The id of the guest to back up",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getVMIDValidator(),
+			},
+			mkResourceVirtualEnvironmentBackupVolumeID: {
+				Type:        schema.TypeString,
+				Description: "The volume id of the backup",
+				Computed:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentBackupCreate,
+		Read:   resourceVirtualEnvironmentBackupRead,
+		Delete: resourceVirtualEnvironmentBackupDelete,
+	}
+}
+
+func resourceVirtualEnvironmentBackupCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	compression := d.Get(mkResourceVirtualEnvironmentBackupCompression).(string)
+	datastoreID := d.Get(mkResourceVirtualEnvironmentBackupDatastoreID).(string)
+	mode := d.Get(mkResourceVirtualEnvironmentBackupMode).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentBackupNodeName).(string)
+	notesTemplate := d.Get(mkResourceVirtualEnvironmentBackupNotesTemplate).(string)
+	protected := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentBackupProtected).(bool))
+	vmID := d.Get(mkResourceVirtualEnvironmentBackupVMID).(int)
+
+	// Record the existing backups in order to identify the one created by this resource.
+	existingBackups, err := resourceVirtualEnvironmentBackupList(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	if compression == "none" {
+		compression = "0"
+	}
+
+	body := &proxmox.VirtualEnvironmentVzdumpRequestBody{
+		Compress: &compression,
+		Mode:     &mode,
+		Storage:  &datastoreID,
+		VMID:     vmID,
+	}
+
+	if notesTemplate != "" {
+		body.NotesTemplate = &notesTemplate
+	}
+
+	if protected {
+		body.Protected = &protected
+	}
+
+	err = veClient.Vzdump(nodeName, dvResourceVirtualEnvironmentBackupTimeout, body)
+
+	if err != nil {
+		return err
+	}
+
+	backups, err := resourceVirtualEnvironmentBackupList(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	for volumeID := range backups {
+		if _, ok := existingBackups[volumeID]; !ok {
+			d.SetId(volumeID)
+
+			return resourceVirtualEnvironmentBackupRead(d, m)
+		}
+	}
+
+	return fmt.Errorf("Failed to determine the volume id of the backup of guest %d in datastore %s", vmID, datastoreID)
+}
+
+func resourceVirtualEnvironmentBackupList(d *schema.ResourceData, m interface{}) (map[string]*proxmox.VirtualEnvironmentDatastoreFileListResponseData, error) {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := "backup"
+	datastoreID := d.Get(mkResourceVirtualEnvironmentBackupDatastoreID).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentBackupNodeName).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentBackupVMID).(int)
+
+	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID, &proxmox.VirtualEnvironmentDatastoreFileListRequestBody{
+		ContentType: &contentType,
+		VMID:        &vmID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	backups := map[string]*proxmox.VirtualEnvironmentDatastoreFileListResponseData{}
+
+	for _, v := range list {
+		backups[v.VolumeID] = v
+	}
+
+	return backups, nil
+}
+
+func resourceVirtualEnvironmentBackupRead(d *schema.ResourceData, m interface{}) error {
+	backups, err := resourceVirtualEnvironmentBackupList(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	// The state is retained when the backup has been removed (e.g. by a prune job), as a new backup should not be
+	// created without the user's consent.
+	_, ok := backups[d.Id()]
+
+	d.Set(mkResourceVirtualEnvironmentBackupExists, ok)
+	d.Set(mkResourceVirtualEnvironmentBackupVolumeID, d.Id())
+
+	return nil
+}
+
+func resourceVirtualEnvironmentBackupDelete(d *schema.ResourceData, m interface{}) error {
+	// The backup is intentionally retained, as it is most likely needed for a restore after the resource is destroyed.
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,660 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentBackupJobAll                  = false
+	dvResourceVirtualEnvironmentBackupJobComment              = ""
+	dvResourceVirtualEnvironmentBackupJobCompression          = "zstd"
+	dvResourceVirtualEnvironmentBackupJobEnabled              = true
+	dvResourceVirtualEnvironmentBackupJobMailNotification     = "always"
+	dvResourceVirtualEnvironmentBackupJobMode                 = "snapshot"
+	dvResourceVirtualEnvironmentBackupJobNodeName             = ""
+	dvResourceVirtualEnvironmentBackupJobNotesTemplate        = ""
+	dvResourceVirtualEnvironmentBackupJobPool                 = ""
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepAll     = false
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepDaily   = 0
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepHourly  = 0
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepLast    = 0
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepMonthly = 0
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepWeekly  = 0
+	dvResourceVirtualEnvironmentBackupJobRetentionKeepYearly  = 0
+
+	mkResourceVirtualEnvironmentBackupJobAll                  = "all"
+	mkResourceVirtualEnvironmentBackupJobComment              = "comment"
+	mkResourceVirtualEnvironmentBackupJobCompression          = "compression"
+	mkResourceVirtualEnvironmentBackupJobDatastoreID          = "datastore_id"
+	mkResourceVirtualEnvironmentBackupJobEnabled              = "enabled"
+	mkResourceVirtualEnvironmentBackupJobExclude              = "exclude"
+	mkResourceVirtualEnvironmentBackupJobJobID                = "job_id"
+	mkResourceVirtualEnvironmentBackupJobMailNotification     = "mail_notification"
+	mkResourceVirtualEnvironmentBackupJobMailTo               = "mail_to"
+	mkResourceVirtualEnvironmentBackupJobMode                 = "mode"
+	mkResourceVirtualEnvironmentBackupJobNodeName             = "node_name"
+	mkResourceVirtualEnvironmentBackupJobNotesTemplate        = "notes_template"
+	mkResourceVirtualEnvironmentBackupJobPool                 = "pool"
+	mkResourceVirtualEnvironmentBackupJobRetention            = "retention"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepAll     = "keep_all"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily   = "keep_daily"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly  = "keep_hourly"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepLast    = "keep_last"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly = "keep_monthly"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly  = "keep_weekly"
+	mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly  = "keep_yearly"
+	mkResourceVirtualEnvironmentBackupJobSchedule             = "schedule"
+	mkResourceVirtualEnvironmentBackupJobVMIDs                = "vm_ids"
+)
+
+func resourceVirtualEnvironmentBackupJob() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentBackupJobAll: {
+				Type:        schema.TypeBool,
+				Description: "Whether to back up all the guests",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobAll,
+			},
+			mkResourceVirtualEnvironmentBackupJobComment: {
+				Type:        schema.TypeString,
+				Description: "The job comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobComment,
+			},
+			mkResourceVirtualEnvironmentBackupJobCompression: {
+				Type:         schema.TypeString,
+				Description:  "The compression algorithm",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentBackupJobCompression,
+				ValidateFunc: validation.StringInSlice([]string{"gzip", "lzo", "none", "zstd"}, false),
+			},
+			mkResourceVirtualEnvironmentBackupJobDatastoreID: {
+				Type:        schema.TypeString,
+				Description: "The datastore id",
+				Required:    true,
+			},
+			mkResourceVirtualEnvironmentBackupJobEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the job is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobEnabled,
+			},
+			mkResourceVirtualEnvironmentBackupJobExclude: {
+				Type:        schema.TypeList,
+				Description: "The ids of the guests to exclude",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeInt},
+			},
+			mkResourceVirtualEnvironmentBackupJobJobID: {
+				Type:         schema.TypeString,
+				Description:  "The job id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getBackupJobIDValidator(),
+			},
+			mkResourceVirtualEnvironmentBackupJobMailNotification: {
+				Type:         schema.TypeString,
+				Description:  "When to send an email notification",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentBackupJobMailNotification,
+				ValidateFunc: validation.StringInSlice([]string{"always", "failure"}, false),
+			},
+			mkResourceVirtualEnvironmentBackupJobMailTo: {
+				Type:        schema.TypeList,
+				Description: "The email notification recipients",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentBackupJobMode: {
+				Type:         schema.TypeString,
+				Description:  "The backup mode",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentBackupJobMode,
+				ValidateFunc: getBackupModeValidator(),
+			},
+			mkResourceVirtualEnvironmentBackupJobNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node to run the job on",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobNodeName,
+			},
+			mkResourceVirtualEnvironmentBackupJobNotesTemplate: {
+				Type:        schema.TypeString,
+				Description: "The template for the backup notes",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobNotesTemplate,
+			},
+			mkResourceVirtualEnvironmentBackupJobPool: {
+				Type:        schema.TypeString,
+				Description: "The pool whose members to back up",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentBackupJobPool,
+			},
+			mkResourceVirtualEnvironmentBackupJobRetention: {
+				Type:        schema.TypeList,
+				Description: "The retention options",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepAll: {
+							Type:        schema.TypeBool,
+							Description: "Whether to keep all backups",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentBackupJobRetentionKeepAll,
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily: {
+							Type:         schema.TypeInt,
+							Description:  "The number of daily backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepDaily,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly: {
+							Type:         schema.TypeInt,
+							Description:  "The number of hourly backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepHourly,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepLast: {
+							Type:         schema.TypeInt,
+							Description:  "The number of most recent backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepLast,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly: {
+							Type:         schema.TypeInt,
+							Description:  "The number of monthly backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepMonthly,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly: {
+							Type:         schema.TypeInt,
+							Description:  "The number of weekly backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepWeekly,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly: {
+							Type:         schema.TypeInt,
+							Description:  "The number of yearly backups to keep",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentBackupJobRetentionKeepYearly,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+				MaxItems: 1,
+				MinItems: 0,
+			},
+			mkResourceVirtualEnvironmentBackupJobSchedule: {
+				Type:         schema.TypeString,
+				Description:  "The schedule as a calendar event",
+				Required:     true,
+				ValidateFunc: getCalendarEventValidator(),
+			},
+			mkResourceVirtualEnvironmentBackupJobVMIDs: {
+				Type:        schema.TypeList,
+				Description: "The ids of the guests to back up",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+		Create: resourceVirtualEnvironmentBackupJobCreate,
+		Read:   resourceVirtualEnvironmentBackupJobRead,
+		Update: resourceVirtualEnvironmentBackupJobUpdate,
+		Delete: resourceVirtualEnvironmentBackupJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentBackupJobCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	jobID := d.Get(mkResourceVirtualEnvironmentBackupJobJobID).(string)
+	updateBody, err := resourceVirtualEnvironmentBackupJobGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentBackupJobCreateRequestBody{
+		All:              updateBody.All,
+		Comment:          updateBody.Comment,
+		Compress:         updateBody.Compress,
+		Enabled:          updateBody.Enabled,
+		Exclude:          updateBody.Exclude,
+		ID:               jobID,
+		MailNotification: updateBody.MailNotification,
+		MailTo:           updateBody.MailTo,
+		Mode:             updateBody.Mode,
+		Node:             updateBody.Node,
+		NotesTemplate:    updateBody.NotesTemplate,
+		Pool:             updateBody.Pool,
+		PruneBackups:     updateBody.PruneBackups,
+		Schedule:         updateBody.Schedule,
+		Storage:          updateBody.Storage,
+		VMIDs:            updateBody.VMIDs,
+	}
+
+	err = veClient.CreateBackupJob(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(jobID)
+
+	return resourceVirtualEnvironmentBackupJobRead(d, m)
+}
+
+func resourceVirtualEnvironmentBackupJobGetUpdateBody(d *schema.ResourceData) (*proxmox.VirtualEnvironmentBackupJobUpdateRequestBody, error) {
+	all := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentBackupJobAll).(bool))
+	comment := d.Get(mkResourceVirtualEnvironmentBackupJobComment).(string)
+	compression := d.Get(mkResourceVirtualEnvironmentBackupJobCompression).(string)
+	datastoreID := d.Get(mkResourceVirtualEnvironmentBackupJobDatastoreID).(string)
+	enabled := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentBackupJobEnabled).(bool))
+	exclude := d.Get(mkResourceVirtualEnvironmentBackupJobExclude).([]interface{})
+	mailNotification := d.Get(mkResourceVirtualEnvironmentBackupJobMailNotification).(string)
+	mailTo := d.Get(mkResourceVirtualEnvironmentBackupJobMailTo).([]interface{})
+	mode := d.Get(mkResourceVirtualEnvironmentBackupJobMode).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentBackupJobNodeName).(string)
+	notesTemplate := d.Get(mkResourceVirtualEnvironmentBackupJobNotesTemplate).(string)
+	pool := d.Get(mkResourceVirtualEnvironmentBackupJobPool).(string)
+	retention := d.Get(mkResourceVirtualEnvironmentBackupJobRetention).([]interface{})
+	schedule := d.Get(mkResourceVirtualEnvironmentBackupJobSchedule).(string)
+	vmIDs := d.Get(mkResourceVirtualEnvironmentBackupJobVMIDs).([]interface{})
+
+	// Exactly one selection method must be used, as the API silently prefers one over the others.
+	selections := 0
+
+	if all {
+		selections++
+	}
+
+	if len(vmIDs) > 0 {
+		selections++
+	}
+
+	if pool != "" {
+		selections++
+	}
+
+	if selections != 1 {
+		return nil, fmt.Errorf(
+			"Exactly one of the \"%s\", \"%s\" and \"%s\" arguments must be specified",
+			mkResourceVirtualEnvironmentBackupJobAll,
+			mkResourceVirtualEnvironmentBackupJobPool,
+			mkResourceVirtualEnvironmentBackupJobVMIDs,
+		)
+	}
+
+	if len(exclude) > 0 && len(vmIDs) > 0 {
+		return nil, fmt.Errorf(
+			"The \"%s\" argument cannot be combined with the \"%s\" argument",
+			mkResourceVirtualEnvironmentBackupJobExclude,
+			mkResourceVirtualEnvironmentBackupJobVMIDs,
+		)
+	}
+
+	if compression == "none" {
+		compression = "0"
+	}
+
+	body := &proxmox.VirtualEnvironmentBackupJobUpdateRequestBody{
+		All:              &all,
+		Compress:         &compression,
+		Enabled:          &enabled,
+		MailNotification: &mailNotification,
+		Mode:             &mode,
+		Schedule:         schedule,
+		Storage:          &datastoreID,
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	} else {
+		body.Delete = append(body.Delete, "comment")
+	}
+
+	if len(exclude) > 0 {
+		for _, v := range exclude {
+			body.Exclude = append(body.Exclude, strconv.Itoa(v.(int)))
+		}
+	} else {
+		body.Delete = append(body.Delete, "exclude")
+	}
+
+	if len(mailTo) > 0 {
+		for _, v := range mailTo {
+			body.MailTo = append(body.MailTo, v.(string))
+		}
+	} else {
+		body.Delete = append(body.Delete, "mailto")
+	}
+
+	if nodeName != "" {
+		body.Node = &nodeName
+	} else {
+		body.Delete = append(body.Delete, "node")
+	}
+
+	if notesTemplate != "" {
+		body.NotesTemplate = &notesTemplate
+	} else {
+		body.Delete = append(body.Delete, "notes-template")
+	}
+
+	if pool != "" {
+		body.Pool = &pool
+	} else {
+		body.Delete = append(body.Delete, "pool")
+	}
+
+	if len(retention) > 0 && retention[0] != nil {
+		retentionBlock := retention[0].(map[string]interface{})
+		pruneBackups := &proxmox.CustomPruneBackups{}
+
+		keepAll := proxmox.CustomBool(retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepAll].(bool))
+		keepSpecified := bool(keepAll)
+
+		if keepAll {
+			pruneBackups.KeepAll = &keepAll
+		}
+
+		keep := map[string]**int{
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily:   &pruneBackups.KeepDaily,
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly:  &pruneBackups.KeepHourly,
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepLast:    &pruneBackups.KeepLast,
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly: &pruneBackups.KeepMonthly,
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly:  &pruneBackups.KeepWeekly,
+			mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly:  &pruneBackups.KeepYearly,
+		}
+
+		for k, p := range keep {
+			v := retentionBlock[k].(int)
+
+			if v > 0 {
+				if keepAll {
+					return nil, errors.New("The keep-all retention option cannot be combined with other retention options")
+				}
+
+				*p = &v
+				keepSpecified = true
+			}
+		}
+
+		if keepSpecified {
+			body.PruneBackups = pruneBackups
+		} else {
+			body.Delete = append(body.Delete, "prune-backups")
+		}
+	} else {
+		body.Delete = append(body.Delete, "prune-backups")
+	}
+
+	if len(vmIDs) > 0 {
+		for _, v := range vmIDs {
+			body.VMIDs = append(body.VMIDs, strconv.Itoa(v.(int)))
+		}
+	} else {
+		body.Delete = append(body.Delete, "vmid")
+	}
+
+	return body, nil
+}
+
+func resourceVirtualEnvironmentBackupJobRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	jobID := d.Id()
+	job, err := veClient.GetBackupJob(jobID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "does not exist") ||
+			strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	intList := func(v *proxmox.CustomCommaSeparatedList) ([]interface{}, error) {
+		list := []interface{}{}
+
+		if v == nil {
+			return list, nil
+		}
+
+		for _, s := range *v {
+			s = strings.TrimSpace(s)
+
+			if s == "" {
+				continue
+			}
+
+			i, err := strconv.Atoi(s)
+
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, i)
+		}
+
+		return list, nil
+	}
+
+	exclude, err := intList(job.Exclude)
+
+	if err != nil {
+		return err
+	}
+
+	vmIDs, err := intList(job.VMIDs)
+
+	if err != nil {
+		return err
+	}
+
+	if job.All != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobAll, bool(*job.All))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobAll, dvResourceVirtualEnvironmentBackupJobAll)
+	}
+
+	if job.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobComment, strings.TrimSpace(*job.Comment))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobComment, dvResourceVirtualEnvironmentBackupJobComment)
+	}
+
+	if job.Compress != nil {
+		switch *job.Compress {
+		case "0":
+			d.Set(mkResourceVirtualEnvironmentBackupJobCompression, "none")
+		case "1":
+			d.Set(mkResourceVirtualEnvironmentBackupJobCompression, "lzo")
+		default:
+			d.Set(mkResourceVirtualEnvironmentBackupJobCompression, *job.Compress)
+		}
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobCompression, "none")
+	}
+
+	if job.Enabled != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobEnabled, bool(*job.Enabled))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobEnabled, dvResourceVirtualEnvironmentBackupJobEnabled)
+	}
+
+	if job.MailNotification != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobMailNotification, *job.MailNotification)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobMailNotification, dvResourceVirtualEnvironmentBackupJobMailNotification)
+	}
+
+	mailTo := []interface{}{}
+
+	if job.MailTo != nil {
+		for _, v := range *job.MailTo {
+			v = strings.TrimSpace(v)
+
+			if v != "" {
+				mailTo = append(mailTo, v)
+			}
+		}
+	}
+
+	if job.Mode != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobMode, *job.Mode)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobMode, dvResourceVirtualEnvironmentBackupJobMode)
+	}
+
+	if job.Node != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobNodeName, *job.Node)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobNodeName, dvResourceVirtualEnvironmentBackupJobNodeName)
+	}
+
+	if job.NotesTemplate != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobNotesTemplate, *job.NotesTemplate)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobNotesTemplate, dvResourceVirtualEnvironmentBackupJobNotesTemplate)
+	}
+
+	if job.Pool != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobPool, *job.Pool)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobPool, dvResourceVirtualEnvironmentBackupJobPool)
+	}
+
+	retention := []interface{}{}
+
+	if job.PruneBackups != nil {
+		intValue := func(v *int) int {
+			if v != nil {
+				return *v
+			}
+
+			return 0
+		}
+
+		retentionBlock := map[string]interface{}{}
+
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepAll] = job.PruneBackups.KeepAll != nil && bool(*job.PruneBackups.KeepAll)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily] = intValue(job.PruneBackups.KeepDaily)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly] = intValue(job.PruneBackups.KeepHourly)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepLast] = intValue(job.PruneBackups.KeepLast)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly] = intValue(job.PruneBackups.KeepMonthly)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly] = intValue(job.PruneBackups.KeepWeekly)
+		retentionBlock[mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly] = intValue(job.PruneBackups.KeepYearly)
+
+		retention = append(retention, retentionBlock)
+	}
+
+	if job.Storage != nil {
+		d.Set(mkResourceVirtualEnvironmentBackupJobDatastoreID, *job.Storage)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentBackupJobDatastoreID, "")
+	}
+
+	d.Set(mkResourceVirtualEnvironmentBackupJobExclude, exclude)
+	d.Set(mkResourceVirtualEnvironmentBackupJobJobID, jobID)
+	d.Set(mkResourceVirtualEnvironmentBackupJobMailTo, mailTo)
+	d.Set(mkResourceVirtualEnvironmentBackupJobRetention, retention)
+	d.Set(mkResourceVirtualEnvironmentBackupJobSchedule, job.Schedule)
+	d.Set(mkResourceVirtualEnvironmentBackupJobVMIDs, vmIDs)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentBackupJobUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentBackupJobGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.UpdateBackupJob(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentBackupJobRead(d, m)
+}
+
+func resourceVirtualEnvironmentBackupJobDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteBackupJob(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
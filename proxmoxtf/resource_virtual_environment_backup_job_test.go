@@ -0,0 +1,88 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentBackupJobInstantiation tests whether the ResourceVirtualEnvironmentBackupJob instance can be instantiated.
+func TestResourceVirtualEnvironmentBackupJobInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentBackupJob()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentBackupJob")
+	}
+}
+
+// TestResourceVirtualEnvironmentBackupJobSchema tests the resourceVirtualEnvironmentBackupJob schema.
+func TestResourceVirtualEnvironmentBackupJobSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentBackupJob()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentBackupJobDatastoreID,
+		mkResourceVirtualEnvironmentBackupJobJobID,
+		mkResourceVirtualEnvironmentBackupJobSchedule,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentBackupJobAll,
+		mkResourceVirtualEnvironmentBackupJobComment,
+		mkResourceVirtualEnvironmentBackupJobCompression,
+		mkResourceVirtualEnvironmentBackupJobEnabled,
+		mkResourceVirtualEnvironmentBackupJobExclude,
+		mkResourceVirtualEnvironmentBackupJobMailNotification,
+		mkResourceVirtualEnvironmentBackupJobMailTo,
+		mkResourceVirtualEnvironmentBackupJobMode,
+		mkResourceVirtualEnvironmentBackupJobNodeName,
+		mkResourceVirtualEnvironmentBackupJobNotesTemplate,
+		mkResourceVirtualEnvironmentBackupJobPool,
+		mkResourceVirtualEnvironmentBackupJobRetention,
+		mkResourceVirtualEnvironmentBackupJobVMIDs,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentBackupJobAll:              schema.TypeBool,
+		mkResourceVirtualEnvironmentBackupJobComment:          schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobCompression:      schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobDatastoreID:      schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobEnabled:          schema.TypeBool,
+		mkResourceVirtualEnvironmentBackupJobExclude:          schema.TypeList,
+		mkResourceVirtualEnvironmentBackupJobJobID:            schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobMailNotification: schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobMailTo:           schema.TypeList,
+		mkResourceVirtualEnvironmentBackupJobMode:             schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobNodeName:         schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobNotesTemplate:    schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobPool:             schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobRetention:        schema.TypeList,
+		mkResourceVirtualEnvironmentBackupJobSchedule:         schema.TypeString,
+		mkResourceVirtualEnvironmentBackupJobVMIDs:            schema.TypeList,
+	})
+
+	retentionSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentBackupJobRetention)
+
+	testOptionalArguments(t, retentionSchema, []string{
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepAll,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepLast,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly,
+	})
+
+	testValueTypes(t, retentionSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepAll:     schema.TypeBool,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepDaily:   schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepHourly:  schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepLast:    schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepMonthly: schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepWeekly:  schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupJobRetentionKeepYearly:  schema.TypeInt,
+	})
+}
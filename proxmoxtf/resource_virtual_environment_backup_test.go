@@ -0,0 +1,57 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentBackupInstantiation tests whether the ResourceVirtualEnvironmentBackup instance can be instantiated.
+func TestResourceVirtualEnvironmentBackupInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentBackup()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentBackup")
+	}
+}
+
+// TestResourceVirtualEnvironmentBackupSchema tests the resourceVirtualEnvironmentBackup schema.
+func TestResourceVirtualEnvironmentBackupSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentBackup()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentBackupDatastoreID,
+		mkResourceVirtualEnvironmentBackupNodeName,
+		mkResourceVirtualEnvironmentBackupVMID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentBackupCompression,
+		mkResourceVirtualEnvironmentBackupMode,
+		mkResourceVirtualEnvironmentBackupNotesTemplate,
+		mkResourceVirtualEnvironmentBackupProtected,
+		mkResourceVirtualEnvironmentBackupTriggers,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentBackupExists,
+		mkResourceVirtualEnvironmentBackupVolumeID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentBackupCompression:   schema.TypeString,
+		mkResourceVirtualEnvironmentBackupDatastoreID:   schema.TypeString,
+		mkResourceVirtualEnvironmentBackupExists:        schema.TypeBool,
+		mkResourceVirtualEnvironmentBackupMode:          schema.TypeString,
+		mkResourceVirtualEnvironmentBackupNodeName:      schema.TypeString,
+		mkResourceVirtualEnvironmentBackupNotesTemplate: schema.TypeString,
+		mkResourceVirtualEnvironmentBackupProtected:     schema.TypeBool,
+		mkResourceVirtualEnvironmentBackupTriggers:      schema.TypeMap,
+		mkResourceVirtualEnvironmentBackupVMID:          schema.TypeInt,
+		mkResourceVirtualEnvironmentBackupVolumeID:      schema.TypeString,
+	})
+}
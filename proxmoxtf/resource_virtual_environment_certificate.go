@@ -5,6 +5,10 @@
 package proxmoxtf
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -16,6 +20,7 @@ import (
 const (
 	dvResourceVirtualEnvironmentCertificateCertificateChain = ""
 	dvResourceVirtualEnvironmentCertificateOverwrite        = false
+	dvResourceVirtualEnvironmentCertificateRenewBefore      = "0s"
 
 	mkResourceVirtualEnvironmentCertificateCertificate             = "certificate"
 	mkResourceVirtualEnvironmentCertificateCertificateChain        = "certificate_chain"
@@ -27,6 +32,7 @@ const (
 	mkResourceVirtualEnvironmentCertificatePrivateKey              = "private_key"
 	mkResourceVirtualEnvironmentCertificatePublicKeySize           = "public_key_size"
 	mkResourceVirtualEnvironmentCertificatePublicKeyType           = "public_key_type"
+	mkResourceVirtualEnvironmentCertificateRenewBefore             = "renew_before"
 	mkResourceVirtualEnvironmentCertificateSSLFingerprint          = "ssl_fingerprint"
 	mkResourceVirtualEnvironmentCertificateStartDate               = "start_date"
 	mkResourceVirtualEnvironmentCertificateSubject                 = "subject"
@@ -90,6 +96,13 @@ func resourceVirtualEnvironmentCertificate() *schema.Resource {
 				Description: "The public key type",
 				Computed:    true,
 			},
+			mkResourceVirtualEnvironmentCertificateRenewBefore: {
+				Type:         schema.TypeString,
+				Description:  "The time before the expiration date, where the certificate is replaced",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentCertificateRenewBefore,
+				ValidateFunc: getTimeoutValidator(),
+			},
 			mkResourceVirtualEnvironmentCertificateSSLFingerprint: {
 				Type:        schema.TypeString,
 				Description: "The SSL fingerprint",
@@ -112,10 +125,11 @@ func resourceVirtualEnvironmentCertificate() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
-		Create: resourceVirtualEnvironmentCertificateCreate,
-		Read:   resourceVirtualEnvironmentCertificateRead,
-		Update: resourceVirtualEnvironmentCertificateUpdate,
-		Delete: resourceVirtualEnvironmentCertificateDelete,
+		CustomizeDiff: resourceVirtualEnvironmentCertificateCustomizeDiff,
+		Create:        resourceVirtualEnvironmentCertificateCreate,
+		Read:          resourceVirtualEnvironmentCertificateRead,
+		Update:        resourceVirtualEnvironmentCertificateUpdate,
+		Delete:        resourceVirtualEnvironmentCertificateDelete,
 	}
 }
 
@@ -133,6 +147,60 @@ func resourceVirtualEnvironmentCertificateCreate(d *schema.ResourceData, m inter
 	return nil
 }
 
+func resourceVirtualEnvironmentCertificateCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown(mkResourceVirtualEnvironmentCertificateCertificate) &&
+		d.NewValueKnown(mkResourceVirtualEnvironmentCertificateCertificateChain) &&
+		d.NewValueKnown(mkResourceVirtualEnvironmentCertificatePrivateKey) {
+		err := resourceVirtualEnvironmentCertificateVerify(
+			d.Get(mkResourceVirtualEnvironmentCertificateCertificate).(string),
+			d.Get(mkResourceVirtualEnvironmentCertificateCertificateChain).(string),
+			d.Get(mkResourceVirtualEnvironmentCertificatePrivateKey).(string),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	expirationDate := d.Get(mkResourceVirtualEnvironmentCertificateExpirationDate).(string)
+	renewBefore := d.Get(mkResourceVirtualEnvironmentCertificateRenewBefore).(string)
+
+	// A zero duration disables the replacement, as the certificate is provided by the configuration.
+	if window, err := time.ParseDuration(renewBefore); err != nil || window <= 0 {
+		return nil
+	}
+
+	if !isCertificateExpiring(expirationDate, renewBefore) {
+		return nil
+	}
+
+	// Replacing the certificate with the same expiring certificate would never converge, which is why the plan fails
+	// until the configuration provides a renewed certificate.
+	oldCertificate, newCertificate := d.GetChange(mkResourceVirtualEnvironmentCertificateCertificate)
+
+	if d.NewValueKnown(mkResourceVirtualEnvironmentCertificateCertificate) &&
+		strings.TrimSpace(oldCertificate.(string)) == strings.TrimSpace(newCertificate.(string)) {
+		return fmt.Errorf(
+			"The certificate expires at %s, which is within the \"%s\" window of %s - Please provide a renewed certificate",
+			expirationDate,
+			mkResourceVirtualEnvironmentCertificateRenewBefore,
+			renewBefore,
+		)
+	}
+
+	err := d.SetNewComputed(mkResourceVirtualEnvironmentCertificateExpirationDate)
+
+	if err != nil {
+		return err
+	}
+
+	return d.ForceNew(mkResourceVirtualEnvironmentCertificateCertificate)
+}
+
 func resourceVirtualEnvironmentCertificateGetUpdateBody(d *schema.ResourceData, m interface{}) (*proxmox.VirtualEnvironmentCertificateUpdateRequestBody, error) {
 	certificate := d.Get(mkResourceVirtualEnvironmentCertificateCertificate).(string)
 	certificateChain := d.Get(mkResourceVirtualEnvironmentCertificateCertificateChain).(string)
@@ -302,6 +370,73 @@ func resourceVirtualEnvironmentCertificateUpdate(d *schema.ResourceData, m inter
 	return resourceVirtualEnvironmentCertificateRead(d, m)
 }
 
+// resourceVirtualEnvironmentCertificateVerify verifies that the private key matches the certificate and that the chain is ordered correctly.
+func resourceVirtualEnvironmentCertificateVerify(certificate string, certificateChain string, privateKey string) error {
+	if certificate == "" || privateKey == "" {
+		return nil
+	}
+
+	_, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey))
+
+	if err != nil {
+		return fmt.Errorf("The private key does not match the certificate - Reason: %s", err.Error())
+	}
+
+	certificates := []*x509.Certificate{}
+	rest := []byte(strings.TrimSpace(certificate) + "\n" + strings.TrimSpace(certificateChain) + "\n")
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		c, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			return fmt.Errorf("The certificate chain contains an invalid certificate - Reason: %s", err.Error())
+		}
+
+		certificates = append(certificates, c)
+	}
+
+	if len(certificates) == 0 {
+		return errors.New("The certificate does not contain any PEM encoded certificates")
+	}
+
+	for i := 0; i < len(certificates)-1; i++ {
+		if certificates[i].Equal(certificates[i+1]) {
+			return fmt.Errorf(
+				"The certificate chain must not repeat certificate %d (%s)",
+				i+1,
+				certificates[i].Subject.String(),
+			)
+		}
+
+		err = certificates[i].CheckSignatureFrom(certificates[i+1])
+
+		if err != nil {
+			return fmt.Errorf(
+				"The certificate chain is not ordered correctly, as certificate %d (%s) is not issued by certificate %d (%s) - Reason: %s",
+				i+1,
+				certificates[i].Subject.String(),
+				i+2,
+				certificates[i+1].Subject.String(),
+				err.Error(),
+			)
+		}
+	}
+
+	return nil
+}
+
 func resourceVirtualEnvironmentCertificateDelete(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
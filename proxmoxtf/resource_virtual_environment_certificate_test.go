@@ -5,9 +5,19 @@
 package proxmoxtf
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // TestResourceVirtualEnvironmentCertificateInstantiation tests whether the ResourceVirtualEnvironmentCertificate instance can be instantiated.
@@ -31,6 +41,7 @@ func TestResourceVirtualEnvironmentCertificateSchema(t *testing.T) {
 
 	testOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentCertificateCertificateChain,
+		mkResourceVirtualEnvironmentCertificateRenewBefore,
 	})
 
 	testComputedAttributes(t, s, []string{
@@ -55,9 +66,155 @@ func TestResourceVirtualEnvironmentCertificateSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentCertificatePrivateKey:              schema.TypeString,
 		mkResourceVirtualEnvironmentCertificatePublicKeySize:           schema.TypeInt,
 		mkResourceVirtualEnvironmentCertificatePublicKeyType:           schema.TypeString,
+		mkResourceVirtualEnvironmentCertificateRenewBefore:             schema.TypeString,
 		mkResourceVirtualEnvironmentCertificateSSLFingerprint:          schema.TypeString,
 		mkResourceVirtualEnvironmentCertificateStartDate:               schema.TypeString,
 		mkResourceVirtualEnvironmentCertificateSubject:                 schema.TypeString,
 		mkResourceVirtualEnvironmentCertificateSubjectAlternativeNames: schema.TypeList,
 	})
 }
+
+// TestResourceVirtualEnvironmentCertificateVerify tests the verification of private keys and certificate chains.
+func TestResourceVirtualEnvironmentCertificateVerify(t *testing.T) {
+	root, rootKey, rootPEM, _ := testCreateCertificate(t, "root", nil, nil)
+	intermediate, intermediateKey, intermediatePEM, _ := testCreateCertificate(t, "intermediate", root, rootKey)
+	_, _, leafPEM, leafKeyPEM := testCreateCertificate(t, "leaf", intermediate, intermediateKey)
+	_, _, _, otherKeyPEM := testCreateCertificate(t, "other", nil, nil)
+
+	tests := []struct {
+		name             string
+		certificate      string
+		certificateChain string
+		privateKey       string
+		valid            bool
+	}{
+		{"certificate without chain", leafPEM, "", leafKeyPEM, true},
+		{"certificate with ordered chain", leafPEM, intermediatePEM + rootPEM, leafKeyPEM, true},
+		{"certificate with partial chain", leafPEM, intermediatePEM, leafKeyPEM, true},
+		{"certificate with repeated certificate", leafPEM, leafPEM + intermediatePEM, leafKeyPEM, false},
+		{"certificate with reversed chain", leafPEM, rootPEM + intermediatePEM, leafKeyPEM, false},
+		{"certificate with unrelated chain", leafPEM, rootPEM, leafKeyPEM, false},
+		{"certificate with mismatched key", leafPEM, intermediatePEM, otherKeyPEM, false},
+		{"certificate with invalid key", leafPEM, "", "invalid", false},
+	}
+
+	for _, tt := range tests {
+		err := resourceVirtualEnvironmentCertificateVerify(tt.certificate, tt.certificateChain, tt.privateKey)
+
+		if tt.valid && err != nil {
+			t.Fatalf("Expected %s to be valid - Reason: %s", tt.name, err.Error())
+		} else if !tt.valid && err == nil {
+			t.Fatalf("Expected %s to be invalid", tt.name)
+		}
+	}
+}
+
+// testCreateCertificate creates a certificate, which is signed by the parent or, if no parent is given, by itself.
+func testCreateCertificate(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("Failed to generate private key - Reason: %s", err.Error())
+	}
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil || commonName != "leaf",
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+	}
+
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+
+	if err != nil {
+		t.Fatalf("Failed to create certificate - Reason: %s", err.Error())
+	}
+
+	c, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("Failed to parse certificate - Reason: %s", err.Error())
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatalf("Failed to marshal private key - Reason: %s", err.Error())
+	}
+
+	certificatePEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	return c, key, certificatePEM, keyPEM
+}
+
+// TestResourceVirtualEnvironmentCertificateCustomizeDiff tests the replacement of certificates, which are about to expire.
+func TestResourceVirtualEnvironmentCertificateCustomizeDiff(t *testing.T) {
+	_, _, certificatePEM, keyPEM := testCreateCertificate(t, "expiring", nil, nil)
+	_, _, renewedCertificatePEM, renewedKeyPEM := testCreateCertificate(t, "renewed", nil, nil)
+
+	r := resourceVirtualEnvironmentCertificate()
+
+	plan := func(expirationDate time.Time, certificate string, privateKey string) (*terraform.InstanceDiff, error) {
+		state := &terraform.InstanceState{
+			ID: "pve_certificate",
+			Attributes: map[string]string{
+				"id": "pve_certificate",
+				mkResourceVirtualEnvironmentCertificateCertificate:      certificatePEM,
+				mkResourceVirtualEnvironmentCertificateCertificateChain: "",
+				mkResourceVirtualEnvironmentCertificateExpirationDate:   expirationDate.UTC().Format(time.RFC3339),
+				mkResourceVirtualEnvironmentCertificateNodeName:         "pve",
+				mkResourceVirtualEnvironmentCertificateOverwrite:        "false",
+				mkResourceVirtualEnvironmentCertificatePrivateKey:       keyPEM,
+				mkResourceVirtualEnvironmentCertificateRenewBefore:      "720h",
+			},
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			mkResourceVirtualEnvironmentCertificateCertificate: certificate,
+			mkResourceVirtualEnvironmentCertificateNodeName:    "pve",
+			mkResourceVirtualEnvironmentCertificatePrivateKey:  privateKey,
+			mkResourceVirtualEnvironmentCertificateRenewBefore: "720h",
+		})
+
+		if err != nil {
+			t.Fatalf("Failed to create the configuration - Reason: %s", err.Error())
+		}
+
+		return r.Diff(state, terraform.NewResourceConfig(raw), nil)
+	}
+
+	diff, err := plan(time.Now().Add(2000*time.Hour), certificatePEM, keyPEM)
+
+	if err != nil {
+		t.Fatalf("Expected a certificate outside of the renewal window to be accepted - Reason: %s", err.Error())
+	}
+
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("Expected a certificate outside of the renewal window to be retained")
+	}
+
+	_, err = plan(time.Now().Add(24*time.Hour), certificatePEM, keyPEM)
+
+	if err == nil {
+		t.Fatalf("Expected an expiring certificate, which has not been renewed, to be rejected")
+	}
+
+	diff, err = plan(time.Now().Add(24*time.Hour), renewedCertificatePEM, renewedKeyPEM)
+
+	if err != nil {
+		t.Fatalf("Expected a renewed certificate to be accepted - Reason: %s", err.Error())
+	}
+
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("Expected an expiring certificate to be replaced by the renewed certificate")
+	}
+}
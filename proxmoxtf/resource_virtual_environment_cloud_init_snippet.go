@@ -0,0 +1,506 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	dvResourceVirtualEnvironmentCloudInitSnippetData            = ""
+	dvResourceVirtualEnvironmentCloudInitSnippetPartContentType = "text/cloud-config"
+	dvResourceVirtualEnvironmentCloudInitSnippetPartFileName    = ""
+	dvResourceVirtualEnvironmentCloudInitSnippetType            = "user"
+	dvResourceVirtualEnvironmentCloudInitSnippetVMID            = -1
+
+	mkResourceVirtualEnvironmentCloudInitSnippetData            = "data"
+	mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID     = "datastore_id"
+	mkResourceVirtualEnvironmentCloudInitSnippetFileName        = "file_name"
+	mkResourceVirtualEnvironmentCloudInitSnippetNodeName        = "node_name"
+	mkResourceVirtualEnvironmentCloudInitSnippetPart            = "part"
+	mkResourceVirtualEnvironmentCloudInitSnippetPartContent     = "content"
+	mkResourceVirtualEnvironmentCloudInitSnippetPartContentType = "content_type"
+	mkResourceVirtualEnvironmentCloudInitSnippetPartFileName    = "file_name"
+	mkResourceVirtualEnvironmentCloudInitSnippetRenderedData    = "rendered_data"
+	mkResourceVirtualEnvironmentCloudInitSnippetType            = "type"
+	mkResourceVirtualEnvironmentCloudInitSnippetVMID            = "vm_id"
+)
+
+func resourceVirtualEnvironmentCloudInitSnippet() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentCloudInitSnippetData: {
+				Type:        schema.TypeString,
+				Description: "The cloud-init data",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentCloudInitSnippetData,
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID: {
+				Type:        schema.TypeString,
+				Description: "The datastore id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetFileName: {
+				Type:         schema.TypeString,
+				Description:  "The file name",
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: getCloudInitSnippetFileNameValidator(),
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetPart: {
+				Type:        schema.TypeList,
+				Description: "The parts to merge into a MIME multipart document",
+				Optional:    true,
+				ForceNew:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentCloudInitSnippetPartContent: {
+							Type:        schema.TypeString,
+							Description: "The part content",
+							Required:    true,
+							ForceNew:    true,
+						},
+						mkResourceVirtualEnvironmentCloudInitSnippetPartContentType: {
+							Type:         schema.TypeString,
+							Description:  "The part content type",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dvResourceVirtualEnvironmentCloudInitSnippetPartContentType,
+							ValidateFunc: getCloudInitPartContentTypeValidator(),
+						},
+						mkResourceVirtualEnvironmentCloudInitSnippetPartFileName: {
+							Type:        schema.TypeString,
+							Description: "The part file name",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentCloudInitSnippetPartFileName,
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetRenderedData: {
+				Type:        schema.TypeString,
+				Description: "The rendered cloud-init data",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetType: {
+				Type:         schema.TypeString,
+				Description:  "The cloud-init data type",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentCloudInitSnippetType,
+				ValidateFunc: getCloudInitTypeValidator(),
+			},
+			mkResourceVirtualEnvironmentCloudInitSnippetVMID: {
+				Type:         schema.TypeInt,
+				Description:  "The VM identifier",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentCloudInitSnippetVMID,
+				ValidateFunc: getVMIDValidator(),
+			},
+		},
+		CustomizeDiff: resourceVirtualEnvironmentCloudInitSnippetCustomizeDiff,
+		Create:        resourceVirtualEnvironmentCloudInitSnippetCreate,
+		Read:          resourceVirtualEnvironmentCloudInitSnippetRead,
+		Delete:        resourceVirtualEnvironmentCloudInitSnippetDelete,
+	}
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	datastoreID := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID).(string)
+	fileName := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetFileName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetNodeName).(string)
+	snippetType := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetType).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetVMID).(int)
+
+	data, err := resourceVirtualEnvironmentCloudInitSnippetRender(
+		snippetType,
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetData).(string),
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetPart).([]interface{}),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentCloudInitSnippetValidate(snippetType, data)
+
+	if err != nil {
+		return err
+	}
+
+	if fileName == "" {
+		fileName = resourceVirtualEnvironmentCloudInitSnippetGetFileName(snippetType, vmID, data)
+	}
+
+	volumeID := fmt.Sprintf("%s:snippets/%s", datastoreID, fileName)
+
+	// Refuse to overwrite existing snippets as they may be referenced by other virtual machines.
+	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID, nil)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range list {
+		if v.VolumeID == volumeID {
+			return fmt.Errorf("A snippet with the name \"%s\" already exists in datastore \"%s\"", fileName, datastoreID)
+		}
+	}
+
+	fileSize := int64(len(data))
+
+	body := &proxmox.VirtualEnvironmentDatastoreUploadRequestBody{
+		ContentType: "snippets",
+		DatastoreID: datastoreID,
+		FileName:    fileName,
+		FileReader:  strings.NewReader(data),
+		FileSize:    &fileSize,
+		NodeName:    nodeName,
+	}
+
+	_, err = veClient.UploadFileToDatastore(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(volumeID)
+
+	d.Set(mkResourceVirtualEnvironmentCloudInitSnippetFileName, fileName)
+	d.Set(mkResourceVirtualEnvironmentCloudInitSnippetRenderedData, data)
+
+	return resourceVirtualEnvironmentCloudInitSnippetRead(d, m)
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(mkResourceVirtualEnvironmentCloudInitSnippetData) ||
+		!d.NewValueKnown(mkResourceVirtualEnvironmentCloudInitSnippetPart) ||
+		!d.NewValueKnown(mkResourceVirtualEnvironmentCloudInitSnippetType) {
+		return nil
+	}
+
+	data, err := resourceVirtualEnvironmentCloudInitSnippetRender(
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetType).(string),
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetData).(string),
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetPart).([]interface{}),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentCloudInitSnippetValidate(
+		d.Get(mkResourceVirtualEnvironmentCloudInitSnippetType).(string),
+		data,
+	)
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetGetFileName(snippetType string, vmID int, data string) string {
+	// The checksum ensures that a replacement snippet does not collide with the one it replaces (create_before_destroy).
+	checksum := fmt.Sprintf("%x", sha256.Sum256([]byte(data)))
+
+	if vmID != -1 {
+		return fmt.Sprintf("vm-%d-cloud-init-%s-%s.yaml", vmID, snippetType, checksum[:12])
+	}
+
+	return fmt.Sprintf("cloud-init-%s-%s.yaml", snippetType, checksum[:12])
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	datastoreID := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetNodeName).(string)
+
+	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID, nil)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range list {
+		if v.VolumeID == d.Id() {
+			return nil
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetRender(snippetType string, data string, parts []interface{}) (string, error) {
+	if data != "" && len(parts) > 0 {
+		return "", fmt.Errorf(
+			"The \"%s\" and \"%s\" arguments are mutually exclusive",
+			mkResourceVirtualEnvironmentCloudInitSnippetData,
+			mkResourceVirtualEnvironmentCloudInitSnippetPart,
+		)
+	}
+
+	if len(parts) == 0 {
+		if data == "" {
+			return "", fmt.Errorf(
+				"Either the \"%s\" or the \"%s\" argument must be specified",
+				mkResourceVirtualEnvironmentCloudInitSnippetData,
+				mkResourceVirtualEnvironmentCloudInitSnippetPart,
+			)
+		}
+
+		return data, nil
+	}
+
+	if snippetType != "user" && snippetType != "vendor" {
+		return "", fmt.Errorf("Multipart documents are only supported for user and vendor data")
+	}
+
+	// Derive the boundary from the parts in order to keep the rendered document stable between runs.
+	h := sha256.New()
+
+	for i, p := range parts {
+		part := p.(map[string]interface{})
+		content := part[mkResourceVirtualEnvironmentCloudInitSnippetPartContent].(string)
+		contentType := part[mkResourceVirtualEnvironmentCloudInitSnippetPartContentType].(string)
+
+		if contentType == "text/cloud-config" {
+			err := resourceVirtualEnvironmentCloudInitSnippetValidateYAML(content)
+
+			if err != nil {
+				return "", fmt.Errorf("Part %d contains invalid cloud-config data - Reason: %s", i+1, err.Error())
+			}
+		}
+
+		h.Write([]byte(contentType))
+		h.Write([]byte(content))
+	}
+
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	err := w.SetBoundary(fmt.Sprintf("MIMEBOUNDARY%x", h.Sum(nil)[:16]))
+
+	if err != nil {
+		return "", err
+	}
+
+	buf.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\n", w.Boundary()))
+	buf.WriteString("MIME-Version: 1.0\n\n")
+
+	for i, p := range parts {
+		part := p.(map[string]interface{})
+		content := part[mkResourceVirtualEnvironmentCloudInitSnippetPartContent].(string)
+		contentType := part[mkResourceVirtualEnvironmentCloudInitSnippetPartContentType].(string)
+		fileName := part[mkResourceVirtualEnvironmentCloudInitSnippetPartFileName].(string)
+
+		if fileName == "" {
+			fileName = fmt.Sprintf("part-%03d", i+1)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", contentType))
+		header.Set("MIME-Version", "1.0")
+
+		pw, err := w.CreatePart(header)
+
+		if err != nil {
+			return "", err
+		}
+
+		_, err = pw.Write([]byte(content))
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = w.Close()
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetValidate(snippetType string, data string) error {
+	switch snippetType {
+	case "meta":
+		err := resourceVirtualEnvironmentCloudInitSnippetValidateYAML(data)
+
+		if err != nil {
+			return fmt.Errorf("The meta data is invalid - Reason: %s", err.Error())
+		}
+	case "network":
+		err := resourceVirtualEnvironmentCloudInitSnippetValidateNetworkData(data)
+
+		if err != nil {
+			return fmt.Errorf("The network data is invalid - Reason: %s", err.Error())
+		}
+	default:
+		err := resourceVirtualEnvironmentCloudInitSnippetValidateUserData(data)
+
+		if err != nil {
+			return fmt.Errorf("The %s data is invalid - Reason: %s", snippetType, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetValidateNetworkData(data string) error {
+	err := resourceVirtualEnvironmentCloudInitSnippetValidateYAML(data)
+
+	if err != nil {
+		return err
+	}
+
+	document := map[string]interface{}{}
+	err = yaml.Unmarshal([]byte(data), &document)
+
+	if err != nil {
+		return err
+	}
+
+	if network, ok := document["network"].(map[interface{}]interface{}); ok {
+		if _, ok := network["version"]; ok {
+			return nil
+		}
+	}
+
+	if _, ok := document["version"]; !ok {
+		return errors.New("The document does not specify a network configuration version")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetValidateUserData(data string) error {
+	firstLine := strings.TrimSpace(strings.SplitN(data, "\n", 2)[0])
+	template := false
+
+	// Jinja templates carry their own header line before the actual cloud-init header and cannot be parsed as YAML
+	// until cloud-init has rendered them.
+	if strings.HasPrefix(firstLine, "## template:") {
+		lines := strings.SplitN(data, "\n", 3)
+
+		if len(lines) < 2 {
+			return errors.New("The document is missing a header after the template declaration")
+		}
+
+		firstLine = strings.TrimSpace(lines[1])
+		template = true
+	}
+
+	if firstLine == "#cloud-config" {
+		if template {
+			return nil
+		}
+
+		return resourceVirtualEnvironmentCloudInitSnippetValidateYAML(data)
+	}
+
+	headers := []string{
+		"#!",
+		"#cloud-boothook",
+		"#cloud-config-archive",
+		"#include",
+		"#part-handler",
+		"#upstart-job",
+		"Content-Type: multipart/",
+	}
+
+	for _, v := range headers {
+		if strings.HasPrefix(firstLine, v) {
+			return nil
+		}
+	}
+
+	return errors.New("The document must begin with a \"#cloud-config\" header or another supported cloud-init header")
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetValidateYAML(data string) error {
+	var document interface{}
+
+	err := yaml.Unmarshal([]byte(data), &document)
+
+	if err != nil {
+		return err
+	}
+
+	if document == nil {
+		return nil
+	}
+
+	if _, ok := document.(map[interface{}]interface{}); !ok {
+		return errors.New("The document is not a YAML mapping")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentCloudInitSnippetDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	datastoreID := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentCloudInitSnippetNodeName).(string)
+
+	err = veClient.DeleteDatastoreFile(nodeName, datastoreID, d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
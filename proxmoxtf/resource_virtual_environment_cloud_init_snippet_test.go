@@ -0,0 +1,168 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentCloudInitSnippetInstantiation tests whether the ResourceVirtualEnvironmentCloudInitSnippet instance can be instantiated.
+func TestResourceVirtualEnvironmentCloudInitSnippetInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentCloudInitSnippet()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentCloudInitSnippet")
+	}
+}
+
+// TestResourceVirtualEnvironmentCloudInitSnippetSchema tests the resourceVirtualEnvironmentCloudInitSnippet schema.
+func TestResourceVirtualEnvironmentCloudInitSnippetSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentCloudInitSnippet()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID,
+		mkResourceVirtualEnvironmentCloudInitSnippetNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentCloudInitSnippetData,
+		mkResourceVirtualEnvironmentCloudInitSnippetFileName,
+		mkResourceVirtualEnvironmentCloudInitSnippetPart,
+		mkResourceVirtualEnvironmentCloudInitSnippetType,
+		mkResourceVirtualEnvironmentCloudInitSnippetVMID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentCloudInitSnippetFileName,
+		mkResourceVirtualEnvironmentCloudInitSnippetRenderedData,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentCloudInitSnippetData:         schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetDatastoreID:  schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetFileName:     schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetNodeName:     schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetPart:         schema.TypeList,
+		mkResourceVirtualEnvironmentCloudInitSnippetRenderedData: schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetType:         schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetVMID:         schema.TypeInt,
+	})
+
+	partSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentCloudInitSnippetPart)
+
+	testRequiredArguments(t, partSchema, []string{
+		mkResourceVirtualEnvironmentCloudInitSnippetPartContent,
+	})
+
+	testOptionalArguments(t, partSchema, []string{
+		mkResourceVirtualEnvironmentCloudInitSnippetPartContentType,
+		mkResourceVirtualEnvironmentCloudInitSnippetPartFileName,
+	})
+
+	testValueTypes(t, partSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentCloudInitSnippetPartContent:     schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetPartContentType: schema.TypeString,
+		mkResourceVirtualEnvironmentCloudInitSnippetPartFileName:    schema.TypeString,
+	})
+}
+
+// TestResourceVirtualEnvironmentCloudInitSnippetValidate tests the cloud-init data validation.
+func TestResourceVirtualEnvironmentCloudInitSnippetValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		snippetType string
+		data        string
+		valid       bool
+	}{
+		{"user data with header", "user", "#cloud-config\npackages:\n  - qemu-guest-agent\n", true},
+		{"user data without header", "user", "packages:\n  - qemu-guest-agent\n", false},
+		{"user data with invalid yaml", "user", "#cloud-config\npackages: [qemu-guest-agent\n", false},
+		{"user data script", "user", "#!/bin/sh\necho test\n", true},
+		{"user data jinja template", "user", "## template: jinja\n#cloud-config\nhostname: {{ v1.local_hostname }}\n", true},
+		{"network data version 1", "network", "version: 1\nconfig: []\n", true},
+		{"network data version 2", "network", "network:\n  version: 2\n", true},
+		{"network data without version", "network", "ethernets: {}\n", false},
+		{"meta data list", "meta", "- instance-id\n", false},
+	}
+
+	for _, tt := range tests {
+		err := resourceVirtualEnvironmentCloudInitSnippetValidate(tt.snippetType, tt.data)
+
+		if tt.valid && err != nil {
+			t.Fatalf("Expected %s to be valid - Reason: %s", tt.name, err.Error())
+		} else if !tt.valid && err == nil {
+			t.Fatalf("Expected %s to be invalid", tt.name)
+		}
+	}
+}
+
+// TestResourceVirtualEnvironmentCloudInitSnippetRender tests the rendering of multipart documents.
+func TestResourceVirtualEnvironmentCloudInitSnippetRender(t *testing.T) {
+	parts := []interface{}{
+		map[string]interface{}{
+			mkResourceVirtualEnvironmentCloudInitSnippetPartContent:     "#cloud-config\nhostname: test\n",
+			mkResourceVirtualEnvironmentCloudInitSnippetPartContentType: "text/cloud-config",
+			mkResourceVirtualEnvironmentCloudInitSnippetPartFileName:    "",
+		},
+		map[string]interface{}{
+			mkResourceVirtualEnvironmentCloudInitSnippetPartContent:     "#!/bin/sh\necho test\n",
+			mkResourceVirtualEnvironmentCloudInitSnippetPartContentType: "text/x-shellscript",
+			mkResourceVirtualEnvironmentCloudInitSnippetPartFileName:    "test.sh",
+		},
+	}
+
+	data, err := resourceVirtualEnvironmentCloudInitSnippetRender("user", "", parts)
+
+	if err != nil {
+		t.Fatalf("Failed to render multipart document - Reason: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(data, "Content-Type: multipart/mixed;") {
+		t.Fatalf("Expected the rendered document to begin with a multipart header")
+	}
+
+	if !strings.Contains(data, "filename=\"part-001\"") || !strings.Contains(data, "filename=\"test.sh\"") {
+		t.Fatalf("Expected the rendered document to contain both parts")
+	}
+
+	err = resourceVirtualEnvironmentCloudInitSnippetValidate("user", data)
+
+	if err != nil {
+		t.Fatalf("Expected the rendered document to be valid - Reason: %s", err.Error())
+	}
+
+	again, _ := resourceVirtualEnvironmentCloudInitSnippetRender("user", "", parts)
+
+	if again != data {
+		t.Fatalf("Expected the rendered document to be stable")
+	}
+
+	_, err = resourceVirtualEnvironmentCloudInitSnippetRender("network", "", parts)
+
+	if err == nil {
+		t.Fatalf("Expected multipart network data to be rejected")
+	}
+}
+
+// TestResourceVirtualEnvironmentCloudInitSnippetGetFileName tests whether replacement snippets receive a new file name.
+func TestResourceVirtualEnvironmentCloudInitSnippetGetFileName(t *testing.T) {
+	first := resourceVirtualEnvironmentCloudInitSnippetGetFileName("user", 100, "#cloud-config\nhostname: first\n")
+	second := resourceVirtualEnvironmentCloudInitSnippetGetFileName("user", 100, "#cloud-config\nhostname: second\n")
+
+	if !strings.HasPrefix(first, "vm-100-cloud-init-user-") || !strings.HasSuffix(first, ".yaml") {
+		t.Fatalf("Unexpected file name \"%s\"", first)
+	}
+
+	if first == second {
+		t.Fatalf("Expected the file name to change along with the data")
+	}
+
+	if first != resourceVirtualEnvironmentCloudInitSnippetGetFileName("user", 100, "#cloud-config\nhostname: first\n") {
+		t.Fatalf("Expected the file name to be stable")
+	}
+}
@@ -0,0 +1,201 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive = false
+	dvResourceVirtualEnvironmentClusterOptionsTagStyleOrdering      = "config"
+	dvResourceVirtualEnvironmentClusterOptionsTagStyleShape         = "circle"
+
+	mkResourceVirtualEnvironmentClusterOptionsTagStyle              = "tag_style"
+	mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive = "case_sensitive"
+	mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap      = "color_map"
+	mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering      = "ordering"
+	mkResourceVirtualEnvironmentClusterOptionsTagStyleShape         = "shape"
+)
+
+func resourceVirtualEnvironmentClusterOptions() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentClusterOptionsTagStyle: {
+				Type:        schema.TypeList,
+				Description: "The tag style",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive: {
+							Type:        schema.TypeBool,
+							Description: "Whether to treat tags as case-sensitive when sorting and filtering",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive,
+						},
+						mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap: {
+							Type:         schema.TypeMap,
+							Description:  "The colors of the tags",
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: getTagColorMapValidator(),
+						},
+						mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering: {
+							Type:         schema.TypeString,
+							Description:  "The tag ordering",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentClusterOptionsTagStyleOrdering,
+							ValidateFunc: validation.StringInSlice([]string{"alphabetical", "config"}, false),
+						},
+						mkResourceVirtualEnvironmentClusterOptionsTagStyleShape: {
+							Type:         schema.TypeString,
+							Description:  "The tag shape in the tree view",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentClusterOptionsTagStyleShape,
+							ValidateFunc: validation.StringInSlice([]string{"circle", "dense", "full", "none"}, false),
+						},
+					},
+				},
+				MaxItems: 1,
+				MinItems: 0,
+			},
+		},
+		Create: resourceVirtualEnvironmentClusterOptionsCreate,
+		Read:   resourceVirtualEnvironmentClusterOptionsRead,
+		Update: resourceVirtualEnvironmentClusterOptionsUpdate,
+		Delete: resourceVirtualEnvironmentClusterOptionsDelete,
+	}
+}
+
+func resourceVirtualEnvironmentClusterOptionsCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentClusterOptionsUpdate(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("cluster")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentClusterOptionsRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	options, err := veClient.GetClusterOptions()
+
+	if err != nil {
+		return err
+	}
+
+	tagStyle := []interface{}{}
+
+	if options.TagStyle != nil {
+		tagStyleBlock := map[string]interface{}{}
+
+		if options.TagStyle.CaseSensitive != nil {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive] = bool(*options.TagStyle.CaseSensitive)
+		} else {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive] = dvResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive
+		}
+
+		colorMap := map[string]interface{}{}
+
+		for tag, color := range options.TagStyle.ColorMap {
+			colorMap[tag] = color
+		}
+
+		tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap] = colorMap
+
+		if options.TagStyle.Ordering != nil {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering] = *options.TagStyle.Ordering
+		} else {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering] = dvResourceVirtualEnvironmentClusterOptionsTagStyleOrdering
+		}
+
+		if options.TagStyle.Shape != nil {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleShape] = *options.TagStyle.Shape
+		} else {
+			tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleShape] = dvResourceVirtualEnvironmentClusterOptionsTagStyleShape
+		}
+
+		tagStyle = append(tagStyle, tagStyleBlock)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentClusterOptionsTagStyle, tagStyle)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentClusterOptionsUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	tagStyle := d.Get(mkResourceVirtualEnvironmentClusterOptionsTagStyle).([]interface{})
+
+	body := &proxmox.VirtualEnvironmentClusterOptionsUpdateRequestBody{}
+
+	if len(tagStyle) > 0 {
+		tagStyleBlock := tagStyle[0].(map[string]interface{})
+
+		caseSensitive := proxmox.CustomBool(tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive].(bool))
+		colorMap := tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap].(map[string]interface{})
+		ordering := tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering].(string)
+		shape := tagStyleBlock[mkResourceVirtualEnvironmentClusterOptionsTagStyleShape].(string)
+
+		body.TagStyle = &proxmox.CustomTagStyle{
+			CaseSensitive: &caseSensitive,
+			ColorMap:      map[string]string{},
+			Ordering:      &ordering,
+			Shape:         &shape,
+		}
+
+		for tag, color := range colorMap {
+			body.TagStyle.ColorMap[tag] = color.(string)
+		}
+	} else {
+		body.Delete = append(body.Delete, "tag-style")
+	}
+
+	err = veClient.UpdateClusterOptions(body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentClusterOptionsRead(d, m)
+}
+
+func resourceVirtualEnvironmentClusterOptionsDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.UpdateClusterOptions(&proxmox.VirtualEnvironmentClusterOptionsUpdateRequestBody{
+		Delete: []string{"tag-style"},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
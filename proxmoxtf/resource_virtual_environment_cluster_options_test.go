@@ -0,0 +1,49 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentClusterOptionsInstantiation tests whether the ResourceVirtualEnvironmentClusterOptions instance can be instantiated.
+func TestResourceVirtualEnvironmentClusterOptionsInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentClusterOptions()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentClusterOptions")
+	}
+}
+
+// TestResourceVirtualEnvironmentClusterOptionsSchema tests the resourceVirtualEnvironmentClusterOptions schema.
+func TestResourceVirtualEnvironmentClusterOptionsSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentClusterOptions()
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentClusterOptionsTagStyle,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentClusterOptionsTagStyle: schema.TypeList,
+	})
+
+	tagStyleSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentClusterOptionsTagStyle)
+
+	testOptionalArguments(t, tagStyleSchema, []string{
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleShape,
+	})
+
+	testValueTypes(t, tagStyleSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleCaseSensitive: schema.TypeBool,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleColorMap:      schema.TypeMap,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleOrdering:      schema.TypeString,
+		mkResourceVirtualEnvironmentClusterOptionsTagStyleShape:         schema.TypeString,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/danitso/terraform-provider-proxmox/proxmox"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -41,9 +42,14 @@ const (
 	dvResourceVirtualEnvironmentContainerNetworkInterfaceRateLimit         = 0
 	dvResourceVirtualEnvironmentContainerNetworkInterfaceVLANID            = 0
 	dvResourceVirtualEnvironmentContainerOperatingSystemType               = "unmanaged"
+	dvResourceVirtualEnvironmentContainerPlacementDatastoreID              = ""
+	dvResourceVirtualEnvironmentContainerPlacementStrategy                 = "memory"
 	dvResourceVirtualEnvironmentContainerPoolID                            = ""
+	dvResourceVirtualEnvironmentContainerRestoreDatastoreID                = ""
+	dvResourceVirtualEnvironmentContainerRestoreUnique                     = false
 	dvResourceVirtualEnvironmentContainerStarted                           = true
 	dvResourceVirtualEnvironmentContainerTemplate                          = false
+	dvResourceVirtualEnvironmentContainerTimeoutRestore                    = "30m"
 	dvResourceVirtualEnvironmentContainerVMID                              = -1
 
 	maxResourceVirtualEnvironmentContainerNetworkInterfaces = 8
@@ -93,9 +99,19 @@ const (
 	mkResourceVirtualEnvironmentContainerOperatingSystem                   = "operating_system"
 	mkResourceVirtualEnvironmentContainerOperatingSystemTemplateFileID     = "template_file_id"
 	mkResourceVirtualEnvironmentContainerOperatingSystemType               = "type"
+	mkResourceVirtualEnvironmentContainerPlacement                         = "placement"
+	mkResourceVirtualEnvironmentContainerPlacementDatastoreID              = "datastore_id"
+	mkResourceVirtualEnvironmentContainerPlacementNodes                    = "nodes"
+	mkResourceVirtualEnvironmentContainerPlacementStrategy                 = "strategy"
 	mkResourceVirtualEnvironmentContainerPoolID                            = "pool_id"
+	mkResourceVirtualEnvironmentContainerRestore                           = "restore"
+	mkResourceVirtualEnvironmentContainerRestoreBackupFile                 = "backup_file"
+	mkResourceVirtualEnvironmentContainerRestoreDatastoreID                = "datastore_id"
+	mkResourceVirtualEnvironmentContainerRestoreUnique                     = "unique"
 	mkResourceVirtualEnvironmentContainerStarted                           = "started"
+	mkResourceVirtualEnvironmentContainerTags                              = "tags"
 	mkResourceVirtualEnvironmentContainerTemplate                          = "template"
+	mkResourceVirtualEnvironmentContainerTimeoutRestore                    = "timeout_restore"
 	mkResourceVirtualEnvironmentContainerVMID                              = "vm_id"
 )
 
@@ -487,7 +503,8 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 			mkResourceVirtualEnvironmentContainerNodeName: {
 				Type:        schema.TypeString,
 				Description: "The node name",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			mkResourceVirtualEnvironmentContainerOperatingSystem: {
@@ -516,6 +533,43 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 				MaxItems: 1,
 				MinItems: 0,
 			},
+			mkResourceVirtualEnvironmentContainerPlacement: {
+				Type:        schema.TypeList,
+				Description: "The placement configuration, which selects the node when node_name is omitted",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentContainerPlacementDatastoreID: {
+							Type:        schema.TypeString,
+							Description: "The ID of a datastore, which the node must have access to",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentContainerPlacementDatastoreID,
+						},
+						mkResourceVirtualEnvironmentContainerPlacementNodes: {
+							Type:        schema.TypeList,
+							Description: "The names of the nodes to choose from",
+							Optional:    true,
+							DefaultFunc: func() (interface{}, error) {
+								return []interface{}{}, nil
+							},
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						mkResourceVirtualEnvironmentContainerPlacementStrategy: {
+							Type:         schema.TypeString,
+							Description:  "The strategy for selecting the node",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentContainerPlacementStrategy,
+							ValidateFunc: getPlacementStrategyValidator(),
+						},
+					},
+				},
+				MaxItems:      1,
+				MinItems:      0,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentContainerNodeName},
+			},
 			mkResourceVirtualEnvironmentContainerPoolID: {
 				Type:        schema.TypeString,
 				Description: "The ID of the pool to assign the container to",
@@ -523,6 +577,42 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 				ForceNew:    true,
 				Default:     dvResourceVirtualEnvironmentContainerPoolID,
 			},
+			mkResourceVirtualEnvironmentContainerRestore: {
+				Type:        schema.TypeList,
+				Description: "The restore configuration",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentContainerRestoreBackupFile: {
+							Type:         schema.TypeString,
+							Description:  "The volume ID of the backup to restore",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: getFileIDValidator(),
+						},
+						mkResourceVirtualEnvironmentContainerRestoreDatastoreID: {
+							Type:        schema.TypeString,
+							Description: "The ID of the target datastore",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentContainerRestoreDatastoreID,
+						},
+						mkResourceVirtualEnvironmentContainerRestoreUnique: {
+							Type:        schema.TypeBool,
+							Description: "Whether to assign unique random ethernet addresses",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentContainerRestoreUnique,
+						},
+					},
+				},
+				MaxItems:      1,
+				MinItems:      0,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentContainerClone},
+			},
 			mkResourceVirtualEnvironmentContainerStarted: {
 				Type:        schema.TypeBool,
 				Description: "Whether to start the container",
@@ -532,6 +622,16 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 					return d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool)
 				},
 			},
+			mkResourceVirtualEnvironmentContainerTags: {
+				Type:        schema.TypeSet,
+				Description: "The tags",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: getTagValidator(),
+				},
+				Set: getTagHash,
+			},
 			mkResourceVirtualEnvironmentContainerTemplate: {
 				Type:        schema.TypeBool,
 				Description: "Whether to create a template",
@@ -539,6 +639,13 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 				ForceNew:    true,
 				Default:     dvResourceVirtualEnvironmentContainerTemplate,
 			},
+			mkResourceVirtualEnvironmentContainerTimeoutRestore: {
+				Type:         schema.TypeString,
+				Description:  "The maximum amount of time to wait for a backup to be restored",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentContainerTimeoutRestore,
+				ValidateFunc: getTimeoutValidator(),
+			},
 			mkResourceVirtualEnvironmentContainerVMID: {
 				Type:         schema.TypeInt,
 				Description:  "The VM identifier",
@@ -556,12 +663,24 @@ func resourceVirtualEnvironmentContainer() *schema.Resource {
 }
 
 func resourceVirtualEnvironmentContainerCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentContainerCreatePlacement(d, m)
+
+	if err != nil {
+		return err
+	}
+
 	clone := d.Get(mkResourceVirtualEnvironmentContainerClone).([]interface{})
 
 	if len(clone) > 0 {
 		return resourceVirtualEnvironmentContainerCreateClone(d, m)
 	}
 
+	restore := d.Get(mkResourceVirtualEnvironmentContainerRestore).([]interface{})
+
+	if len(restore) > 0 {
+		return resourceVirtualEnvironmentContainerCreateRestore(d, m)
+	}
+
 	return resourceVirtualEnvironmentContainerCreateCustom(d, m)
 }
 
@@ -593,21 +712,10 @@ func resourceVirtualEnvironmentContainerCreateClone(d *schema.ResourceData, m in
 	poolID := d.Get(mkResourceVirtualEnvironmentContainerPoolID).(string)
 	vmID := d.Get(mkResourceVirtualEnvironmentContainerVMID).(int)
 
-	if vmID == -1 {
-		vmIDNew, err := veClient.GetVMID()
-
-		if err != nil {
-			return err
-		}
-
-		vmID = *vmIDNew
-	}
-
 	fullCopy := proxmox.CustomBool(true)
 
 	cloneBody := &proxmox.VirtualEnvironmentContainerCloneRequestBody{
 		FullCopy: &fullCopy,
-		VMIDNew:  vmID,
 	}
 
 	if cloneDatastoreID != "" {
@@ -626,28 +734,354 @@ func resourceVirtualEnvironmentContainerCreateClone(d *schema.ResourceData, m in
 		cloneBody.PoolID = &poolID
 	}
 
-	if cloneNodeName != "" && cloneNodeName != nodeName {
-		cloneBody.TargetNodeName = &nodeName
+	if cloneNodeName != "" && cloneNodeName != nodeName {
+		cloneBody.TargetNodeName = &nodeName
+	} else {
+		cloneNodeName = nodeName
+	}
+
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		cloneBody.VMIDNew = vmID
+
+		return veClient.CloneContainer(cloneNodeName, cloneVMID, cloneBody)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	// Wait for the container to be created and its configuration lock to be released.
+	err = veClient.WaitForContainerLock(nodeName, vmID, 600, 5, true)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentContainerCreateReconfigure(d, m)
+}
+
+func resourceVirtualEnvironmentContainerCreateCustom(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentContainerNodeName).(string)
+	resource := resourceVirtualEnvironmentContainer()
+
+	consoleBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerConsole}, 0, true)
+
+	if err != nil {
+		return err
+	}
+
+	consoleEnabled := proxmox.CustomBool(consoleBlock[mkResourceVirtualEnvironmentContainerConsoleEnabled].(bool))
+	consoleMode := consoleBlock[mkResourceVirtualEnvironmentContainerConsoleMode].(string)
+	consoleTTYCount := consoleBlock[mkResourceVirtualEnvironmentContainerConsoleTTYCount].(int)
+
+	cpuBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerCPU}, 0, true)
+
+	if err != nil {
+		return err
+	}
+
+	cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentContainerCPUArchitecture].(string)
+	cpuCores := cpuBlock[mkResourceVirtualEnvironmentContainerCPUCores].(int)
+	cpuUnits := cpuBlock[mkResourceVirtualEnvironmentContainerCPUUnits].(int)
+
+	description := d.Get(mkResourceVirtualEnvironmentContainerDescription).(string)
+
+	diskBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerDisk}, 0, true)
+
+	if err != nil {
+		return err
+	}
+
+	diskDatastoreID := diskBlock[mkResourceVirtualEnvironmentContainerDiskDatastoreID].(string)
+
+	initialization := d.Get(mkResourceVirtualEnvironmentContainerInitialization).([]interface{})
+	initializationDNSDomain := dvResourceVirtualEnvironmentContainerInitializationDNSDomain
+	initializationDNSServer := dvResourceVirtualEnvironmentContainerInitializationDNSServer
+	initializationHostname := dvResourceVirtualEnvironmentContainerInitializationHostname
+	initializationIPConfigIPv4Address := []string{}
+	initializationIPConfigIPv4Gateway := []string{}
+	initializationIPConfigIPv6Address := []string{}
+	initializationIPConfigIPv6Gateway := []string{}
+	initializationUserAccountKeys := proxmox.VirtualEnvironmentContainerCustomSSHKeys{}
+	initializationUserAccountPassword := dvResourceVirtualEnvironmentContainerInitializationUserAccountPassword
+
+	if len(initialization) > 0 {
+		initializationBlock := initialization[0].(map[string]interface{})
+		initializationDNS := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationDNS].([]interface{})
+
+		if len(initializationDNS) > 0 {
+			initializationDNSBlock := initializationDNS[0].(map[string]interface{})
+			initializationDNSDomain = initializationDNSBlock[mkResourceVirtualEnvironmentContainerInitializationDNSDomain].(string)
+			initializationDNSServer = initializationDNSBlock[mkResourceVirtualEnvironmentContainerInitializationDNSServer].(string)
+		}
+
+		initializationHostname = initializationBlock[mkResourceVirtualEnvironmentContainerInitializationHostname].(string)
+		initializationIPConfig := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfig].([]interface{})
+
+		for _, c := range initializationIPConfig {
+			configBlock := c.(map[string]interface{})
+			ipv4 := configBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4].([]interface{})
+
+			if len(ipv4) > 0 {
+				ipv4Block := ipv4[0].(map[string]interface{})
+
+				initializationIPConfigIPv4Address = append(initializationIPConfigIPv4Address, ipv4Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4Address].(string))
+				initializationIPConfigIPv4Gateway = append(initializationIPConfigIPv4Gateway, ipv4Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4Gateway].(string))
+			} else {
+				initializationIPConfigIPv4Address = append(initializationIPConfigIPv4Address, "")
+				initializationIPConfigIPv4Gateway = append(initializationIPConfigIPv4Gateway, "")
+			}
+
+			ipv6 := configBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6].([]interface{})
+
+			if len(ipv6) > 0 {
+				ipv6Block := ipv6[0].(map[string]interface{})
+
+				initializationIPConfigIPv6Address = append(initializationIPConfigIPv6Address, ipv6Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6Address].(string))
+				initializationIPConfigIPv6Gateway = append(initializationIPConfigIPv6Gateway, ipv6Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6Gateway].(string))
+			} else {
+				initializationIPConfigIPv6Address = append(initializationIPConfigIPv6Address, "")
+				initializationIPConfigIPv6Gateway = append(initializationIPConfigIPv6Gateway, "")
+			}
+		}
+
+		initializationUserAccount := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccount].([]interface{})
+
+		if len(initializationUserAccount) > 0 {
+			initializationUserAccountBlock := initializationUserAccount[0].(map[string]interface{})
+
+			keys := initializationUserAccountBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccountKeys].([]interface{})
+			initializationUserAccountKeys = make(proxmox.VirtualEnvironmentContainerCustomSSHKeys, len(keys))
+
+			for ki, kv := range keys {
+				initializationUserAccountKeys[ki] = kv.(string)
+			}
+
+			initializationUserAccountPassword = initializationUserAccountBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccountPassword].(string)
+		}
+	}
+
+	memoryBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerMemory}, 0, true)
+
+	if err != nil {
+		return err
+	}
+
+	memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentContainerMemoryDedicated].(int)
+	memorySwap := memoryBlock[mkResourceVirtualEnvironmentContainerMemorySwap].(int)
+
+	networkInterface := d.Get(mkResourceVirtualEnvironmentContainerNetworkInterface).([]interface{})
+	networkInterfaceArray := make(proxmox.VirtualEnvironmentContainerCustomNetworkInterfaceArray, len(networkInterface))
+
+	for ni, nv := range networkInterface {
+		networkInterfaceMap := nv.(map[string]interface{})
+		networkInterfaceObject := proxmox.VirtualEnvironmentContainerCustomNetworkInterface{}
+
+		bridge := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceBridge].(string)
+		enabled := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceEnabled].(bool)
+		macAddress := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceMACAddress].(string)
+		name := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceName].(string)
+		rateLimit := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceRateLimit].(float64)
+		vlanID := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceVLANID].(int)
+
+		if bridge != "" {
+			networkInterfaceObject.Bridge = &bridge
+		}
+
+		networkInterfaceObject.Enabled = enabled
+
+		if len(initializationIPConfigIPv4Address) > ni {
+			if initializationIPConfigIPv4Address[ni] != "" {
+				networkInterfaceObject.IPv4Address = &initializationIPConfigIPv4Address[ni]
+			}
+
+			if initializationIPConfigIPv4Gateway[ni] != "" {
+				networkInterfaceObject.IPv4Gateway = &initializationIPConfigIPv4Gateway[ni]
+			}
+
+			if initializationIPConfigIPv6Address[ni] != "" {
+				networkInterfaceObject.IPv6Address = &initializationIPConfigIPv6Address[ni]
+			}
+
+			if initializationIPConfigIPv6Gateway[ni] != "" {
+				networkInterfaceObject.IPv6Gateway = &initializationIPConfigIPv6Gateway[ni]
+			}
+		}
+
+		if macAddress != "" {
+			networkInterfaceObject.MACAddress = &macAddress
+		}
+
+		networkInterfaceObject.Name = name
+
+		if rateLimit != 0 {
+			networkInterfaceObject.RateLimit = &rateLimit
+		}
+
+		if vlanID != 0 {
+			networkInterfaceObject.Tag = &vlanID
+		}
+
+		networkInterfaceArray[ni] = networkInterfaceObject
+	}
+
+	operatingSystem := d.Get(mkResourceVirtualEnvironmentContainerOperatingSystem).([]interface{})
+
+	if len(operatingSystem) == 0 {
+		return fmt.Errorf("\"%s\": required field is not set", mkResourceVirtualEnvironmentContainerOperatingSystem)
+	}
+
+	operatingSystemBlock := operatingSystem[0].(map[string]interface{})
+	operatingSystemTemplateFileID := operatingSystemBlock[mkResourceVirtualEnvironmentContainerOperatingSystemTemplateFileID].(string)
+	operatingSystemType := operatingSystemBlock[mkResourceVirtualEnvironmentContainerOperatingSystemType].(string)
+
+	poolID := d.Get(mkResourceVirtualEnvironmentContainerPoolID).(string)
+	started := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerStarted).(bool))
+	tags := d.Get(mkResourceVirtualEnvironmentContainerTags).(*schema.Set).List()
+	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool))
+	vmID := d.Get(mkResourceVirtualEnvironmentContainerVMID).(int)
+
+	// Attempt to create the resource using the retrieved values.
+	createBody := proxmox.VirtualEnvironmentContainerCreateRequestBody{
+		ConsoleEnabled:       &consoleEnabled,
+		ConsoleMode:          &consoleMode,
+		CPUArchitecture:      &cpuArchitecture,
+		CPUCores:             &cpuCores,
+		CPUUnits:             &cpuUnits,
+		DatastoreID:          &diskDatastoreID,
+		DedicatedMemory:      &memoryDedicated,
+		NetworkInterfaces:    networkInterfaceArray,
+		OSTemplateFileVolume: &operatingSystemTemplateFileID,
+		OSType:               &operatingSystemType,
+		StartOnBoot:          &started,
+		Swap:                 &memorySwap,
+		Template:             &template,
+		TTY:                  &consoleTTYCount,
+	}
+
+	if description != "" {
+		createBody.Description = &description
+	}
+
+	if len(tags) > 0 {
+		tagsString := getTagString(tags)
+		createBody.Tags = &tagsString
+	}
+
+	if initializationDNSDomain != "" {
+		createBody.DNSDomain = &initializationDNSDomain
+	}
+
+	if initializationDNSServer != "" {
+		createBody.DNSServer = &initializationDNSServer
+	}
+
+	if initializationHostname != "" {
+		createBody.Hostname = &initializationHostname
+	}
+
+	if len(initializationUserAccountKeys) > 0 {
+		createBody.SSHKeys = &initializationUserAccountKeys
+	}
+
+	if initializationUserAccountPassword != "" {
+		createBody.Password = &initializationUserAccountPassword
+	}
+
+	if poolID != "" {
+		createBody.PoolID = &poolID
+	}
+
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		createBody.VMID = &vmID
+
+		return veClient.CreateContainer(nodeName, &createBody)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	// Wait for the container's lock to be released.
+	err = veClient.WaitForContainerLock(nodeName, vmID, 600, 5, true)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentContainerCreateStart(d, m)
+}
+
+func resourceVirtualEnvironmentContainerCreatePlacement(d *schema.ResourceData, m interface{}) error {
+	nodeName := d.Get(mkResourceVirtualEnvironmentContainerNodeName).(string)
+
+	if nodeName != "" {
+		return nil
+	}
+
+	placement := d.Get(mkResourceVirtualEnvironmentContainerPlacement).([]interface{})
+
+	if len(placement) == 0 {
+		return fmt.Errorf("Either %s or %s must be specified", mkResourceVirtualEnvironmentContainerNodeName, mkResourceVirtualEnvironmentContainerPlacement)
+	}
+
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	placementBlock := placement[0].(map[string]interface{})
+	placementDatastoreID := placementBlock[mkResourceVirtualEnvironmentContainerPlacementDatastoreID].(string)
+	placementNodes := placementBlock[mkResourceVirtualEnvironmentContainerPlacementNodes].([]interface{})
+	placementStrategy := placementBlock[mkResourceVirtualEnvironmentContainerPlacementStrategy].(string)
+
+	allowedNodeNames := make([]string, len(placementNodes))
+
+	for i, v := range placementNodes {
+		allowedNodeNames[i] = v.(string)
+	}
+
+	nodeName, err = getPlacementNodeName(veClient, allowedNodeNames, placementDatastoreID, placementStrategy)
 
-		err = veClient.CloneContainer(cloneNodeName, cloneVMID, cloneBody)
-	} else {
-		err = veClient.CloneContainer(nodeName, cloneVMID, cloneBody)
+	if err != nil {
+		return err
 	}
 
+	// The selected node is stored in the state, which prevents the container from being moved by subsequent plans.
+	d.Set(mkResourceVirtualEnvironmentContainerNodeName, nodeName)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentContainerCreateReconfigure(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
 	if err != nil {
 		return err
 	}
 
-	d.SetId(strconv.Itoa(vmID))
-
-	// Wait for the container to be created and its configuration lock to be released.
-	err = veClient.WaitForContainerLock(nodeName, vmID, 600, 5, true)
+	nodeName := d.Get(mkResourceVirtualEnvironmentContainerNodeName).(string)
+	vmID, err := strconv.Atoi(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	// Now that the virtual machine has been cloned, we need to perform some modifications.
+	// Now that the container has been cloned or restored, we need to perform some modifications.
 	updateBody := &proxmox.VirtualEnvironmentContainerUpdateRequestBody{}
 
 	console := d.Get(mkResourceVirtualEnvironmentContainerConsole).([]interface{})
@@ -678,6 +1112,7 @@ func resourceVirtualEnvironmentContainerCreateClone(d *schema.ResourceData, m in
 		updateBody.CPUUnits = &cpuUnits
 	}
 
+	initialization := d.Get(mkResourceVirtualEnvironmentContainerInitialization).([]interface{})
 	initializationIPConfigIPv4Address := []string{}
 	initializationIPConfigIPv4Gateway := []string{}
 	initializationIPConfigIPv6Address := []string{}
@@ -853,6 +1288,13 @@ func resourceVirtualEnvironmentContainerCreateClone(d *schema.ResourceData, m in
 		updateBody.OSType = &operatingSystemType
 	}
 
+	tags := d.Get(mkResourceVirtualEnvironmentContainerTags).(*schema.Set).List()
+
+	if len(tags) > 0 {
+		tagsString := getTagString(tags)
+		updateBody.Tags = &tagsString
+	}
+
 	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool))
 
 	if template != dvResourceVirtualEnvironmentContainerTemplate {
@@ -875,7 +1317,7 @@ func resourceVirtualEnvironmentContainerCreateClone(d *schema.ResourceData, m in
 	return resourceVirtualEnvironmentContainerCreateStart(d, m)
 }
 
-func resourceVirtualEnvironmentContainerCreateCustom(d *schema.ResourceData, m interface{}) error {
+func resourceVirtualEnvironmentContainerCreateRestore(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
 
@@ -883,243 +1325,51 @@ func resourceVirtualEnvironmentContainerCreateCustom(d *schema.ResourceData, m i
 		return err
 	}
 
-	nodeName := d.Get(mkResourceVirtualEnvironmentContainerNodeName).(string)
-	resource := resourceVirtualEnvironmentContainer()
-
-	consoleBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerConsole}, 0, true)
-
-	if err != nil {
-		return err
-	}
-
-	consoleEnabled := proxmox.CustomBool(consoleBlock[mkResourceVirtualEnvironmentContainerConsoleEnabled].(bool))
-	consoleMode := consoleBlock[mkResourceVirtualEnvironmentContainerConsoleMode].(string)
-	consoleTTYCount := consoleBlock[mkResourceVirtualEnvironmentContainerConsoleTTYCount].(int)
-
-	cpuBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerCPU}, 0, true)
-
-	if err != nil {
-		return err
-	}
-
-	cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentContainerCPUArchitecture].(string)
-	cpuCores := cpuBlock[mkResourceVirtualEnvironmentContainerCPUCores].(int)
-	cpuUnits := cpuBlock[mkResourceVirtualEnvironmentContainerCPUUnits].(int)
+	restore := d.Get(mkResourceVirtualEnvironmentContainerRestore).([]interface{})
+	restoreBlock := restore[0].(map[string]interface{})
+	restoreBackupFile := restoreBlock[mkResourceVirtualEnvironmentContainerRestoreBackupFile].(string)
+	restoreDatastoreID := restoreBlock[mkResourceVirtualEnvironmentContainerRestoreDatastoreID].(string)
+	restoreUnique := proxmox.CustomBool(restoreBlock[mkResourceVirtualEnvironmentContainerRestoreUnique].(bool))
 
 	description := d.Get(mkResourceVirtualEnvironmentContainerDescription).(string)
-
-	diskBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerDisk}, 0, true)
-
-	if err != nil {
-		return err
-	}
-
-	diskDatastoreID := diskBlock[mkResourceVirtualEnvironmentContainerDiskDatastoreID].(string)
-
-	initialization := d.Get(mkResourceVirtualEnvironmentContainerInitialization).([]interface{})
-	initializationDNSDomain := dvResourceVirtualEnvironmentContainerInitializationDNSDomain
-	initializationDNSServer := dvResourceVirtualEnvironmentContainerInitializationDNSServer
-	initializationHostname := dvResourceVirtualEnvironmentContainerInitializationHostname
-	initializationIPConfigIPv4Address := []string{}
-	initializationIPConfigIPv4Gateway := []string{}
-	initializationIPConfigIPv6Address := []string{}
-	initializationIPConfigIPv6Gateway := []string{}
-	initializationUserAccountKeys := proxmox.VirtualEnvironmentContainerCustomSSHKeys{}
-	initializationUserAccountPassword := dvResourceVirtualEnvironmentContainerInitializationUserAccountPassword
-
-	if len(initialization) > 0 {
-		initializationBlock := initialization[0].(map[string]interface{})
-		initializationDNS := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationDNS].([]interface{})
-
-		if len(initializationDNS) > 0 {
-			initializationDNSBlock := initializationDNS[0].(map[string]interface{})
-			initializationDNSDomain = initializationDNSBlock[mkResourceVirtualEnvironmentContainerInitializationDNSDomain].(string)
-			initializationDNSServer = initializationDNSBlock[mkResourceVirtualEnvironmentContainerInitializationDNSServer].(string)
-		}
-
-		initializationHostname = initializationBlock[mkResourceVirtualEnvironmentContainerInitializationHostname].(string)
-		initializationIPConfig := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfig].([]interface{})
-
-		for _, c := range initializationIPConfig {
-			configBlock := c.(map[string]interface{})
-			ipv4 := configBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4].([]interface{})
-
-			if len(ipv4) > 0 {
-				ipv4Block := ipv4[0].(map[string]interface{})
-
-				initializationIPConfigIPv4Address = append(initializationIPConfigIPv4Address, ipv4Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4Address].(string))
-				initializationIPConfigIPv4Gateway = append(initializationIPConfigIPv4Gateway, ipv4Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv4Gateway].(string))
-			} else {
-				initializationIPConfigIPv4Address = append(initializationIPConfigIPv4Address, "")
-				initializationIPConfigIPv4Gateway = append(initializationIPConfigIPv4Gateway, "")
-			}
-
-			ipv6 := configBlock[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6].([]interface{})
-
-			if len(ipv6) > 0 {
-				ipv6Block := ipv6[0].(map[string]interface{})
-
-				initializationIPConfigIPv6Address = append(initializationIPConfigIPv6Address, ipv6Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6Address].(string))
-				initializationIPConfigIPv6Gateway = append(initializationIPConfigIPv6Gateway, ipv6Block[mkResourceVirtualEnvironmentContainerInitializationIPConfigIPv6Gateway].(string))
-			} else {
-				initializationIPConfigIPv6Address = append(initializationIPConfigIPv6Address, "")
-				initializationIPConfigIPv6Gateway = append(initializationIPConfigIPv6Gateway, "")
-			}
-		}
-
-		initializationUserAccount := initializationBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccount].([]interface{})
-
-		if len(initializationUserAccount) > 0 {
-			initializationUserAccountBlock := initializationUserAccount[0].(map[string]interface{})
-
-			keys := initializationUserAccountBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccountKeys].([]interface{})
-			initializationUserAccountKeys = make(proxmox.VirtualEnvironmentContainerCustomSSHKeys, len(keys))
-
-			for ki, kv := range keys {
-				initializationUserAccountKeys[ki] = kv.(string)
-			}
-
-			initializationUserAccountPassword = initializationUserAccountBlock[mkResourceVirtualEnvironmentContainerInitializationUserAccountPassword].(string)
-		}
-	}
-
-	memoryBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentContainerMemory}, 0, true)
+	nodeName := d.Get(mkResourceVirtualEnvironmentContainerNodeName).(string)
+	poolID := d.Get(mkResourceVirtualEnvironmentContainerPoolID).(string)
+	timeoutRestore, err := time.ParseDuration(d.Get(mkResourceVirtualEnvironmentContainerTimeoutRestore).(string))
 
 	if err != nil {
 		return err
 	}
 
-	memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentContainerMemoryDedicated].(int)
-	memorySwap := memoryBlock[mkResourceVirtualEnvironmentContainerMemorySwap].(int)
-
-	networkInterface := d.Get(mkResourceVirtualEnvironmentContainerNetworkInterface).([]interface{})
-	networkInterfaceArray := make(proxmox.VirtualEnvironmentContainerCustomNetworkInterfaceArray, len(networkInterface))
-
-	for ni, nv := range networkInterface {
-		networkInterfaceMap := nv.(map[string]interface{})
-		networkInterfaceObject := proxmox.VirtualEnvironmentContainerCustomNetworkInterface{}
-
-		bridge := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceBridge].(string)
-		enabled := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceEnabled].(bool)
-		macAddress := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceMACAddress].(string)
-		name := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceName].(string)
-		rateLimit := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceRateLimit].(float64)
-		vlanID := networkInterfaceMap[mkResourceVirtualEnvironmentContainerNetworkInterfaceVLANID].(int)
-
-		if bridge != "" {
-			networkInterfaceObject.Bridge = &bridge
-		}
-
-		networkInterfaceObject.Enabled = enabled
-
-		if len(initializationIPConfigIPv4Address) > ni {
-			if initializationIPConfigIPv4Address[ni] != "" {
-				networkInterfaceObject.IPv4Address = &initializationIPConfigIPv4Address[ni]
-			}
-
-			if initializationIPConfigIPv4Gateway[ni] != "" {
-				networkInterfaceObject.IPv4Gateway = &initializationIPConfigIPv4Gateway[ni]
-			}
-
-			if initializationIPConfigIPv6Address[ni] != "" {
-				networkInterfaceObject.IPv6Address = &initializationIPConfigIPv6Address[ni]
-			}
-
-			if initializationIPConfigIPv6Gateway[ni] != "" {
-				networkInterfaceObject.IPv6Gateway = &initializationIPConfigIPv6Gateway[ni]
-			}
-		}
-
-		if macAddress != "" {
-			networkInterfaceObject.MACAddress = &macAddress
-		}
-
-		networkInterfaceObject.Name = name
-
-		if rateLimit != 0 {
-			networkInterfaceObject.RateLimit = &rateLimit
-		}
-
-		if vlanID != 0 {
-			networkInterfaceObject.Tag = &vlanID
-		}
-
-		networkInterfaceArray[ni] = networkInterfaceObject
-	}
-
-	operatingSystem := d.Get(mkResourceVirtualEnvironmentContainerOperatingSystem).([]interface{})
-
-	if len(operatingSystem) == 0 {
-		return fmt.Errorf("\"%s\": required field is not set", mkResourceVirtualEnvironmentContainerOperatingSystem)
-	}
-
-	operatingSystemBlock := operatingSystem[0].(map[string]interface{})
-	operatingSystemTemplateFileID := operatingSystemBlock[mkResourceVirtualEnvironmentContainerOperatingSystemTemplateFileID].(string)
-	operatingSystemType := operatingSystemBlock[mkResourceVirtualEnvironmentContainerOperatingSystemType].(string)
-
-	poolID := d.Get(mkResourceVirtualEnvironmentContainerPoolID).(string)
-	started := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerStarted).(bool))
-	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool))
 	vmID := d.Get(mkResourceVirtualEnvironmentContainerVMID).(int)
 
-	if vmID == -1 {
-		vmIDNew, err := veClient.GetVMID()
-
-		if err != nil {
-			return err
-		}
-
-		vmID = *vmIDNew
-	}
-
-	// Attempt to create the resource using the retrieved values.
-	createBody := proxmox.VirtualEnvironmentContainerCreateRequestBody{
-		ConsoleEnabled:       &consoleEnabled,
-		ConsoleMode:          &consoleMode,
-		CPUArchitecture:      &cpuArchitecture,
-		CPUCores:             &cpuCores,
-		CPUUnits:             &cpuUnits,
-		DatastoreID:          &diskDatastoreID,
-		DedicatedMemory:      &memoryDedicated,
-		NetworkInterfaces:    networkInterfaceArray,
-		OSTemplateFileVolume: &operatingSystemTemplateFileID,
-		OSType:               &operatingSystemType,
-		StartOnBoot:          &started,
-		Swap:                 &memorySwap,
-		Template:             &template,
-		TTY:                  &consoleTTYCount,
-		VMID:                 &vmID,
-	}
-
-	if description != "" {
-		createBody.Description = &description
-	}
-
-	if initializationDNSDomain != "" {
-		createBody.DNSDomain = &initializationDNSDomain
-	}
+	restoreFlag := proxmox.CustomBool(true)
 
-	if initializationDNSServer != "" {
-		createBody.DNSServer = &initializationDNSServer
+	restoreBody := &proxmox.VirtualEnvironmentContainerCreateRequestBody{
+		OSTemplateFileVolume: &restoreBackupFile,
+		Restore:              &restoreFlag,
 	}
 
-	if initializationHostname != "" {
-		createBody.Hostname = &initializationHostname
+	if restoreDatastoreID != "" {
+		restoreBody.DatastoreID = &restoreDatastoreID
 	}
 
-	if len(initializationUserAccountKeys) > 0 {
-		createBody.SSHKeys = &initializationUserAccountKeys
+	if restoreUnique {
+		restoreBody.Unique = &restoreUnique
 	}
 
-	if initializationUserAccountPassword != "" {
-		createBody.Password = &initializationUserAccountPassword
+	if description != "" {
+		restoreBody.Description = &description
 	}
 
 	if poolID != "" {
-		createBody.PoolID = &poolID
+		restoreBody.PoolID = &poolID
 	}
 
-	err = veClient.CreateContainer(nodeName, &createBody)
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		restoreBody.VMID = &vmID
+
+		return veClient.RestoreContainer(nodeName, int(timeoutRestore.Seconds()), restoreBody)
+	})
 
 	if err != nil {
 		return err
@@ -1127,14 +1377,14 @@ func resourceVirtualEnvironmentContainerCreateCustom(d *schema.ResourceData, m i
 
 	d.SetId(strconv.Itoa(vmID))
 
-	// Wait for the container's lock to be released.
+	// Wait for the container to be restored and its configuration lock to be released.
 	err = veClient.WaitForContainerLock(nodeName, vmID, 600, 5, true)
 
 	if err != nil {
 		return err
 	}
 
-	return resourceVirtualEnvironmentContainerCreateStart(d, m)
+	return resourceVirtualEnvironmentContainerCreateReconfigure(d, m)
 }
 
 func resourceVirtualEnvironmentContainerCreateStart(d *schema.ResourceData, m interface{}) error {
@@ -1237,6 +1487,9 @@ func resourceVirtualEnvironmentContainerRead(d *schema.ResourceData, m interface
 
 	clone := d.Get(mkResourceVirtualEnvironmentVMClone).([]interface{})
 
+	// Restored containers inherit their configuration from the backup, just like clones do.
+	clone = append(clone, d.Get(mkResourceVirtualEnvironmentContainerRestore).([]interface{})...)
+
 	// Compare the primitive values to those stored in the state.
 	currentDescription := d.Get(mkResourceVirtualEnvironmentContainerDescription).(string)
 
@@ -1576,6 +1829,16 @@ func resourceVirtualEnvironmentContainerRead(d *schema.ResourceData, m interface
 		d.Set(mkResourceVirtualEnvironmentContainerOperatingSystem, []interface{}{operatingSystem})
 	}
 
+	currentTags := d.Get(mkResourceVirtualEnvironmentContainerTags).(*schema.Set)
+
+	if len(clone) == 0 || currentTags.Len() > 0 {
+		if containerConfig.Tags != nil {
+			d.Set(mkResourceVirtualEnvironmentContainerTags, getTagList(*containerConfig.Tags))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentContainerTags, []string{})
+		}
+	}
+
 	currentTemplate := d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool)
 
 	if len(clone) == 0 || currentTemplate != dvResourceVirtualEnvironmentContainerTemplate {
@@ -1628,6 +1891,17 @@ func resourceVirtualEnvironmentContainerUpdate(d *schema.ResourceData, m interfa
 		updateBody.Description = &description
 	}
 
+	if d.HasChange(mkResourceVirtualEnvironmentContainerTags) {
+		tags := d.Get(mkResourceVirtualEnvironmentContainerTags).(*schema.Set).List()
+
+		if len(tags) > 0 {
+			tagsString := getTagString(tags)
+			updateBody.Tags = &tagsString
+		} else {
+			updateBody.Delete = append(updateBody.Delete, "tags")
+		}
+	}
+
 	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentContainerTemplate).(bool))
 
 	if d.HasChange(mkResourceVirtualEnvironmentContainerTemplate) {
@@ -1912,6 +2186,13 @@ func resourceVirtualEnvironmentContainerDelete(d *schema.ResourceData, m interfa
 		return err
 	}
 
+	// Remove the container from the HA configuration before shutting it down, as the HA manager would otherwise start it again and prevent its deletion.
+	err = resourceVirtualEnvironmentHAResourceRemoveGuest(veClient, fmt.Sprintf("ct:%d", vmID))
+
+	if err != nil {
+		return err
+	}
+
 	// Shut down the container before deleting it.
 	status, err := veClient.GetContainerStatus(nodeName, vmID)
 
@@ -23,20 +23,21 @@ func TestResourceVirtualEnvironmentContainerInstantiation(t *testing.T) {
 func TestResourceVirtualEnvironmentContainerSchema(t *testing.T) {
 	s := resourceVirtualEnvironmentContainer()
 
-	testRequiredArguments(t, s, []string{
-		mkResourceVirtualEnvironmentContainerNodeName,
-	})
-
 	testOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentContainerCPU,
 		mkResourceVirtualEnvironmentContainerDescription,
 		mkResourceVirtualEnvironmentContainerDisk,
 		mkResourceVirtualEnvironmentContainerInitialization,
 		mkResourceVirtualEnvironmentContainerMemory,
+		mkResourceVirtualEnvironmentContainerNodeName,
 		mkResourceVirtualEnvironmentContainerOperatingSystem,
+		mkResourceVirtualEnvironmentContainerPlacement,
 		mkResourceVirtualEnvironmentContainerPoolID,
+		mkResourceVirtualEnvironmentContainerRestore,
 		mkResourceVirtualEnvironmentContainerStarted,
+		mkResourceVirtualEnvironmentContainerTags,
 		mkResourceVirtualEnvironmentContainerTemplate,
+		mkResourceVirtualEnvironmentContainerTimeoutRestore,
 		mkResourceVirtualEnvironmentContainerVMID,
 	})
 
@@ -47,9 +48,13 @@ func TestResourceVirtualEnvironmentContainerSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentContainerInitialization:  schema.TypeList,
 		mkResourceVirtualEnvironmentContainerMemory:          schema.TypeList,
 		mkResourceVirtualEnvironmentContainerOperatingSystem: schema.TypeList,
+		mkResourceVirtualEnvironmentContainerPlacement:       schema.TypeList,
 		mkResourceVirtualEnvironmentContainerPoolID:          schema.TypeString,
+		mkResourceVirtualEnvironmentContainerRestore:         schema.TypeList,
 		mkResourceVirtualEnvironmentContainerStarted:         schema.TypeBool,
+		mkResourceVirtualEnvironmentContainerTags:            schema.TypeSet,
 		mkResourceVirtualEnvironmentContainerTemplate:        schema.TypeBool,
+		mkResourceVirtualEnvironmentContainerTimeoutRestore:  schema.TypeString,
 		mkResourceVirtualEnvironmentContainerVMID:            schema.TypeInt,
 	})
 
@@ -219,4 +224,35 @@ func TestResourceVirtualEnvironmentContainerSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentContainerOperatingSystemTemplateFileID: schema.TypeString,
 		mkResourceVirtualEnvironmentContainerOperatingSystemType:           schema.TypeString,
 	})
+
+	placementSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentContainerPlacement)
+
+	testOptionalArguments(t, placementSchema, []string{
+		mkResourceVirtualEnvironmentContainerPlacementDatastoreID,
+		mkResourceVirtualEnvironmentContainerPlacementNodes,
+		mkResourceVirtualEnvironmentContainerPlacementStrategy,
+	})
+
+	testValueTypes(t, placementSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentContainerPlacementDatastoreID: schema.TypeString,
+		mkResourceVirtualEnvironmentContainerPlacementNodes:       schema.TypeList,
+		mkResourceVirtualEnvironmentContainerPlacementStrategy:    schema.TypeString,
+	})
+
+	restoreSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentContainerRestore)
+
+	testRequiredArguments(t, restoreSchema, []string{
+		mkResourceVirtualEnvironmentContainerRestoreBackupFile,
+	})
+
+	testOptionalArguments(t, restoreSchema, []string{
+		mkResourceVirtualEnvironmentContainerRestoreDatastoreID,
+		mkResourceVirtualEnvironmentContainerRestoreUnique,
+	})
+
+	testValueTypes(t, restoreSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentContainerRestoreBackupFile:  schema.TypeString,
+		mkResourceVirtualEnvironmentContainerRestoreDatastoreID: schema.TypeString,
+		mkResourceVirtualEnvironmentContainerRestoreUnique:      schema.TypeBool,
+	})
 }
@@ -6,16 +6,20 @@ package proxmoxtf
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
 	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/tls"
 	"fmt"
+	"hash"
 	"io"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,35 +28,47 @@ import (
 )
 
 const (
-	dvResourceVirtualEnvironmentFileContentType        = ""
-	dvResourceVirtualEnvironmentFileSourceData         = ""
-	dvResourceVirtualEnvironmentFileSourceFileChanged  = false
-	dvResourceVirtualEnvironmentFileSourceFileChecksum = ""
-	dvResourceVirtualEnvironmentFileSourceFileFileName = ""
-	dvResourceVirtualEnvironmentFileSourceFileInsecure = false
-	dvResourceVirtualEnvironmentFileSourceRawResize    = 0
-
-	mkResourceVirtualEnvironmentFileContentType          = "content_type"
-	mkResourceVirtualEnvironmentFileDatastoreID          = "datastore_id"
-	mkResourceVirtualEnvironmentFileFileModificationDate = "file_modification_date"
-	mkResourceVirtualEnvironmentFileFileName             = "file_name"
-	mkResourceVirtualEnvironmentFileFileSize             = "file_size"
-	mkResourceVirtualEnvironmentFileFileTag              = "file_tag"
-	mkResourceVirtualEnvironmentFileNodeName             = "node_name"
-	mkResourceVirtualEnvironmentFileSourceFile           = "source_file"
-	mkResourceVirtualEnvironmentFileSourceFilePath       = "path"
-	mkResourceVirtualEnvironmentFileSourceFileChanged    = "changed"
-	mkResourceVirtualEnvironmentFileSourceFileChecksum   = "checksum"
-	mkResourceVirtualEnvironmentFileSourceFileFileName   = "file_name"
-	mkResourceVirtualEnvironmentFileSourceFileInsecure   = "insecure"
-	mkResourceVirtualEnvironmentFileSourceRaw            = "source_raw"
-	mkResourceVirtualEnvironmentFileSourceRawData        = "data"
-	mkResourceVirtualEnvironmentFileSourceRawFileName    = "file_name"
-	mkResourceVirtualEnvironmentFileSourceRawResize      = "resize"
+	dvResourceVirtualEnvironmentFileContentType                 = ""
+	dvResourceVirtualEnvironmentFileSourceData                  = ""
+	dvResourceVirtualEnvironmentFileSourceFileChanged           = false
+	dvResourceVirtualEnvironmentFileSourceFileChecksum          = ""
+	dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm = "sha256"
+	dvResourceVirtualEnvironmentFileSourceFileDecompression     = ""
+	dvResourceVirtualEnvironmentFileSourceFileFileName          = ""
+	dvResourceVirtualEnvironmentFileSourceFileInsecure          = false
+	dvResourceVirtualEnvironmentFileSourceFileNodeDownload      = true
+	dvResourceVirtualEnvironmentFileSourceRawResize             = 0
+	dvResourceVirtualEnvironmentFileTimeoutDownload             = "24h"
+	dvResourceVirtualEnvironmentFileVerifyDatastoreChecksum     = false
+
+	mkResourceVirtualEnvironmentFileContentType                 = "content_type"
+	mkResourceVirtualEnvironmentFileDatastoreID                 = "datastore_id"
+	mkResourceVirtualEnvironmentFileFileChecksum                = "file_checksum"
+	mkResourceVirtualEnvironmentFileFileChecksumFingerprint     = "file_checksum_fingerprint"
+	mkResourceVirtualEnvironmentFileFileModificationDate        = "file_modification_date"
+	mkResourceVirtualEnvironmentFileFileName                    = "file_name"
+	mkResourceVirtualEnvironmentFileFileSize                    = "file_size"
+	mkResourceVirtualEnvironmentFileFileTag                     = "file_tag"
+	mkResourceVirtualEnvironmentFileNodeName                    = "node_name"
+	mkResourceVirtualEnvironmentFileSourceFile                  = "source_file"
+	mkResourceVirtualEnvironmentFileSourceFilePath              = "path"
+	mkResourceVirtualEnvironmentFileSourceFileChanged           = "changed"
+	mkResourceVirtualEnvironmentFileSourceFileChecksum          = "checksum"
+	mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm = "checksum_algorithm"
+	mkResourceVirtualEnvironmentFileSourceFileDecompression     = "decompression_algorithm"
+	mkResourceVirtualEnvironmentFileSourceFileFileName          = "file_name"
+	mkResourceVirtualEnvironmentFileSourceFileInsecure          = "insecure"
+	mkResourceVirtualEnvironmentFileSourceFileNodeDownload      = "node_download"
+	mkResourceVirtualEnvironmentFileSourceRaw                   = "source_raw"
+	mkResourceVirtualEnvironmentFileSourceRawData               = "data"
+	mkResourceVirtualEnvironmentFileSourceRawFileName           = "file_name"
+	mkResourceVirtualEnvironmentFileSourceRawResize             = "resize"
+	mkResourceVirtualEnvironmentFileTimeoutDownload             = "timeout_download"
+	mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum     = "verify_datastore_checksum"
 )
 
 func resourceVirtualEnvironmentFile() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			mkResourceVirtualEnvironmentFileContentType: {
 				Type:         schema.TypeString,
@@ -68,6 +84,16 @@ func resourceVirtualEnvironmentFile() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			mkResourceVirtualEnvironmentFileFileChecksum: {
+				Type:        schema.TypeString,
+				Description: "The checksum of the file in the datastore",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFileFileChecksumFingerprint: {
+				Type:        schema.TypeString,
+				Description: "The fingerprint of the file in the datastore at the time its checksum was calculated",
+				Computed:    true,
+			},
 			mkResourceVirtualEnvironmentFileFileModificationDate: {
 				Type:        schema.TypeString,
 				Description: "The file modification date",
@@ -122,11 +148,27 @@ func resourceVirtualEnvironmentFile() *schema.Resource {
 						},
 						mkResourceVirtualEnvironmentFileSourceFileChecksum: {
 							Type:        schema.TypeString,
-							Description: "The SHA256 checksum of the source file",
+							Description: "The checksum of the source file",
 							Optional:    true,
 							ForceNew:    true,
 							Default:     dvResourceVirtualEnvironmentFileSourceFileChecksum,
 						},
+						mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: {
+							Type:         schema.TypeString,
+							Description:  "The algorithm used to calculate the checksum of the source file",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm,
+							ValidateFunc: getChecksumAlgorithmValidator(),
+						},
+						mkResourceVirtualEnvironmentFileSourceFileDecompression: {
+							Type:         schema.TypeString,
+							Description:  "The algorithm used to decompress the source file when the node downloads it",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dvResourceVirtualEnvironmentFileSourceFileDecompression,
+							ValidateFunc: getDecompressionAlgorithmValidator(),
+						},
 						mkResourceVirtualEnvironmentFileSourceFileFileName: {
 							Type:        schema.TypeString,
 							Description: "The file name to use instead of the source file name",
@@ -141,6 +183,13 @@ func resourceVirtualEnvironmentFile() *schema.Resource {
 							ForceNew:    true,
 							Default:     dvResourceVirtualEnvironmentFileSourceFileInsecure,
 						},
+						mkResourceVirtualEnvironmentFileSourceFileNodeDownload: {
+							Type:        schema.TypeBool,
+							Description: "Whether to let the node download URL sources instead of uploading them",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentFileSourceFileNodeDownload,
+						},
 					},
 				},
 				MaxItems: 1,
@@ -180,11 +229,37 @@ func resourceVirtualEnvironmentFile() *schema.Resource {
 				MaxItems: 1,
 				MinItems: 0,
 			},
+			mkResourceVirtualEnvironmentFileTimeoutDownload: {
+				Type:         schema.TypeString,
+				Description:  "The maximum amount of time to wait for a node to download a file",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentFileTimeoutDownload,
+				ValidateFunc: getTimeoutValidator(),
+			},
+			mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum: {
+				Type:        schema.TypeBool,
+				Description: "Whether to verify the checksum of the file in the datastore using SSH",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentFileVerifyDatastoreChecksum,
+			},
+		},
+		Create:        resourceVirtualEnvironmentFileCreate,
+		Read:          resourceVirtualEnvironmentFileRead,
+		Update:        resourceVirtualEnvironmentFileUpdate,
+		Delete:        resourceVirtualEnvironmentFileDelete,
+		SchemaVersion: 1,
+	}
+
+	// The current schema is a superset of version 0, which makes it suitable for decoding legacy state.
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceVirtualEnvironmentFileStateUpgradeV0,
 		},
-		Create: resourceVirtualEnvironmentFileCreate,
-		Read:   resourceVirtualEnvironmentFileRead,
-		Delete: resourceVirtualEnvironmentFileDelete,
 	}
+
+	return r
 }
 
 func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{}) error {
@@ -211,8 +286,13 @@ func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{})
 	nodeName := d.Get(mkResourceVirtualEnvironmentFileNodeName).(string)
 	sourceFile := d.Get(mkResourceVirtualEnvironmentFileSourceFile).([]interface{})
 	sourceRaw := d.Get(mkResourceVirtualEnvironmentFileSourceRaw).([]interface{})
+	verifyDatastoreChecksum := d.Get(mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum).(bool)
+
+	var fileReader io.Reader
+	var fileSize *int64
 
-	sourceFilePathLocal := ""
+	checksumAlgorithm := dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm
+	expectedChecksum := ""
 
 	// Determine if both source_data and source_file is specified as this is not supported.
 	if len(sourceFile) > 0 && len(sourceRaw) > 0 {
@@ -225,83 +305,154 @@ func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{})
 	}
 
 	// Determine if we're dealing with raw file data or a reference to a file or URL.
-	// In case of a URL, we must first download the file before proceeding.
-	// This is due to lack of support for chunked transfers in the Proxmox VE API.
+	// In case of a URL, the node downloads the file itself, if supported. Otherwise, we stream the file to the node.
 	if len(sourceFile) > 0 {
 		sourceFileBlock := sourceFile[0].(map[string]interface{})
 		sourceFilePath := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFilePath].(string)
 		sourceFileChecksum := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksum].(string)
+		sourceFileChecksumAlgorithm := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm].(string)
+		sourceFileDecompression := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileDecompression].(string)
 		sourceFileInsecure := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileInsecure].(bool)
+		sourceFileNodeDownload := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileNodeDownload].(bool)
 
-		if resourceVirtualEnvironmentFileIsURL(d, m) {
-			log.Printf("[DEBUG] Downloading file from '%s'", sourceFilePath)
+		checksumAlgorithm = sourceFileChecksumAlgorithm
+		expectedChecksum = sourceFileChecksum
 
-			httpClient := http.Client{
-				Transport: &http.Transport{
-					TLSClientConfig: &tls.Config{
-						InsecureSkipVerify: sourceFileInsecure,
-					},
-				},
-			}
+		fileIsURL := resourceVirtualEnvironmentFileIsURL(d, m)
+		nodeDownload := false
 
-			res, err := httpClient.Get(sourceFilePath)
+		if fileIsURL && sourceFileNodeDownload {
+			nodeDownload, err = resourceVirtualEnvironmentFileIsNodeDownloadSupported(veClient, *contentType)
 
 			if err != nil {
 				return err
 			}
+		}
 
-			defer res.Body.Close()
+		if sourceFileDecompression != "" && !nodeDownload {
+			return fmt.Errorf(
+				"The \"%s.%s\" argument requires the node to download an ISO image (Proxmox VE 7.0 or newer)",
+				mkResourceVirtualEnvironmentFileSourceFile,
+				mkResourceVirtualEnvironmentFileSourceFileDecompression,
+			)
+		}
+
+		// Let the node download the file directly, if possible, as this avoids transferring it twice.
+		if nodeDownload {
+			log.Printf("[DEBUG] Instructing node \"%s\" to download file from '%s'", nodeName, sourceFilePath)
+
+			verifyCertificates := proxmox.CustomBool(!sourceFileInsecure)
+			body := &proxmox.VirtualEnvironmentDatastoreDownloadURLRequestBody{
+				ContentType:        *contentType,
+				FileName:           *fileName,
+				URL:                sourceFilePath,
+				VerifyCertificates: &verifyCertificates,
+			}
 
-			tempDownloadedFile, err := ioutil.TempFile("", "download")
+			if sourceFileChecksum != "" {
+				body.Checksum = &sourceFileChecksum
+				body.ChecksumAlgorithm = &sourceFileChecksumAlgorithm
+			}
+
+			if sourceFileDecompression != "" {
+				body.Compression = &sourceFileDecompression
+			}
+
+			timeoutDownload, err := time.ParseDuration(d.Get(mkResourceVirtualEnvironmentFileTimeoutDownload).(string))
 
 			if err != nil {
 				return err
 			}
 
-			tempDownloadedFileName := tempDownloadedFile.Name()
-			_, err = io.Copy(tempDownloadedFile, res.Body)
+			err = veClient.DownloadFileToDatastore(nodeName, datastoreID, int(timeoutDownload.Seconds()), body)
 
 			if err != nil {
-				tempDownloadedFile.Close()
+				return err
+			}
 
+			volumeID, err := resourceVirtualEnvironmentFileGetVolumeID(d, m)
+
+			if err != nil {
 				return err
 			}
 
-			tempDownloadedFile.Close()
+			// The node has already verified the downloaded file, but the checksum no longer applies after decompression.
+			fileChecksum := ""
 
-			defer os.Remove(tempDownloadedFileName)
+			if sourceFileDecompression == "" {
+				fileChecksum = strings.ToLower(expectedChecksum)
+			}
 
-			sourceFilePathLocal = tempDownloadedFileName
-		} else {
-			sourceFilePathLocal = sourceFilePath
+			if verifyDatastoreChecksum {
+				datastoreChecksum, err := resourceVirtualEnvironmentFileVerifyChecksum(
+					veClient,
+					nodeName,
+					datastoreID,
+					*volumeID,
+					checksumAlgorithm,
+					fileChecksum,
+				)
+
+				if err != nil {
+					return err
+				}
+
+				fileChecksum = *datastoreChecksum
+			}
+
+			d.SetId(*volumeID)
+			d.Set(mkResourceVirtualEnvironmentFileFileChecksum, fileChecksum)
+
+			return resourceVirtualEnvironmentFileRead(d, m)
 		}
 
-		// Calculate the checksum of the source file now that it's available locally.
-		if sourceFileChecksum != "" {
-			file, err := os.Open(sourceFilePathLocal)
+		if fileIsURL {
+			log.Printf("[DEBUG] Downloading file from '%s'", sourceFilePath)
+
+			httpClient := http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{
+						InsecureSkipVerify: sourceFileInsecure,
+					},
+				},
+			}
+
+			res, err := httpClient.Get(sourceFilePath)
 
 			if err != nil {
 				return err
 			}
 
-			h := sha256.New()
-			_, err = io.Copy(h, file)
+			defer res.Body.Close()
 
-			if err != nil {
-				file.Close()
+			if res.StatusCode < 200 || res.StatusCode >= 300 {
+				return fmt.Errorf("Failed to download file from '%s' - Reason: %s", sourceFilePath, res.Status)
+			}
 
-				return err
+			// The response body is streamed directly to the node, unless the server omits the content length.
+			fileReader = res.Body
+
+			if res.ContentLength >= 0 {
+				fileSize = &res.ContentLength
 			}
+		} else {
+			file, err := os.Open(sourceFilePath)
 
-			file.Close()
+			if err != nil {
+				return err
+			}
 
-			calculatedChecksum := fmt.Sprintf("%x", h.Sum(nil))
+			defer file.Close()
 
-			log.Printf("[DEBUG] The calculated SHA256 checksum for source \"%s\" is \"%s\"", sourceFilePath, calculatedChecksum)
+			fileInfo, err := file.Stat()
 
-			if sourceFileChecksum != calculatedChecksum {
-				return fmt.Errorf("The calculated SHA256 checksum \"%s\" does not match source checksum \"%s\"", calculatedChecksum, sourceFileChecksum)
+			if err != nil {
+				return err
 			}
+
+			fileReader = file
+			fileSizeLocal := fileInfo.Size()
+			fileSize = &fileSizeLocal
 		}
 	} else if len(sourceRaw) > 0 {
 		sourceRawBlock := sourceRaw[0].(map[string]interface{})
@@ -316,26 +467,9 @@ func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{})
 			}
 		}
 
-		tempRawFile, err := ioutil.TempFile("", "raw")
-
-		if err != nil {
-			return err
-		}
-
-		tempRawFileName := tempRawFile.Name()
-		_, err = io.Copy(tempRawFile, bytes.NewBufferString(sourceRawData))
-
-		if err != nil {
-			tempRawFile.Close()
-
-			return err
-		}
-
-		tempRawFile.Close()
-
-		defer os.Remove(tempRawFileName)
-
-		sourceFilePathLocal = tempRawFileName
+		fileReader = bytes.NewBufferString(sourceRawData)
+		fileSizeRaw := int64(len(sourceRawData))
+		fileSize = &fileSizeRaw
 	} else {
 		return fmt.Errorf(
 			"Please specify either \"%s.%s\" or \"%s\"",
@@ -345,20 +479,15 @@ func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{})
 		)
 	}
 
-	// Open the source file for reading in order to upload it.
-	file, err := os.Open(sourceFilePathLocal)
-
-	if err != nil {
-		return err
-	}
-
-	defer file.Close()
+	// Calculate the checksum of the source file while it's being uploaded in order to avoid reading it twice.
+	h := resourceVirtualEnvironmentFileGetChecksumHash(checksumAlgorithm)
 
 	body := &proxmox.VirtualEnvironmentDatastoreUploadRequestBody{
 		ContentType: *contentType,
 		DatastoreID: datastoreID,
 		FileName:    *fileName,
-		FileReader:  file,
+		FileReader:  io.TeeReader(fileReader, h),
+		FileSize:    fileSize,
 		NodeName:    nodeName,
 	}
 
@@ -374,11 +503,64 @@ func resourceVirtualEnvironmentFileCreate(d *schema.ResourceData, m interface{})
 		return err
 	}
 
+	calculatedChecksum := fmt.Sprintf("%x", h.Sum(nil))
+
+	log.Printf("[DEBUG] The calculated %s checksum for volume \"%s\" is \"%s\"", strings.ToUpper(checksumAlgorithm), *volumeID, calculatedChecksum)
+
+	if expectedChecksum != "" && !strings.EqualFold(expectedChecksum, calculatedChecksum) {
+		deleteErr := veClient.DeleteDatastoreFile(nodeName, datastoreID, *volumeID)
+
+		if deleteErr != nil {
+			log.Printf("[DEBUG] WARNING: Failed to delete volume \"%s\" - Reason: %s", *volumeID, deleteErr.Error())
+		}
+
+		return fmt.Errorf(
+			"The calculated %s checksum \"%s\" does not match source checksum \"%s\"",
+			strings.ToUpper(checksumAlgorithm),
+			calculatedChecksum,
+			expectedChecksum,
+		)
+	}
+
+	// Verify that the file in the datastore matches the checksum calculated during the upload, if requested.
+	if verifyDatastoreChecksum {
+		_, err = resourceVirtualEnvironmentFileVerifyChecksum(
+			veClient,
+			nodeName,
+			datastoreID,
+			*volumeID,
+			checksumAlgorithm,
+			calculatedChecksum,
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	d.SetId(*volumeID)
+	d.Set(mkResourceVirtualEnvironmentFileFileChecksum, calculatedChecksum)
 
 	return resourceVirtualEnvironmentFileRead(d, m)
 }
 
+func resourceVirtualEnvironmentFileGetChecksumHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case "md5":
+		return md5.New()
+	case "sha1":
+		return sha1.New()
+	case "sha224":
+		return sha256.New224()
+	case "sha384":
+		return sha512.New384()
+	case "sha512":
+		return sha512.New()
+	default:
+		return sha256.New()
+	}
+}
+
 func resourceVirtualEnvironmentFileGetContentType(d *schema.ResourceData, m interface{}) (*string, error) {
 	contentType := d.Get(mkResourceVirtualEnvironmentFileContentType).(string)
 	sourceFile := d.Get(mkResourceVirtualEnvironmentFileSourceFile).([]interface{})
@@ -474,11 +656,31 @@ func resourceVirtualEnvironmentFileGetFileName(d *schema.ResourceData, m interfa
 		} else {
 			sourceFileFileName = filepath.Base(sourceFilePath)
 		}
+
+		// The node stores the decompressed file, which is why we need to strip the compression extension.
+		if len(sourceFile) > 0 {
+			sourceFileBlock := sourceFile[0].(map[string]interface{})
+			sourceFileDecompression := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileDecompression].(string)
+
+			if sourceFileDecompression != "" {
+				sourceFileFileName = strings.TrimSuffix(sourceFileFileName, fmt.Sprintf(".%s", sourceFileDecompression))
+			}
+		}
 	}
 
 	return &sourceFileFileName, nil
 }
 
+func resourceVirtualEnvironmentFileGetFingerprint(file *proxmox.VirtualEnvironmentDatastoreFileListResponseData) string {
+	var creationTime int64
+
+	if file.CreationTime != nil {
+		creationTime = time.Time(*file.CreationTime).UTC().Unix()
+	}
+
+	return fmt.Sprintf("%x-%x", creationTime, file.FileSize)
+}
+
 func resourceVirtualEnvironmentFileGetVolumeID(d *schema.ResourceData, m interface{}) (*string, error) {
 	fileName, err := resourceVirtualEnvironmentFileGetFileName(d, m)
 
@@ -512,6 +714,35 @@ func resourceVirtualEnvironmentFileIsURL(d *schema.ResourceData, m interface{})
 	return strings.HasPrefix(sourceFilePath, "http://") || strings.HasPrefix(sourceFilePath, "https://")
 }
 
+func resourceVirtualEnvironmentFileIsNodeDownloadSupported(veClient *proxmox.VirtualEnvironmentClient, contentType string) (bool, error) {
+	// The node is only able to download ISO images and container templates.
+	if contentType != "iso" && contentType != "vztmpl" {
+		return false, nil
+	}
+
+	version, err := veClient.Version()
+
+	if err != nil {
+		return false, err
+	}
+
+	// The download-url endpoint was introduced in Proxmox VE 7.0.
+	releaseParts := strings.Split(version.Release, ".")
+	majorVersion, err := strconv.Atoi(releaseParts[0])
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to parse the Proxmox VE release \"%s\"", version.Release)
+	}
+
+	if majorVersion < 7 {
+		log.Printf("[DEBUG] Proxmox VE %s does not support URL downloads - Falling back to uploads", version.Release)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func resourceVirtualEnvironmentFileRead(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
@@ -523,112 +754,243 @@ func resourceVirtualEnvironmentFileRead(d *schema.ResourceData, m interface{}) e
 	datastoreID := d.Get(mkResourceVirtualEnvironmentFileDatastoreID).(string)
 	nodeName := d.Get(mkResourceVirtualEnvironmentFileNodeName).(string)
 	sourceFile := d.Get(mkResourceVirtualEnvironmentFileSourceFile).([]interface{})
-	sourceFilePath := ""
 
-	if len(sourceFile) == 0 {
-		return nil
+	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID, nil)
+
+	if err != nil {
+		return err
 	}
 
-	sourceFileBlock := sourceFile[0].(map[string]interface{})
-	sourceFilePath = sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFilePath].(string)
+	var file *proxmox.VirtualEnvironmentDatastoreFileListResponseData
 
-	list, err := veClient.ListDatastoreFiles(nodeName, datastoreID)
+	for _, v := range list {
+		if v.VolumeID == d.Id() {
+			file = v
+			break
+		}
+	}
 
-	if err != nil {
-		return err
+	// Plan a new upload in case the file has been removed from the datastore.
+	if file == nil {
+		d.SetId("")
+
+		return nil
 	}
 
-	fileIsURL := resourceVirtualEnvironmentFileIsURL(d, m)
 	fileName, err := resourceVirtualEnvironmentFileGetFileName(d, m)
 
 	if err != nil {
 		return err
 	}
 
-	for _, v := range list {
-		if v.VolumeID == d.Id() {
-			var fileModificationDate string
-			var fileSize int64
-			var fileTag string
+	d.Set(mkResourceVirtualEnvironmentFileFileName, *fileName)
 
-			if fileIsURL {
-				res, err := http.Head(sourceFilePath)
+	// Determine whether the file in the datastore has been replaced since the last run.
+	checksumAlgorithm := dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm
 
-				if err != nil {
-					return err
-				}
+	if len(sourceFile) > 0 {
+		sourceFileBlock := sourceFile[0].(map[string]interface{})
+		checksumAlgorithm = sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm].(string)
+	}
 
-				defer res.Body.Close()
+	// The checksum is only calculated again, if verification is enabled and the size or creation time of the file in
+	// the datastore has changed.
+	fileFingerprint := resourceVirtualEnvironmentFileGetFingerprint(file)
+	lastFileChecksum := d.Get(mkResourceVirtualEnvironmentFileFileChecksum).(string)
+	lastFileFingerprint := d.Get(mkResourceVirtualEnvironmentFileFileChecksumFingerprint).(string)
+	verifyDatastoreChecksum := d.Get(mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum).(bool)
+	fileReplaced := false
 
-				fileSize = res.ContentLength
-				httpLastModified := res.Header.Get("Last-Modified")
+	if d.IsNewResource() || !verifyDatastoreChecksum {
+		d.Set(mkResourceVirtualEnvironmentFileFileChecksumFingerprint, fileFingerprint)
+	} else if lastFileChecksum != "" && lastFileFingerprint != fileFingerprint {
+		fileChecksum, err := veClient.GetDatastoreFileChecksum(nodeName, datastoreID, d.Id(), checksumAlgorithm)
 
-				if httpLastModified != "" {
-					timeParsed, err := time.Parse(time.RFC1123, httpLastModified)
+		if err != nil {
+			// The stored checksum is retained, while the empty fingerprint marks it as unverified until the next refresh.
+			log.Printf("[DEBUG] WARNING: Unable to verify the checksum of volume \"%s\" - Reason: %s", d.Id(), err.Error())
 
-					if err != nil {
-						timeParsed, err = time.Parse(time.RFC1123Z, httpLastModified)
+			d.Set(mkResourceVirtualEnvironmentFileFileChecksumFingerprint, "")
+		} else if !strings.EqualFold(lastFileChecksum, *fileChecksum) {
+			log.Printf("[DEBUG] The checksum of volume \"%s\" has changed from \"%s\" to \"%s\"", d.Id(), lastFileChecksum, *fileChecksum)
 
-						if err != nil {
-							return err
-						}
-					}
+			fileReplaced = true
+		} else {
+			d.Set(mkResourceVirtualEnvironmentFileFileChecksumFingerprint, fileFingerprint)
+		}
+	}
 
-					fileModificationDate = timeParsed.UTC().Format(time.RFC3339)
-				} else {
-					d.Set(mkResourceVirtualEnvironmentFileFileModificationDate, "")
-				}
+	if len(sourceFile) == 0 {
+		if fileReplaced {
+			d.SetId("")
+		}
 
-				httpTag := res.Header.Get("ETag")
+		return nil
+	}
 
-				if httpTag != "" {
-					httpTagParts := strings.Split(httpTag, "\"")
+	sourceFileBlock := sourceFile[0].(map[string]interface{})
+	sourceFilePath := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFilePath].(string)
 
-					if len(httpTagParts) > 1 {
-						fileTag = httpTagParts[1]
-					} else {
-						fileTag = ""
-					}
-				} else {
-					fileTag = ""
-				}
-			} else {
-				f, err := os.Open(sourceFilePath)
+	var fileModificationDate string
+	var fileSize int64
+	var fileTag string
 
-				if err != nil {
-					return err
-				}
+	if resourceVirtualEnvironmentFileIsURL(d, m) {
+		res, err := http.Head(sourceFilePath)
+
+		if err != nil {
+			return err
+		}
+
+		defer res.Body.Close()
 
-				defer f.Close()
+		fileSize = res.ContentLength
+		httpLastModified := res.Header.Get("Last-Modified")
 
-				fileInfo, err := f.Stat()
+		if httpLastModified != "" {
+			timeParsed, err := time.Parse(time.RFC1123, httpLastModified)
+
+			if err != nil {
+				timeParsed, err = time.Parse(time.RFC1123Z, httpLastModified)
 
 				if err != nil {
 					return err
 				}
+			}
+
+			fileModificationDate = timeParsed.UTC().Format(time.RFC3339)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentFileFileModificationDate, "")
+		}
+
+		httpTag := res.Header.Get("ETag")
+
+		if httpTag != "" {
+			httpTagParts := strings.Split(httpTag, "\"")
 
-				fileModificationDate = fileInfo.ModTime().UTC().Format(time.RFC3339)
-				fileSize = fileInfo.Size()
-				fileTag = fmt.Sprintf("%x-%x", fileInfo.ModTime().UTC().Unix(), fileInfo.Size())
+			if len(httpTagParts) > 1 {
+				fileTag = httpTagParts[1]
+			} else {
+				fileTag = ""
 			}
+		} else {
+			fileTag = ""
+		}
+	} else {
+		f, err := os.Open(sourceFilePath)
 
-			lastFileModificationDate := d.Get(mkResourceVirtualEnvironmentFileFileModificationDate).(string)
-			lastFileSize := int64(d.Get(mkResourceVirtualEnvironmentFileFileSize).(int))
-			lastFileTag := d.Get(mkResourceVirtualEnvironmentFileFileTag).(string)
+		if err != nil {
+			return err
+		}
 
-			d.Set(mkResourceVirtualEnvironmentFileFileModificationDate, fileModificationDate)
-			d.Set(mkResourceVirtualEnvironmentFileFileName, *fileName)
-			d.Set(mkResourceVirtualEnvironmentFileFileSize, fileSize)
-			d.Set(mkResourceVirtualEnvironmentFileFileTag, fileTag)
-			d.Set(mkResourceVirtualEnvironmentFileSourceFileChanged, lastFileModificationDate != fileModificationDate || lastFileSize != fileSize || lastFileTag != fileTag)
+		defer f.Close()
 
-			return nil
+		fileInfo, err := f.Stat()
+
+		if err != nil {
+			return err
 		}
+
+		fileModificationDate = fileInfo.ModTime().UTC().Format(time.RFC3339)
+		fileSize = fileInfo.Size()
+		fileTag = fmt.Sprintf("%x-%x", fileInfo.ModTime().UTC().Unix(), fileInfo.Size())
 	}
 
-	d.SetId("")
+	lastFileModificationDate := d.Get(mkResourceVirtualEnvironmentFileFileModificationDate).(string)
+	lastFileSize := int64(d.Get(mkResourceVirtualEnvironmentFileFileSize).(int))
+	lastFileTag := d.Get(mkResourceVirtualEnvironmentFileFileTag).(string)
 
-	return nil
+	d.Set(mkResourceVirtualEnvironmentFileFileModificationDate, fileModificationDate)
+	d.Set(mkResourceVirtualEnvironmentFileFileSize, fileSize)
+	d.Set(mkResourceVirtualEnvironmentFileFileTag, fileTag)
+
+	// Flag the source file as changed in order to force a new upload. The flag remains set until the next upload.
+	sourceFileChanged := sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChanged].(bool) || fileReplaced
+
+	if !d.IsNewResource() {
+		sourceFileChanged = sourceFileChanged ||
+			lastFileModificationDate != fileModificationDate ||
+			lastFileSize != fileSize ||
+			lastFileTag != fileTag
+	} else {
+		sourceFileChanged = false
+	}
+
+	sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChanged] = sourceFileChanged
+
+	return d.Set(mkResourceVirtualEnvironmentFileSourceFile, []interface{}{sourceFileBlock})
+}
+
+func resourceVirtualEnvironmentFileStateUpgradeV0(rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	// Version 0 did not include the checksum algorithm, decompression algorithm, node download, timeout and verification
+	// arguments. Their defaults must be written to the state, as they would otherwise force the file to be replaced or updated.
+	if rawState[mkResourceVirtualEnvironmentFileTimeoutDownload] == nil {
+		rawState[mkResourceVirtualEnvironmentFileTimeoutDownload] = dvResourceVirtualEnvironmentFileTimeoutDownload
+	}
+
+	if rawState[mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum] == nil {
+		rawState[mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum] = dvResourceVirtualEnvironmentFileVerifyDatastoreChecksum
+	}
+
+	sourceFile, ok := rawState[mkResourceVirtualEnvironmentFileSourceFile].([]interface{})
+
+	if !ok {
+		return rawState, nil
+	}
+
+	for _, b := range sourceFile {
+		sourceFileBlock, ok := b.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm] == nil {
+			sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm] = dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm
+		}
+
+		if sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileDecompression] == nil {
+			sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileDecompression] = dvResourceVirtualEnvironmentFileSourceFileDecompression
+		}
+
+		if sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileNodeDownload] == nil {
+			sourceFileBlock[mkResourceVirtualEnvironmentFileSourceFileNodeDownload] = dvResourceVirtualEnvironmentFileSourceFileNodeDownload
+		}
+	}
+
+	return rawState, nil
+}
+
+func resourceVirtualEnvironmentFileUpdate(d *schema.ResourceData, m interface{}) error {
+	// Only the timeout and verification settings can be changed without uploading the file again.
+	return resourceVirtualEnvironmentFileRead(d, m)
+}
+
+// resourceVirtualEnvironmentFileVerifyChecksum calculates the checksum of a file in a datastore using SSH and removes
+// the file, if the checksum cannot be calculated or does not match the expected checksum.
+func resourceVirtualEnvironmentFileVerifyChecksum(veClient *proxmox.VirtualEnvironmentClient, nodeName string, datastoreID string, volumeID string, algorithm string, expectedChecksum string) (*string, error) {
+	fileChecksum, err := veClient.GetDatastoreFileChecksum(nodeName, datastoreID, volumeID, algorithm)
+
+	if err == nil && (expectedChecksum == "" || strings.EqualFold(expectedChecksum, *fileChecksum)) {
+		return fileChecksum, nil
+	}
+
+	deleteErr := veClient.DeleteDatastoreFile(nodeName, datastoreID, volumeID)
+
+	if deleteErr != nil {
+		log.Printf("[DEBUG] WARNING: Failed to delete volume \"%s\" - Reason: %s", volumeID, deleteErr.Error())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to verify the checksum of volume \"%s\" - Reason: %s", volumeID, err.Error())
+	}
+
+	return nil, fmt.Errorf(
+		"The %s checksum \"%s\" of the file in the datastore does not match the expected checksum \"%s\"",
+		strings.ToUpper(algorithm),
+		*fileChecksum,
+		expectedChecksum,
+	)
 }
 
 func resourceVirtualEnvironmentFileDelete(d *schema.ResourceData, m interface{}) error {
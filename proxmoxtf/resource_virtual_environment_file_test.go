@@ -32,9 +32,13 @@ func TestResourceVirtualEnvironmentFileSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentFileContentType,
 		mkResourceVirtualEnvironmentFileSourceFile,
 		mkResourceVirtualEnvironmentFileSourceRaw,
+		mkResourceVirtualEnvironmentFileTimeoutDownload,
+		mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum,
 	})
 
 	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentFileFileChecksum,
+		mkResourceVirtualEnvironmentFileFileChecksumFingerprint,
 		mkResourceVirtualEnvironmentFileFileModificationDate,
 		mkResourceVirtualEnvironmentFileFileName,
 		mkResourceVirtualEnvironmentFileFileSize,
@@ -44,6 +48,7 @@ func TestResourceVirtualEnvironmentFileSchema(t *testing.T) {
 	testValueTypes(t, s, map[string]schema.ValueType{
 		mkResourceVirtualEnvironmentFileContentType:          schema.TypeString,
 		mkResourceVirtualEnvironmentFileDatastoreID:          schema.TypeString,
+		mkResourceVirtualEnvironmentFileFileChecksum:         schema.TypeString,
 		mkResourceVirtualEnvironmentFileFileModificationDate: schema.TypeString,
 		mkResourceVirtualEnvironmentFileFileName:             schema.TypeString,
 		mkResourceVirtualEnvironmentFileFileSize:             schema.TypeInt,
@@ -62,16 +67,22 @@ func TestResourceVirtualEnvironmentFileSchema(t *testing.T) {
 	testOptionalArguments(t, sourceFileSchema, []string{
 		mkResourceVirtualEnvironmentFileSourceFileChanged,
 		mkResourceVirtualEnvironmentFileSourceFileChecksum,
+		mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm,
+		mkResourceVirtualEnvironmentFileSourceFileDecompression,
 		mkResourceVirtualEnvironmentFileSourceFileFileName,
 		mkResourceVirtualEnvironmentFileSourceFileInsecure,
+		mkResourceVirtualEnvironmentFileSourceFileNodeDownload,
 	})
 
 	testValueTypes(t, sourceFileSchema, map[string]schema.ValueType{
-		mkResourceVirtualEnvironmentFileSourceFileChanged:  schema.TypeBool,
-		mkResourceVirtualEnvironmentFileSourceFileChecksum: schema.TypeString,
-		mkResourceVirtualEnvironmentFileSourceFileFileName: schema.TypeString,
-		mkResourceVirtualEnvironmentFileSourceFileInsecure: schema.TypeBool,
-		mkResourceVirtualEnvironmentFileSourceFilePath:     schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileChanged:           schema.TypeBool,
+		mkResourceVirtualEnvironmentFileSourceFileChecksum:          schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileDecompression:     schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileFileName:          schema.TypeString,
+		mkResourceVirtualEnvironmentFileSourceFileInsecure:          schema.TypeBool,
+		mkResourceVirtualEnvironmentFileSourceFileNodeDownload:      schema.TypeBool,
+		mkResourceVirtualEnvironmentFileSourceFilePath:              schema.TypeString,
 	})
 
 	sourceRawSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentFileSourceRaw)
@@ -91,3 +102,69 @@ func TestResourceVirtualEnvironmentFileSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentFileSourceRawResize:   schema.TypeInt,
 	})
 }
+
+// TestResourceVirtualEnvironmentFileStateUpgradeV0 tests whether version 0 states are upgraded without forcing replacement.
+func TestResourceVirtualEnvironmentFileStateUpgradeV0(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{"missing arguments", map[string]interface{}{
+			mkResourceVirtualEnvironmentFileSourceFile: []interface{}{
+				map[string]interface{}{
+					mkResourceVirtualEnvironmentFileSourceFilePath: "https://example.com/image.iso",
+				},
+			},
+		}, map[string]interface{}{
+			mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: dvResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm,
+			mkResourceVirtualEnvironmentFileSourceFileDecompression:     dvResourceVirtualEnvironmentFileSourceFileDecompression,
+			mkResourceVirtualEnvironmentFileSourceFileNodeDownload:      dvResourceVirtualEnvironmentFileSourceFileNodeDownload,
+		}},
+		{"existing arguments", map[string]interface{}{
+			mkResourceVirtualEnvironmentFileSourceFile: []interface{}{
+				map[string]interface{}{
+					mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: "sha512",
+					mkResourceVirtualEnvironmentFileSourceFileDecompression:     "gz",
+					mkResourceVirtualEnvironmentFileSourceFileNodeDownload:      false,
+				},
+			},
+		}, map[string]interface{}{
+			mkResourceVirtualEnvironmentFileSourceFileChecksumAlgorithm: "sha512",
+			mkResourceVirtualEnvironmentFileSourceFileDecompression:     "gz",
+			mkResourceVirtualEnvironmentFileSourceFileNodeDownload:      false,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := resourceVirtualEnvironmentFileStateUpgradeV0(tt.state, nil)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			sourceFileBlock := state[mkResourceVirtualEnvironmentFileSourceFile].([]interface{})[0].(map[string]interface{})
+
+			for k, v := range tt.expected {
+				if sourceFileBlock[k] != v {
+					t.Fatalf("Expected %s to be %v (got %v)", k, v, sourceFileBlock[k])
+				}
+			}
+		})
+	}
+
+	state, err := resourceVirtualEnvironmentFileStateUpgradeV0(map[string]interface{}{}, nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error for a state without a source file: %s", err.Error())
+	}
+
+	if state[mkResourceVirtualEnvironmentFileTimeoutDownload] != dvResourceVirtualEnvironmentFileTimeoutDownload {
+		t.Fatalf("Expected %s to be %s", mkResourceVirtualEnvironmentFileTimeoutDownload, dvResourceVirtualEnvironmentFileTimeoutDownload)
+	}
+
+	if state[mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum] != dvResourceVirtualEnvironmentFileVerifyDatastoreChecksum {
+		t.Fatalf("Expected %s to be %v", mkResourceVirtualEnvironmentFileVerifyDatastoreChecksum, dvResourceVirtualEnvironmentFileVerifyDatastoreChecksum)
+	}
+}
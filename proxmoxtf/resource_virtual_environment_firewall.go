@@ -0,0 +1,124 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentFirewallContainerID = -1
+	dvResourceVirtualEnvironmentFirewallNodeName    = ""
+	dvResourceVirtualEnvironmentFirewallVMID        = -1
+
+	mkResourceVirtualEnvironmentFirewallContainerID = "container_id"
+	mkResourceVirtualEnvironmentFirewallNodeName    = "node_name"
+	mkResourceVirtualEnvironmentFirewallVMID        = "vm_id"
+)
+
+// resourceVirtualEnvironmentFirewallAddScopeSchema adds the arguments, which select the firewall to manage (defaults to the cluster firewall).
+func resourceVirtualEnvironmentFirewallAddScopeSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s[mkResourceVirtualEnvironmentFirewallContainerID] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "The container id",
+		Optional:      true,
+		ForceNew:      true,
+		Default:       dvResourceVirtualEnvironmentFirewallContainerID,
+		ConflictsWith: []string{mkResourceVirtualEnvironmentFirewallVMID},
+		ValidateFunc:  getVMIDValidator(),
+	}
+	s[mkResourceVirtualEnvironmentFirewallNodeName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The node name",
+		Optional:    true,
+		ForceNew:    true,
+		Default:     dvResourceVirtualEnvironmentFirewallNodeName,
+	}
+	s[mkResourceVirtualEnvironmentFirewallVMID] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "The virtual machine id",
+		Optional:      true,
+		ForceNew:      true,
+		Default:       dvResourceVirtualEnvironmentFirewallVMID,
+		ConflictsWith: []string{mkResourceVirtualEnvironmentFirewallContainerID},
+		ValidateFunc:  getVMIDValidator(),
+	}
+
+	return s
+}
+
+// resourceVirtualEnvironmentFirewallGetScope returns the base path and the identifier of the selected firewall.
+func resourceVirtualEnvironmentFirewallGetScope(d *schema.ResourceData, allowNode bool) (string, string, error) {
+	containerID := d.Get(mkResourceVirtualEnvironmentFirewallContainerID).(int)
+	nodeName := d.Get(mkResourceVirtualEnvironmentFirewallNodeName).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentFirewallVMID).(int)
+
+	if nodeName == "" {
+		if containerID != -1 || vmID != -1 {
+			return "", "", fmt.Errorf("The \"%s\" argument must be specified for container and virtual machine firewalls", mkResourceVirtualEnvironmentFirewallNodeName)
+		}
+
+		return proxmox.GetClusterFirewallPath(), "cluster", nil
+	}
+
+	if containerID != -1 {
+		return proxmox.GetContainerFirewallPath(nodeName, containerID), fmt.Sprintf("container/%s/%d", nodeName, containerID), nil
+	} else if vmID != -1 {
+		return proxmox.GetVMFirewallPath(nodeName, vmID), fmt.Sprintf("vm/%s/%d", nodeName, vmID), nil
+	}
+
+	if !allowNode {
+		return "", "", fmt.Errorf(
+			"Node firewalls are not supported by this resource (specify either \"%s\" or \"%s\" in addition to \"%s\")",
+			mkResourceVirtualEnvironmentFirewallContainerID,
+			mkResourceVirtualEnvironmentFirewallVMID,
+			mkResourceVirtualEnvironmentFirewallNodeName,
+		)
+	}
+
+	return proxmox.GetNodeFirewallPath(nodeName), fmt.Sprintf("node/%s", nodeName), nil
+}
+
+// resourceVirtualEnvironmentFirewallImportScope sets the arguments, which select the firewall, from a scope identifier.
+func resourceVirtualEnvironmentFirewallImportScope(d *schema.ResourceData, id string) error {
+	parts := strings.Split(id, "/")
+
+	d.Set(mkResourceVirtualEnvironmentFirewallContainerID, dvResourceVirtualEnvironmentFirewallContainerID)
+	d.Set(mkResourceVirtualEnvironmentFirewallNodeName, dvResourceVirtualEnvironmentFirewallNodeName)
+	d.Set(mkResourceVirtualEnvironmentFirewallVMID, dvResourceVirtualEnvironmentFirewallVMID)
+
+	switch {
+	case len(parts) == 1 && parts[0] == "cluster":
+		return nil
+	case len(parts) == 2 && parts[0] == "node" && parts[1] != "":
+		d.Set(mkResourceVirtualEnvironmentFirewallNodeName, parts[1])
+
+		return nil
+	case len(parts) == 3 && (parts[0] == "container" || parts[0] == "vm") && parts[1] != "":
+		vmID, err := strconv.Atoi(parts[2])
+
+		if err != nil {
+			return fmt.Errorf("Invalid container or virtual machine id \"%s\"", parts[2])
+		}
+
+		d.Set(mkResourceVirtualEnvironmentFirewallNodeName, parts[1])
+
+		if parts[0] == "container" {
+			d.Set(mkResourceVirtualEnvironmentFirewallContainerID, vmID)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentFirewallVMID, vmID)
+		}
+
+		return nil
+	}
+
+	return errors.New("The firewall must be of the form \"cluster\", \"node/name\", \"container/node/id\" or \"vm/node/id\"")
+}
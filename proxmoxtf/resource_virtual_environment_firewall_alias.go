@@ -0,0 +1,206 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentFirewallAliasComment = ""
+
+	mkResourceVirtualEnvironmentFirewallAliasCIDR    = "cidr"
+	mkResourceVirtualEnvironmentFirewallAliasComment = "comment"
+	mkResourceVirtualEnvironmentFirewallAliasName    = "name"
+)
+
+func resourceVirtualEnvironmentFirewallAlias() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceVirtualEnvironmentFirewallAddScopeSchema(map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentFirewallAliasCIDR: {
+				Type:        schema.TypeString,
+				Description: "The IP address or network in CIDR notation",
+				Required:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallAliasComment: {
+				Type:        schema.TypeString,
+				Description: "The comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentFirewallAliasComment,
+			},
+			mkResourceVirtualEnvironmentFirewallAliasName: {
+				Type:         schema.TypeString,
+				Description:  "The alias name",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getFirewallNameValidator(),
+			},
+		}),
+		Create: resourceVirtualEnvironmentFirewallAliasCreate,
+		Read:   resourceVirtualEnvironmentFirewallAliasRead,
+		Update: resourceVirtualEnvironmentFirewallAliasUpdate,
+		Delete: resourceVirtualEnvironmentFirewallAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentFirewallAliasImport,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentFirewallAliasCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, scopeID, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentFirewallAliasComment).(string)
+	name := d.Get(mkResourceVirtualEnvironmentFirewallAliasName).(string)
+
+	body := &proxmox.VirtualEnvironmentFirewallAliasCreateRequestBody{
+		CIDR:    d.Get(mkResourceVirtualEnvironmentFirewallAliasCIDR).(string),
+		Comment: &comment,
+		Name:    name,
+	}
+
+	err = veClient.CreateFirewallAlias(basePath, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", scopeID, name))
+
+	return resourceVirtualEnvironmentFirewallAliasRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallAliasImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.New("The identifier must be of the form \"firewall|name\" (e.g. \"cluster|local-network\" or \"vm/pve1/100|gateway\")")
+	}
+
+	err := resourceVirtualEnvironmentFirewallImportScope(d, parts[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentFirewallAliasName, parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentFirewallAliasRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentFirewallAliasName).(string)
+	alias, err := veClient.GetFirewallAlias(basePath, name)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentFirewallAliasCIDR, alias.CIDR)
+
+	if alias.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentFirewallAliasComment, alias.Comment)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentFirewallAliasComment, "")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallAliasUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentFirewallAliasComment).(string)
+	name := d.Get(mkResourceVirtualEnvironmentFirewallAliasName).(string)
+
+	body := &proxmox.VirtualEnvironmentFirewallAliasUpdateRequestBody{
+		CIDR:    d.Get(mkResourceVirtualEnvironmentFirewallAliasCIDR).(string),
+		Comment: &comment,
+	}
+
+	err = veClient.UpdateFirewallAlias(basePath, name, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentFirewallAliasRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallAliasDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteFirewallAlias(basePath, d.Get(mkResourceVirtualEnvironmentFirewallAliasName).(string))
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
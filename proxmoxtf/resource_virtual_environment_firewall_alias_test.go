@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentFirewallAliasInstantiation tests whether the ResourceVirtualEnvironmentFirewallAlias instance can be instantiated.
+func TestResourceVirtualEnvironmentFirewallAliasInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallAlias()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentFirewallAlias")
+	}
+}
+
+// TestResourceVirtualEnvironmentFirewallAliasSchema tests the resourceVirtualEnvironmentFirewallAlias schema.
+func TestResourceVirtualEnvironmentFirewallAliasSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallAlias()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallAliasCIDR,
+		mkResourceVirtualEnvironmentFirewallAliasName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallAliasComment,
+		mkResourceVirtualEnvironmentFirewallContainerID,
+		mkResourceVirtualEnvironmentFirewallNodeName,
+		mkResourceVirtualEnvironmentFirewallVMID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallAliasCIDR:    schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallAliasComment: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallAliasName:    schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallContainerID:  schema.TypeInt,
+		mkResourceVirtualEnvironmentFirewallNodeName:     schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallVMID:         schema.TypeInt,
+	})
+}
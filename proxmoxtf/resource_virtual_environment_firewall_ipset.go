@@ -0,0 +1,379 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentFirewallIPSetCIDRComment = ""
+	dvResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch = false
+	dvResourceVirtualEnvironmentFirewallIPSetComment     = ""
+
+	mkResourceVirtualEnvironmentFirewallIPSetCIDR        = "cidr"
+	mkResourceVirtualEnvironmentFirewallIPSetCIDRComment = "comment"
+	mkResourceVirtualEnvironmentFirewallIPSetCIDRName    = "name"
+	mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch = "nomatch"
+	mkResourceVirtualEnvironmentFirewallIPSetComment     = "comment"
+	mkResourceVirtualEnvironmentFirewallIPSetName        = "name"
+)
+
+func resourceVirtualEnvironmentFirewallIPSet() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceVirtualEnvironmentFirewallAddScopeSchema(map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentFirewallIPSetCIDR: {
+				Type:        schema.TypeSet,
+				Description: "The entries",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentFirewallIPSetCIDRComment: {
+							Type:        schema.TypeString,
+							Description: "The comment",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallIPSetCIDRComment,
+						},
+						mkResourceVirtualEnvironmentFirewallIPSetCIDRName: {
+							Type:        schema.TypeString,
+							Description: "The IP address or network in CIDR notation",
+							Required:    true,
+						},
+						mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch: {
+							Type:        schema.TypeBool,
+							Description: "Whether to exclude the entry from the set",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch,
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentFirewallIPSetComment: {
+				Type:        schema.TypeString,
+				Description: "The comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentFirewallIPSetComment,
+			},
+			mkResourceVirtualEnvironmentFirewallIPSetName: {
+				Type:         schema.TypeString,
+				Description:  "The IP set name",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getFirewallNameValidator(),
+			},
+		}),
+		Create: resourceVirtualEnvironmentFirewallIPSetCreate,
+		Read:   resourceVirtualEnvironmentFirewallIPSetRead,
+		Update: resourceVirtualEnvironmentFirewallIPSetUpdate,
+		Delete: resourceVirtualEnvironmentFirewallIPSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentFirewallIPSetImport,
+		},
+	}
+}
+
+// resourceVirtualEnvironmentFirewallIPSetApplyEntries adds, updates and removes entries to match the configuration.
+func resourceVirtualEnvironmentFirewallIPSetApplyEntries(d *schema.ResourceData, veClient *proxmox.VirtualEnvironmentClient, basePath string) error {
+	name := d.Get(mkResourceVirtualEnvironmentFirewallIPSetName).(string)
+	existingEntries, err := veClient.ListFirewallIPSetEntries(basePath, name)
+
+	if err != nil {
+		return err
+	}
+
+	entries := map[string]map[string]interface{}{}
+
+	for _, v := range d.Get(mkResourceVirtualEnvironmentFirewallIPSetCIDR).(*schema.Set).List() {
+		block := v.(map[string]interface{})
+		entries[block[mkResourceVirtualEnvironmentFirewallIPSetCIDRName].(string)] = block
+	}
+
+	for _, v := range existingEntries {
+		block, ok := entries[v.CIDR]
+
+		if !ok {
+			err = veClient.DeleteFirewallIPSetEntry(basePath, name, v.CIDR)
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		delete(entries, v.CIDR)
+
+		comment := block[mkResourceVirtualEnvironmentFirewallIPSetCIDRComment].(string)
+		noMatch := block[mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch].(bool)
+
+		existingComment := ""
+
+		if v.Comment != nil {
+			existingComment = *v.Comment
+		}
+
+		if comment == existingComment && noMatch == (v.NoMatch != nil && bool(*v.NoMatch)) {
+			continue
+		}
+
+		customNoMatch := proxmox.CustomBool(noMatch)
+
+		err = veClient.UpdateFirewallIPSetEntry(basePath, name, v.CIDR, &proxmox.VirtualEnvironmentFirewallIPSetEntryUpdateRequestBody{
+			Comment: &comment,
+			NoMatch: &customNoMatch,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for cidr, block := range entries {
+		comment := block[mkResourceVirtualEnvironmentFirewallIPSetCIDRComment].(string)
+		noMatch := proxmox.CustomBool(block[mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch].(bool))
+
+		err = veClient.CreateFirewallIPSetEntry(basePath, name, &proxmox.VirtualEnvironmentFirewallIPSetEntryCreateRequestBody{
+			CIDR:    cidr,
+			Comment: &comment,
+			NoMatch: &noMatch,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallIPSetCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, scopeID, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentFirewallIPSetComment).(string)
+	name := d.Get(mkResourceVirtualEnvironmentFirewallIPSetName).(string)
+
+	body := &proxmox.VirtualEnvironmentFirewallIPSetCreateRequestBody{
+		Comment: &comment,
+		Name:    name,
+	}
+
+	err = veClient.CreateFirewallIPSet(basePath, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", scopeID, name))
+
+	err = resourceVirtualEnvironmentFirewallIPSetApplyEntries(d, veClient, basePath)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentFirewallIPSetRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallIPSetImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.New("The identifier must be of the form \"firewall|name\" (e.g. \"cluster|management\" or \"vm/pve1/100|allowed\")")
+	}
+
+	err := resourceVirtualEnvironmentFirewallImportScope(d, parts[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentFirewallIPSetName, parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentFirewallIPSetRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentFirewallIPSetName).(string)
+	ipSets, err := veClient.ListFirewallIPSets(basePath)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	var ipSet *proxmox.VirtualEnvironmentFirewallIPSetListResponseData
+
+	for _, v := range ipSets {
+		if v.Name == name {
+			ipSet = v
+
+			break
+		}
+	}
+
+	if ipSet == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	if ipSet.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentFirewallIPSetComment, ipSet.Comment)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentFirewallIPSetComment, "")
+	}
+
+	existingEntries, err := veClient.ListFirewallIPSetEntries(basePath, name)
+
+	if err != nil {
+		return err
+	}
+
+	entries := make([]interface{}, len(existingEntries))
+
+	for i, v := range existingEntries {
+		block := map[string]interface{}{}
+
+		if v.Comment != nil {
+			block[mkResourceVirtualEnvironmentFirewallIPSetCIDRComment] = *v.Comment
+		} else {
+			block[mkResourceVirtualEnvironmentFirewallIPSetCIDRComment] = ""
+		}
+
+		block[mkResourceVirtualEnvironmentFirewallIPSetCIDRName] = v.CIDR
+		block[mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch] = v.NoMatch != nil && bool(*v.NoMatch)
+
+		entries[i] = block
+	}
+
+	d.Set(mkResourceVirtualEnvironmentFirewallIPSetCIDR, entries)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallIPSetUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentFirewallIPSetName).(string)
+
+	if d.HasChange(mkResourceVirtualEnvironmentFirewallIPSetComment) {
+		comment := d.Get(mkResourceVirtualEnvironmentFirewallIPSetComment).(string)
+
+		// Renaming an IP set to its current name only updates the comment.
+		body := &proxmox.VirtualEnvironmentFirewallIPSetCreateRequestBody{
+			Comment: &comment,
+			Name:    name,
+			Rename:  &name,
+		}
+
+		err = veClient.CreateFirewallIPSet(basePath, body)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange(mkResourceVirtualEnvironmentFirewallIPSetCIDR) {
+		err = resourceVirtualEnvironmentFirewallIPSetApplyEntries(d, veClient, basePath)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceVirtualEnvironmentFirewallIPSetRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallIPSetDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentFirewallIPSetName).(string)
+	existingEntries, err := veClient.ListFirewallIPSetEntries(basePath, name)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	// The server refuses to delete IP sets, which still contain entries.
+	for _, v := range existingEntries {
+		err = veClient.DeleteFirewallIPSetEntry(basePath, name, v.CIDR)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = veClient.DeleteFirewallIPSet(basePath, name)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
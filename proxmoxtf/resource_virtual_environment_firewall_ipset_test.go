@@ -0,0 +1,63 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentFirewallIPSetInstantiation tests whether the ResourceVirtualEnvironmentFirewallIPSet instance can be instantiated.
+func TestResourceVirtualEnvironmentFirewallIPSetInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallIPSet()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentFirewallIPSet")
+	}
+}
+
+// TestResourceVirtualEnvironmentFirewallIPSetSchema tests the resourceVirtualEnvironmentFirewallIPSet schema.
+func TestResourceVirtualEnvironmentFirewallIPSetSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallIPSet()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallIPSetName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallContainerID,
+		mkResourceVirtualEnvironmentFirewallIPSetCIDR,
+		mkResourceVirtualEnvironmentFirewallIPSetComment,
+		mkResourceVirtualEnvironmentFirewallNodeName,
+		mkResourceVirtualEnvironmentFirewallVMID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallContainerID:  schema.TypeInt,
+		mkResourceVirtualEnvironmentFirewallIPSetCIDR:    schema.TypeSet,
+		mkResourceVirtualEnvironmentFirewallIPSetComment: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallIPSetName:    schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallNodeName:     schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallVMID:         schema.TypeInt,
+	})
+
+	cidrSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentFirewallIPSetCIDR)
+
+	testRequiredArguments(t, cidrSchema, []string{
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRName,
+	})
+
+	testOptionalArguments(t, cidrSchema, []string{
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRComment,
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch,
+	})
+
+	testValueTypes(t, cidrSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRComment: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRName:    schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallIPSetCIDRNoMatch: schema.TypeBool,
+	})
+}
@@ -0,0 +1,307 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	mkResourceVirtualEnvironmentFirewallOptionsDHCP                = "dhcp"
+	mkResourceVirtualEnvironmentFirewallOptionsEBTables            = "ebtables"
+	mkResourceVirtualEnvironmentFirewallOptionsEnabled             = "enabled"
+	mkResourceVirtualEnvironmentFirewallOptionsIPFilter            = "ip_filter"
+	mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn          = "log_level_in"
+	mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut         = "log_level_out"
+	mkResourceVirtualEnvironmentFirewallOptionsMACFilter           = "mac_filter"
+	mkResourceVirtualEnvironmentFirewallOptionsNDP                 = "ndp"
+	mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs            = "no_smurfs"
+	mkResourceVirtualEnvironmentFirewallOptionsPolicyIn            = "policy_in"
+	mkResourceVirtualEnvironmentFirewallOptionsPolicyOut           = "policy_out"
+	mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement = "router_advertisement"
+	mkResourceVirtualEnvironmentFirewallOptionsTCPFlags            = "tcp_flags"
+)
+
+func resourceVirtualEnvironmentFirewallOptions() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceVirtualEnvironmentFirewallAddScopeSchema(map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentFirewallOptionsDHCP: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable DHCP (guests only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsEBTables: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable ebtables rules (cluster only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the firewall is enabled",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsIPFilter: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable default IP filters (guests only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn: {
+				Type:         schema.TypeString,
+				Description:  "The log level for incoming traffic (nodes and guests only)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: getFirewallLogLevelValidator(),
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut: {
+				Type:         schema.TypeString,
+				Description:  "The log level for outgoing traffic (nodes and guests only)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: getFirewallLogLevelValidator(),
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsMACFilter: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable the MAC address filter (guests only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsNDP: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable NDP (nodes and guests only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable the SMURFS filter (nodes only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsPolicyIn: {
+				Type:         schema.TypeString,
+				Description:  "The policy for incoming traffic (cluster and guests only)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: getFirewallPolicyValidator(),
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsPolicyOut: {
+				Type:         schema.TypeString,
+				Description:  "The policy for outgoing traffic (cluster and guests only)",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: getFirewallPolicyValidator(),
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement: {
+				Type:        schema.TypeBool,
+				Description: "Whether to allow router advertisements (guests only)",
+				Optional:    true,
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentFirewallOptionsTCPFlags: {
+				Type:        schema.TypeBool,
+				Description: "Whether to filter illegal combinations of TCP flags (nodes only)",
+				Optional:    true,
+				Computed:    true,
+			},
+		}),
+		Create: resourceVirtualEnvironmentFirewallOptionsCreate,
+		Read:   resourceVirtualEnvironmentFirewallOptionsRead,
+		Update: resourceVirtualEnvironmentFirewallOptionsUpdate,
+		Delete: resourceVirtualEnvironmentFirewallOptionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentFirewallOptionsImport,
+		},
+	}
+}
+
+// resourceVirtualEnvironmentFirewallOptionsApply updates the options, which are either specified or changed, as the supported options depend on the firewall.
+func resourceVirtualEnvironmentFirewallOptionsApply(d *schema.ResourceData, veClient *proxmox.VirtualEnvironmentClient, basePath string) error {
+	body := &proxmox.VirtualEnvironmentFirewallOptionsUpdateRequestBody{}
+
+	boolOptions := map[string]**proxmox.CustomBool{
+		mkResourceVirtualEnvironmentFirewallOptionsDHCP:                &body.DHCP,
+		mkResourceVirtualEnvironmentFirewallOptionsEBTables:            &body.EBTables,
+		mkResourceVirtualEnvironmentFirewallOptionsEnabled:             &body.Enabled,
+		mkResourceVirtualEnvironmentFirewallOptionsIPFilter:            &body.IPFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsMACFilter:           &body.MACFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsNDP:                 &body.NDP,
+		mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs:            &body.NoSMURFs,
+		mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement: &body.RouterAdvertisement,
+		mkResourceVirtualEnvironmentFirewallOptionsTCPFlags:            &body.TCPFlags,
+	}
+
+	stringOptions := map[string]**string{
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn:  &body.LogLevelIn,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut: &body.LogLevelOut,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyIn:    &body.PolicyIn,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyOut:   &body.PolicyOut,
+	}
+
+	changed := false
+
+	for k, v := range boolOptions {
+		value, ok := d.GetOkExists(k)
+
+		if !ok || (!d.IsNewResource() && !d.HasChange(k)) {
+			continue
+		}
+
+		customValue := proxmox.CustomBool(value.(bool))
+		*v = &customValue
+		changed = true
+	}
+
+	for k, v := range stringOptions {
+		value := d.Get(k).(string)
+
+		if value == "" || (!d.IsNewResource() && !d.HasChange(k)) {
+			continue
+		}
+
+		*v = &value
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return veClient.UpdateFirewallOptions(basePath, body)
+}
+
+func resourceVirtualEnvironmentFirewallOptionsCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, scopeID, err := resourceVirtualEnvironmentFirewallGetScope(d, true)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentFirewallOptionsApply(d, veClient, basePath)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(scopeID)
+
+	return resourceVirtualEnvironmentFirewallOptionsRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallOptionsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	err := resourceVirtualEnvironmentFirewallImportScope(d, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentFirewallOptionsRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, true)
+
+	if err != nil {
+		return err
+	}
+
+	options, err := veClient.GetFirewallOptions(basePath)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	boolOptions := map[string]*proxmox.CustomBool{
+		mkResourceVirtualEnvironmentFirewallOptionsDHCP:                options.DHCP,
+		mkResourceVirtualEnvironmentFirewallOptionsEBTables:            options.EBTables,
+		mkResourceVirtualEnvironmentFirewallOptionsEnabled:             options.Enabled,
+		mkResourceVirtualEnvironmentFirewallOptionsIPFilter:            options.IPFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsMACFilter:           options.MACFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsNDP:                 options.NDP,
+		mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs:            options.NoSMURFs,
+		mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement: options.RouterAdvertisement,
+		mkResourceVirtualEnvironmentFirewallOptionsTCPFlags:            options.TCPFlags,
+	}
+
+	for k, v := range boolOptions {
+		if v != nil {
+			d.Set(k, bool(*v))
+		} else {
+			d.Set(k, false)
+		}
+	}
+
+	stringOptions := map[string]*string{
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn:  options.LogLevelIn,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut: options.LogLevelOut,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyIn:    options.PolicyIn,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyOut:   options.PolicyOut,
+	}
+
+	for k, v := range stringOptions {
+		if v != nil {
+			d.Set(k, *v)
+		} else {
+			d.Set(k, "")
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallOptionsUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	basePath, _, err := resourceVirtualEnvironmentFirewallGetScope(d, true)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentFirewallOptionsApply(d, veClient, basePath)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentFirewallOptionsRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallOptionsDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,63 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentFirewallOptionsInstantiation tests whether the ResourceVirtualEnvironmentFirewallOptions instance can be instantiated.
+func TestResourceVirtualEnvironmentFirewallOptionsInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallOptions()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentFirewallOptions")
+	}
+}
+
+// TestResourceVirtualEnvironmentFirewallOptionsSchema tests the resourceVirtualEnvironmentFirewallOptions schema.
+func TestResourceVirtualEnvironmentFirewallOptionsSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallOptions()
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallContainerID,
+		mkResourceVirtualEnvironmentFirewallNodeName,
+		mkResourceVirtualEnvironmentFirewallOptionsDHCP,
+		mkResourceVirtualEnvironmentFirewallOptionsEBTables,
+		mkResourceVirtualEnvironmentFirewallOptionsEnabled,
+		mkResourceVirtualEnvironmentFirewallOptionsIPFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut,
+		mkResourceVirtualEnvironmentFirewallOptionsMACFilter,
+		mkResourceVirtualEnvironmentFirewallOptionsNDP,
+		mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyIn,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyOut,
+		mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement,
+		mkResourceVirtualEnvironmentFirewallOptionsTCPFlags,
+		mkResourceVirtualEnvironmentFirewallVMID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallContainerID:                schema.TypeInt,
+		mkResourceVirtualEnvironmentFirewallNodeName:                   schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallOptionsDHCP:                schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsEBTables:            schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsEnabled:             schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsIPFilter:            schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelIn:          schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallOptionsLogLevelOut:         schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallOptionsMACFilter:           schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsNDP:                 schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsNoSMURFs:            schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyIn:            schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallOptionsPolicyOut:           schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallOptionsRouterAdvertisement: schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallOptionsTCPFlags:            schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallVMID:                       schema.TypeInt,
+	})
+}
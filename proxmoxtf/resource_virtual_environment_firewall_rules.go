@@ -0,0 +1,459 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentFirewallRulesRuleComment         = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleDestination     = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleDestinationPort = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleEnabled         = true
+	dvResourceVirtualEnvironmentFirewallRulesRuleInterface       = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleLog             = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleMacro           = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleProtocol        = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleSource          = ""
+	dvResourceVirtualEnvironmentFirewallRulesRuleSourcePort      = ""
+	dvResourceVirtualEnvironmentFirewallRulesSecurityGroup       = ""
+
+	mkResourceVirtualEnvironmentFirewallRulesRule                = "rule"
+	mkResourceVirtualEnvironmentFirewallRulesRuleAction          = "action"
+	mkResourceVirtualEnvironmentFirewallRulesRuleComment         = "comment"
+	mkResourceVirtualEnvironmentFirewallRulesRuleDestination     = "destination"
+	mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort = "destination_port"
+	mkResourceVirtualEnvironmentFirewallRulesRuleEnabled         = "enabled"
+	mkResourceVirtualEnvironmentFirewallRulesRuleInterface       = "interface"
+	mkResourceVirtualEnvironmentFirewallRulesRuleLog             = "log"
+	mkResourceVirtualEnvironmentFirewallRulesRuleMacro           = "macro"
+	mkResourceVirtualEnvironmentFirewallRulesRuleProtocol        = "protocol"
+	mkResourceVirtualEnvironmentFirewallRulesRuleSource          = "source"
+	mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort      = "source_port"
+	mkResourceVirtualEnvironmentFirewallRulesRuleType            = "type"
+	mkResourceVirtualEnvironmentFirewallRulesSecurityGroup       = "security_group"
+)
+
+func resourceVirtualEnvironmentFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceVirtualEnvironmentFirewallAddScopeSchema(map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentFirewallRulesRule: {
+				Type:        schema.TypeList,
+				Description: "The rules in the order they are evaluated in",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentFirewallRulesRuleAction: {
+							Type:        schema.TypeString,
+							Description: "The action (ACCEPT, DROP or REJECT) or the security group name for group rules",
+							Required:    true,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleComment: {
+							Type:        schema.TypeString,
+							Description: "The comment",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleComment,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleDestination: {
+							Type:        schema.TypeString,
+							Description: "The destination address, range, alias or IP set",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleDestination,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort: {
+							Type:        schema.TypeString,
+							Description: "The destination ports",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleDestinationPort,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleEnabled: {
+							Type:        schema.TypeBool,
+							Description: "Whether the rule is enabled",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleEnabled,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleInterface: {
+							Type:        schema.TypeString,
+							Description: "The network interface",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleInterface,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleLog: {
+							Type:         schema.TypeString,
+							Description:  "The log level",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentFirewallRulesRuleLog,
+							ValidateFunc: getFirewallLogLevelValidator(),
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleMacro: {
+							Type:        schema.TypeString,
+							Description: "The macro",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleMacro,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleProtocol: {
+							Type:        schema.TypeString,
+							Description: "The protocol",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleProtocol,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleSource: {
+							Type:        schema.TypeString,
+							Description: "The source address, range, alias or IP set",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleSource,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort: {
+							Type:        schema.TypeString,
+							Description: "The source ports",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentFirewallRulesRuleSourcePort,
+						},
+						mkResourceVirtualEnvironmentFirewallRulesRuleType: {
+							Type:         schema.TypeString,
+							Description:  "The rule type",
+							Required:     true,
+							ValidateFunc: getFirewallRuleTypeValidator(),
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentFirewallRulesSecurityGroup: {
+				Type:        schema.TypeString,
+				Description: "The security group name",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentFirewallRulesSecurityGroup,
+				ConflictsWith: []string{
+					mkResourceVirtualEnvironmentFirewallContainerID,
+					mkResourceVirtualEnvironmentFirewallNodeName,
+					mkResourceVirtualEnvironmentFirewallVMID,
+				},
+			},
+		}),
+		Create: resourceVirtualEnvironmentFirewallRulesCreate,
+		Read:   resourceVirtualEnvironmentFirewallRulesRead,
+		Update: resourceVirtualEnvironmentFirewallRulesUpdate,
+		Delete: resourceVirtualEnvironmentFirewallRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentFirewallRulesImport,
+		},
+	}
+}
+
+// resourceVirtualEnvironmentFirewallRulesApply replaces the rules of the firewall while keeping the existing positions.
+func resourceVirtualEnvironmentFirewallRulesApply(d *schema.ResourceData, veClient *proxmox.VirtualEnvironmentClient, rulesPath string) error {
+	rules := d.Get(mkResourceVirtualEnvironmentFirewallRulesRule).([]interface{})
+
+	for i, r := range rules {
+		block := r.(map[string]interface{})
+		action := block[mkResourceVirtualEnvironmentFirewallRulesRuleAction].(string)
+		ruleType := block[mkResourceVirtualEnvironmentFirewallRulesRuleType].(string)
+
+		if ruleType != "group" && action != "ACCEPT" && action != "DROP" && action != "REJECT" {
+			return fmt.Errorf("The action of rule %d must be either ACCEPT, DROP or REJECT (got \"%s\")", i, action)
+		}
+	}
+
+	existingRules, err := veClient.ListFirewallRules(rulesPath)
+
+	if err != nil {
+		return err
+	}
+
+	for i := len(existingRules) - 1; i >= len(rules); i-- {
+		err = veClient.DeleteFirewallRule(rulesPath, existingRules[i].Position)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// New rules are always inserted at the top, which is why the rules are compared position by position afterwards.
+	for i := len(existingRules); i < len(rules); i++ {
+		body := resourceVirtualEnvironmentFirewallRulesGetUpdateBody(rules[i].(map[string]interface{}))
+
+		err = veClient.CreateFirewallRule(rulesPath, &proxmox.VirtualEnvironmentFirewallRuleCreateRequestBody{
+			Action:          body.Action,
+			Comment:         body.Comment,
+			Destination:     body.Destination,
+			DestinationPort: body.DestinationPort,
+			Enabled:         body.Enabled,
+			Interface:       body.Interface,
+			Log:             body.Log,
+			Macro:           body.Macro,
+			Protocol:        body.Protocol,
+			Source:          body.Source,
+			SourcePort:      body.SourcePort,
+			Type:            body.Type,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	existingRules, err = veClient.ListFirewallRules(rulesPath)
+
+	if err != nil {
+		return err
+	}
+
+	if len(existingRules) != len(rules) {
+		return fmt.Errorf("Expected the firewall to contain %d rules, found %d", len(rules), len(existingRules))
+	}
+
+	for i, r := range rules {
+		block := r.(map[string]interface{})
+
+		if reflect.DeepEqual(block, resourceVirtualEnvironmentFirewallRulesGetBlock(existingRules[i])) {
+			continue
+		}
+
+		err = veClient.UpdateFirewallRule(rulesPath, existingRules[i].Position, resourceVirtualEnvironmentFirewallRulesGetUpdateBody(block))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallRulesCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	rulesPath, id, err := resourceVirtualEnvironmentFirewallRulesGetScope(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentFirewallRulesApply(d, veClient, rulesPath)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceVirtualEnvironmentFirewallRulesRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallRulesGetBlock(r *proxmox.VirtualEnvironmentFirewallRuleListResponseData) map[string]interface{} {
+	block := map[string]interface{}{}
+	optional := map[string]*string{
+		mkResourceVirtualEnvironmentFirewallRulesRuleComment:         r.Comment,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestination:     r.Destination,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort: r.DestinationPort,
+		mkResourceVirtualEnvironmentFirewallRulesRuleInterface:       r.Interface,
+		mkResourceVirtualEnvironmentFirewallRulesRuleLog:             r.Log,
+		mkResourceVirtualEnvironmentFirewallRulesRuleMacro:           r.Macro,
+		mkResourceVirtualEnvironmentFirewallRulesRuleProtocol:        r.Protocol,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSource:          r.Source,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort:      r.SourcePort,
+	}
+
+	for k, v := range optional {
+		if v != nil {
+			block[k] = *v
+		} else {
+			block[k] = ""
+		}
+	}
+
+	block[mkResourceVirtualEnvironmentFirewallRulesRuleAction] = r.Action
+	block[mkResourceVirtualEnvironmentFirewallRulesRuleEnabled] = r.Enabled != nil && bool(*r.Enabled)
+	block[mkResourceVirtualEnvironmentFirewallRulesRuleType] = r.Type
+
+	return block
+}
+
+func resourceVirtualEnvironmentFirewallRulesGetScope(d *schema.ResourceData) (string, string, error) {
+	securityGroup := d.Get(mkResourceVirtualEnvironmentFirewallRulesSecurityGroup).(string)
+
+	if securityGroup != "" {
+		return proxmox.GetFirewallSecurityGroupRulesPath(securityGroup), fmt.Sprintf("security-group/%s", securityGroup), nil
+	}
+
+	basePath, id, err := resourceVirtualEnvironmentFirewallGetScope(d, true)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return proxmox.GetFirewallRulesPath(basePath), id, nil
+}
+
+func resourceVirtualEnvironmentFirewallRulesGetUpdateBody(block map[string]interface{}) *proxmox.VirtualEnvironmentFirewallRuleUpdateRequestBody {
+	enabled := proxmox.CustomBool(block[mkResourceVirtualEnvironmentFirewallRulesRuleEnabled].(bool))
+
+	body := &proxmox.VirtualEnvironmentFirewallRuleUpdateRequestBody{
+		Action:  block[mkResourceVirtualEnvironmentFirewallRulesRuleAction].(string),
+		Delete:  []string{},
+		Enabled: &enabled,
+		Type:    block[mkResourceVirtualEnvironmentFirewallRulesRuleType].(string),
+	}
+
+	optional := []struct {
+		key   string
+		param string
+		value **string
+	}{
+		{mkResourceVirtualEnvironmentFirewallRulesRuleComment, "comment", &body.Comment},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleDestination, "dest", &body.Destination},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort, "dport", &body.DestinationPort},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleInterface, "iface", &body.Interface},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleLog, "log", &body.Log},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleMacro, "macro", &body.Macro},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleProtocol, "proto", &body.Protocol},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleSource, "source", &body.Source},
+		{mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort, "sport", &body.SourcePort},
+	}
+
+	for _, v := range optional {
+		value := block[v.key].(string)
+
+		if value == "" {
+			body.Delete = append(body.Delete, v.param)
+		} else {
+			*v.value = &value
+		}
+	}
+
+	return body
+}
+
+func resourceVirtualEnvironmentFirewallRulesImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "security-group/") {
+		err := resourceVirtualEnvironmentFirewallImportScope(d, "cluster")
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set(mkResourceVirtualEnvironmentFirewallRulesSecurityGroup, strings.TrimPrefix(d.Id(), "security-group/"))
+	} else {
+		err := resourceVirtualEnvironmentFirewallImportScope(d, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set(mkResourceVirtualEnvironmentFirewallRulesSecurityGroup, dvResourceVirtualEnvironmentFirewallRulesSecurityGroup)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentFirewallRulesRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	rulesPath, _, err := resourceVirtualEnvironmentFirewallRulesGetScope(d)
+
+	if err != nil {
+		return err
+	}
+
+	existingRules, err := veClient.ListFirewallRules(rulesPath)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	rules := make([]interface{}, len(existingRules))
+
+	for i, v := range existingRules {
+		rules[i] = resourceVirtualEnvironmentFirewallRulesGetBlock(v)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentFirewallRulesRule, rules)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallRulesUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	rulesPath, _, err := resourceVirtualEnvironmentFirewallRulesGetScope(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentFirewallRulesApply(d, veClient, rulesPath)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentFirewallRulesRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallRulesDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	rulesPath, _, err := resourceVirtualEnvironmentFirewallRulesGetScope(d)
+
+	if err != nil {
+		return err
+	}
+
+	existingRules, err := veClient.ListFirewallRules(rulesPath)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	for i := len(existingRules) - 1; i >= 0; i-- {
+		err = veClient.DeleteFirewallRule(rulesPath, existingRules[i].Position)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
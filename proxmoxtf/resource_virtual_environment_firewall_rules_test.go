@@ -0,0 +1,76 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentFirewallRulesInstantiation tests whether the ResourceVirtualEnvironmentFirewallRules instance can be instantiated.
+func TestResourceVirtualEnvironmentFirewallRulesInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallRules()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentFirewallRules")
+	}
+}
+
+// TestResourceVirtualEnvironmentFirewallRulesSchema tests the resourceVirtualEnvironmentFirewallRules schema.
+func TestResourceVirtualEnvironmentFirewallRulesSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallRules()
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallContainerID,
+		mkResourceVirtualEnvironmentFirewallNodeName,
+		mkResourceVirtualEnvironmentFirewallRulesRule,
+		mkResourceVirtualEnvironmentFirewallRulesSecurityGroup,
+		mkResourceVirtualEnvironmentFirewallVMID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallContainerID:        schema.TypeInt,
+		mkResourceVirtualEnvironmentFirewallNodeName:           schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRule:          schema.TypeList,
+		mkResourceVirtualEnvironmentFirewallRulesSecurityGroup: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallVMID:               schema.TypeInt,
+	})
+
+	ruleSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentFirewallRulesRule)
+
+	testRequiredArguments(t, ruleSchema, []string{
+		mkResourceVirtualEnvironmentFirewallRulesRuleAction,
+		mkResourceVirtualEnvironmentFirewallRulesRuleType,
+	})
+
+	testOptionalArguments(t, ruleSchema, []string{
+		mkResourceVirtualEnvironmentFirewallRulesRuleComment,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestination,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort,
+		mkResourceVirtualEnvironmentFirewallRulesRuleEnabled,
+		mkResourceVirtualEnvironmentFirewallRulesRuleInterface,
+		mkResourceVirtualEnvironmentFirewallRulesRuleLog,
+		mkResourceVirtualEnvironmentFirewallRulesRuleMacro,
+		mkResourceVirtualEnvironmentFirewallRulesRuleProtocol,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSource,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort,
+	})
+
+	testValueTypes(t, ruleSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallRulesRuleAction:          schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleComment:         schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestination:     schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleDestinationPort: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleEnabled:         schema.TypeBool,
+		mkResourceVirtualEnvironmentFirewallRulesRuleInterface:       schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleLog:             schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleMacro:           schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleProtocol:        schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSource:          schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleSourcePort:      schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallRulesRuleType:            schema.TypeString,
+	})
+}
@@ -0,0 +1,160 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentFirewallSecurityGroupComment = ""
+
+	mkResourceVirtualEnvironmentFirewallSecurityGroupComment = "comment"
+	mkResourceVirtualEnvironmentFirewallSecurityGroupName    = "name"
+)
+
+func resourceVirtualEnvironmentFirewallSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentFirewallSecurityGroupComment: {
+				Type:        schema.TypeString,
+				Description: "The comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentFirewallSecurityGroupComment,
+			},
+			mkResourceVirtualEnvironmentFirewallSecurityGroupName: {
+				Type:         schema.TypeString,
+				Description:  "The security group name",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getFirewallSecurityGroupNameValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentFirewallSecurityGroupCreate,
+		Read:   resourceVirtualEnvironmentFirewallSecurityGroupRead,
+		Update: resourceVirtualEnvironmentFirewallSecurityGroupUpdate,
+		Delete: resourceVirtualEnvironmentFirewallSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentFirewallSecurityGroupCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentFirewallSecurityGroupComment).(string)
+	name := d.Get(mkResourceVirtualEnvironmentFirewallSecurityGroupName).(string)
+
+	body := &proxmox.VirtualEnvironmentFirewallGroupCreateRequestBody{
+		Comment: &comment,
+		Name:    name,
+	}
+
+	err = veClient.CreateFirewallGroup(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+
+	return resourceVirtualEnvironmentFirewallSecurityGroupRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallSecurityGroupRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	groups, err := veClient.ListFirewallGroups()
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range groups {
+		if v.Name != d.Id() {
+			continue
+		}
+
+		if v.Comment != nil {
+			d.Set(mkResourceVirtualEnvironmentFirewallSecurityGroupComment, v.Comment)
+		} else {
+			d.Set(mkResourceVirtualEnvironmentFirewallSecurityGroupComment, "")
+		}
+
+		d.Set(mkResourceVirtualEnvironmentFirewallSecurityGroupName, v.Name)
+
+		return nil
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentFirewallSecurityGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentFirewallSecurityGroupComment).(string)
+	name := d.Id()
+
+	// Renaming a security group to its current name only updates the comment.
+	body := &proxmox.VirtualEnvironmentFirewallGroupCreateRequestBody{
+		Comment: &comment,
+		Name:    name,
+		Rename:  &name,
+	}
+
+	err = veClient.CreateFirewallGroup(body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentFirewallSecurityGroupRead(d, m)
+}
+
+func resourceVirtualEnvironmentFirewallSecurityGroupDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteFirewallGroup(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentFirewallSecurityGroupInstantiation tests whether the ResourceVirtualEnvironmentFirewallSecurityGroup instance can be instantiated.
+func TestResourceVirtualEnvironmentFirewallSecurityGroupInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallSecurityGroup()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentFirewallSecurityGroup")
+	}
+}
+
+// TestResourceVirtualEnvironmentFirewallSecurityGroupSchema tests the resourceVirtualEnvironmentFirewallSecurityGroup schema.
+func TestResourceVirtualEnvironmentFirewallSecurityGroupSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentFirewallSecurityGroup()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallSecurityGroupName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentFirewallSecurityGroupComment,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentFirewallSecurityGroupComment: schema.TypeString,
+		mkResourceVirtualEnvironmentFirewallSecurityGroupName:    schema.TypeString,
+	})
+}
@@ -12,9 +12,11 @@ import (
 )
 
 const (
+	dvResourceVirtualEnvironmentGroupACLMode = "authoritative"
 	dvResourceVirtualEnvironmentGroupComment = ""
 
 	mkResourceVirtualEnvironmentGroupACL          = "acl"
+	mkResourceVirtualEnvironmentGroupACLMode      = "acl_mode"
 	mkResourceVirtualEnvironmentGroupACLPath      = "path"
 	mkResourceVirtualEnvironmentGroupACLPropagate = "propagate"
 	mkResourceVirtualEnvironmentGroupACLRoleID    = "role_id"
@@ -54,6 +56,13 @@ func resourceVirtualEnvironmentGroup() *schema.Resource {
 					},
 				},
 			},
+			mkResourceVirtualEnvironmentGroupACLMode: {
+				Type:         schema.TypeString,
+				Description:  "The access control list mode",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentGroupACLMode,
+				ValidateFunc: getACLModeValidator(),
+			},
 			mkResourceVirtualEnvironmentGroupComment: {
 				Type:        schema.TypeString,
 				Description: "The group comment",
@@ -129,6 +138,40 @@ func resourceVirtualEnvironmentGroupCreate(d *schema.ResourceData, m interface{}
 	return resourceVirtualEnvironmentGroupRead(d, m)
 }
 
+// resourceVirtualEnvironmentGroupGetACL retrieves the access control list entries of a group.
+// Only the entries declared in the configuration are returned in additive mode, in order to coexist with proxmox_virtual_environment_acl.
+func resourceVirtualEnvironmentGroupGetACL(d *schema.ResourceData, acl []*proxmox.VirtualEnvironmentACLGetResponseData, groupID string) []interface{} {
+	additive := d.Get(mkResourceVirtualEnvironmentGroupACLMode).(string) == "additive"
+	aclManaged := map[string]bool{}
+
+	for _, v := range d.Get(mkResourceVirtualEnvironmentGroupACL).(*schema.Set).List() {
+		aclEntry := v.(map[string]interface{})
+		aclManaged[aclEntry[mkResourceVirtualEnvironmentGroupACLPath].(string)+"|"+aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID].(string)] = true
+	}
+
+	aclParsed := []interface{}{}
+
+	for _, v := range acl {
+		if v.Type == "group" && v.UserOrGroupID == groupID && (!additive || aclManaged[v.Path+"|"+v.RoleID]) {
+			aclEntry := map[string]interface{}{}
+
+			aclEntry[mkResourceVirtualEnvironmentGroupACLPath] = v.Path
+
+			if v.Propagate != nil {
+				aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate] = bool(*v.Propagate)
+			} else {
+				aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate] = false
+			}
+
+			aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID] = v.RoleID
+
+			aclParsed = append(aclParsed, aclEntry)
+		}
+	}
+
+	return aclParsed
+}
+
 func resourceVirtualEnvironmentGroupRead(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
@@ -156,27 +199,12 @@ func resourceVirtualEnvironmentGroupRead(d *schema.ResourceData, m interface{})
 		return err
 	}
 
-	aclParsed := []interface{}{}
-
-	for _, v := range acl {
-		if v.Type == "group" && v.UserOrGroupID == groupID {
-			aclEntry := map[string]interface{}{}
-
-			aclEntry[mkResourceVirtualEnvironmentGroupACLPath] = v.Path
-
-			if v.Propagate != nil {
-				aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate] = bool(*v.Propagate)
-			} else {
-				aclEntry[mkResourceVirtualEnvironmentGroupACLPropagate] = false
-			}
-
-			aclEntry[mkResourceVirtualEnvironmentGroupACLRoleID] = v.RoleID
-
-			aclParsed = append(aclParsed, aclEntry)
-		}
+	// Resources which have been imported lack the mode, in which case the default one applies.
+	if d.Get(mkResourceVirtualEnvironmentGroupACLMode).(string) == "" {
+		d.Set(mkResourceVirtualEnvironmentGroupACLMode, dvResourceVirtualEnvironmentGroupACLMode)
 	}
 
-	d.Set(mkResourceVirtualEnvironmentGroupACL, aclParsed)
+	d.Set(mkResourceVirtualEnvironmentGroupACL, resourceVirtualEnvironmentGroupGetACL(d, acl, groupID))
 
 	if group.Comment != nil {
 		d.Set(mkResourceVirtualEnvironmentGroupComment, group.Comment)
@@ -7,7 +7,9 @@ package proxmoxtf
 import (
 	"testing"
 
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // TestResourceVirtualEnvironmentGroupInstantiation tests whether the ResourceVirtualEnvironmentGroup instance can be instantiated.
@@ -29,6 +31,7 @@ func TestResourceVirtualEnvironmentGroupSchema(t *testing.T) {
 
 	testOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentGroupACL,
+		mkResourceVirtualEnvironmentGroupACLMode,
 		mkResourceVirtualEnvironmentGroupComment,
 	})
 
@@ -38,6 +41,7 @@ func TestResourceVirtualEnvironmentGroupSchema(t *testing.T) {
 
 	testValueTypes(t, s, map[string]schema.ValueType{
 		mkResourceVirtualEnvironmentGroupACL:     schema.TypeSet,
+		mkResourceVirtualEnvironmentGroupACLMode: schema.TypeString,
 		mkResourceVirtualEnvironmentGroupComment: schema.TypeString,
 		mkResourceVirtualEnvironmentGroupID:      schema.TypeString,
 		mkResourceVirtualEnvironmentGroupMembers: schema.TypeSet,
@@ -60,3 +64,48 @@ func TestResourceVirtualEnvironmentGroupSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentGroupACLRoleID:    schema.TypeString,
 	})
 }
+
+// TestResourceVirtualEnvironmentGroupGetACL tests whether the access control list entries are filtered according to the mode.
+func TestResourceVirtualEnvironmentGroupGetACL(t *testing.T) {
+	propagate := proxmox.CustomBool(true)
+	acl := []*proxmox.VirtualEnvironmentACLGetResponseData{
+		{Path: "/vms/1234", Propagate: &propagate, RoleID: "PVEVMUser", Type: "group", UserOrGroupID: "operations"},
+		{Path: "/storage", RoleID: "PVEDatastoreUser", Type: "group", UserOrGroupID: "operations"},
+		{Path: "/vms/1234", RoleID: "PVEVMUser", Type: "user", UserOrGroupID: "operations"},
+		{Path: "/", RoleID: "PVEAuditor", Type: "group", UserOrGroupID: "other"},
+	}
+
+	s := resourceVirtualEnvironmentGroup()
+
+	// Imported resources have an empty state, which must not cause the existing entries to be dropped.
+	d := s.Data(&terraform.InstanceState{ID: "operations"})
+
+	if v := resourceVirtualEnvironmentGroupGetACL(d, acl, "operations"); len(v) != 2 {
+		t.Fatalf("Expected 2 entries for an imported resource but got %d", len(v))
+	}
+
+	d = s.Data(nil)
+	d.Set(mkResourceVirtualEnvironmentGroupACL, []interface{}{
+		map[string]interface{}{
+			mkResourceVirtualEnvironmentGroupACLPath:      "/vms/1234",
+			mkResourceVirtualEnvironmentGroupACLPropagate: true,
+			mkResourceVirtualEnvironmentGroupACLRoleID:    "PVEVMUser",
+		},
+	})
+
+	if v := resourceVirtualEnvironmentGroupGetACL(d, acl, "operations"); len(v) != 2 {
+		t.Fatalf("Expected 2 entries in authoritative mode but got %d", len(v))
+	}
+
+	d.Set(mkResourceVirtualEnvironmentGroupACLMode, "additive")
+
+	v := resourceVirtualEnvironmentGroupGetACL(d, acl, "operations")
+
+	if len(v) != 1 {
+		t.Fatalf("Expected 1 entry in additive mode but got %d", len(v))
+	}
+
+	if v[0].(map[string]interface{})[mkResourceVirtualEnvironmentGroupACLPath] != "/vms/1234" {
+		t.Fatalf("Expected the declared entry in additive mode but got %v", v[0])
+	}
+}
@@ -0,0 +1,269 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentHAGroupComment    = ""
+	dvResourceVirtualEnvironmentHAGroupNoFailback = false
+	dvResourceVirtualEnvironmentHAGroupRestricted = false
+
+	mkResourceVirtualEnvironmentHAGroupComment    = "comment"
+	mkResourceVirtualEnvironmentHAGroupGroupID    = "group_id"
+	mkResourceVirtualEnvironmentHAGroupNoFailback = "no_failback"
+	mkResourceVirtualEnvironmentHAGroupNodes      = "nodes"
+	mkResourceVirtualEnvironmentHAGroupRestricted = "restricted"
+)
+
+func resourceVirtualEnvironmentHAGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentHAGroupComment: {
+				Type:        schema.TypeString,
+				Description: "The group comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentHAGroupComment,
+			},
+			mkResourceVirtualEnvironmentHAGroupGroupID: {
+				Type:        schema.TypeString,
+				Description: "The group id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentHAGroupNoFailback: {
+				Type:        schema.TypeBool,
+				Description: "Whether to keep resources on their current node when a node with a higher priority comes online",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentHAGroupNoFailback,
+			},
+			mkResourceVirtualEnvironmentHAGroupNodes: {
+				Type:        schema.TypeMap,
+				Description: "The member nodes and their priorities",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			mkResourceVirtualEnvironmentHAGroupRestricted: {
+				Type:        schema.TypeBool,
+				Description: "Whether resources are restricted to the member nodes",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentHAGroupRestricted,
+			},
+		},
+		Create: resourceVirtualEnvironmentHAGroupCreate,
+		Read:   resourceVirtualEnvironmentHAGroupRead,
+		Update: resourceVirtualEnvironmentHAGroupUpdate,
+		Delete: resourceVirtualEnvironmentHAGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentHAGroupCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentHAGroupComment).(string)
+	groupID := d.Get(mkResourceVirtualEnvironmentHAGroupGroupID).(string)
+	noFailback := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentHAGroupNoFailback).(bool))
+	restricted := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentHAGroupRestricted).(bool))
+	nodes, err := resourceVirtualEnvironmentHAGroupGetNodes(d)
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentHAGroupCreateRequestBody{
+		ID:         groupID,
+		NoFailback: &noFailback,
+		Nodes:      nodes,
+		Restricted: &restricted,
+		Type:       "group",
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	}
+
+	err = veClient.CreateHAGroup(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(groupID)
+
+	return resourceVirtualEnvironmentHAGroupRead(d, m)
+}
+
+// resourceVirtualEnvironmentHAGroupGetNodes converts the nodes map to the "node:priority" list expected by the API.
+func resourceVirtualEnvironmentHAGroupGetNodes(d *schema.ResourceData) (string, error) {
+	nodes := d.Get(mkResourceVirtualEnvironmentHAGroupNodes).(map[string]interface{})
+
+	if len(nodes) == 0 {
+		return "", fmt.Errorf("The \"%s\" argument must contain at least one node", mkResourceVirtualEnvironmentHAGroupNodes)
+	}
+
+	list := []string{}
+
+	for name, priority := range nodes {
+		p := priority.(int)
+
+		if p < 0 || p > 1000 {
+			return "", fmt.Errorf("The priority of node \"%s\" must be between 0 and 1000", name)
+		}
+
+		list = append(list, fmt.Sprintf("%s:%d", name, p))
+	}
+
+	sort.Strings(list)
+
+	return strings.Join(list, ","), nil
+}
+
+func resourceVirtualEnvironmentHAGroupRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	groupID := d.Id()
+	group, err := veClient.GetHAGroup(groupID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "no such ha group") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	nodes := map[string]interface{}{}
+
+	for _, v := range strings.Split(group.Nodes, ",") {
+		if v == "" {
+			continue
+		}
+
+		parts := strings.SplitN(v, ":", 2)
+		priority := 0
+
+		if len(parts) == 2 {
+			priority, err = strconv.Atoi(parts[1])
+
+			if err != nil {
+				return err
+			}
+		}
+
+		nodes[parts[0]] = priority
+	}
+
+	if group.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentHAGroupComment, strings.TrimSpace(*group.Comment))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAGroupComment, dvResourceVirtualEnvironmentHAGroupComment)
+	}
+
+	if group.NoFailback != nil {
+		d.Set(mkResourceVirtualEnvironmentHAGroupNoFailback, bool(*group.NoFailback))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAGroupNoFailback, dvResourceVirtualEnvironmentHAGroupNoFailback)
+	}
+
+	if group.Restricted != nil {
+		d.Set(mkResourceVirtualEnvironmentHAGroupRestricted, bool(*group.Restricted))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAGroupRestricted, dvResourceVirtualEnvironmentHAGroupRestricted)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentHAGroupGroupID, groupID)
+	d.Set(mkResourceVirtualEnvironmentHAGroupNodes, nodes)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentHAGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentHAGroupComment).(string)
+	groupID := d.Id()
+	noFailback := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentHAGroupNoFailback).(bool))
+	restricted := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentHAGroupRestricted).(bool))
+	nodes, err := resourceVirtualEnvironmentHAGroupGetNodes(d)
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentHAGroupUpdateRequestBody{
+		NoFailback: &noFailback,
+		Nodes:      nodes,
+		Restricted: &restricted,
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	} else {
+		body.Delete = append(body.Delete, "comment")
+	}
+
+	err = veClient.UpdateHAGroup(groupID, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentHAGroupRead(d, m)
+}
+
+func resourceVirtualEnvironmentHAGroupDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteHAGroup(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
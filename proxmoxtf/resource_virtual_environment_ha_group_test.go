@@ -0,0 +1,44 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentHAGroupInstantiation tests whether the ResourceVirtualEnvironmentHAGroup instance can be instantiated.
+func TestResourceVirtualEnvironmentHAGroupInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentHAGroup()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentHAGroup")
+	}
+}
+
+// TestResourceVirtualEnvironmentHAGroupSchema tests the resourceVirtualEnvironmentHAGroup schema.
+func TestResourceVirtualEnvironmentHAGroupSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentHAGroup()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentHAGroupGroupID,
+		mkResourceVirtualEnvironmentHAGroupNodes,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentHAGroupComment,
+		mkResourceVirtualEnvironmentHAGroupNoFailback,
+		mkResourceVirtualEnvironmentHAGroupRestricted,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentHAGroupComment:    schema.TypeString,
+		mkResourceVirtualEnvironmentHAGroupGroupID:    schema.TypeString,
+		mkResourceVirtualEnvironmentHAGroupNoFailback: schema.TypeBool,
+		mkResourceVirtualEnvironmentHAGroupNodes:      schema.TypeMap,
+		mkResourceVirtualEnvironmentHAGroupRestricted: schema.TypeBool,
+	})
+}
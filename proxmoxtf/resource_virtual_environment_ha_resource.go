@@ -0,0 +1,279 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentHAResourceComment     = ""
+	dvResourceVirtualEnvironmentHAResourceGroup       = ""
+	dvResourceVirtualEnvironmentHAResourceMaxRelocate = 1
+	dvResourceVirtualEnvironmentHAResourceMaxRestart  = 1
+	dvResourceVirtualEnvironmentHAResourceState       = "started"
+
+	mkResourceVirtualEnvironmentHAResourceComment     = "comment"
+	mkResourceVirtualEnvironmentHAResourceGroup       = "group"
+	mkResourceVirtualEnvironmentHAResourceMaxRelocate = "max_relocate"
+	mkResourceVirtualEnvironmentHAResourceMaxRestart  = "max_restart"
+	mkResourceVirtualEnvironmentHAResourceResourceID  = "resource_id"
+	mkResourceVirtualEnvironmentHAResourceState       = "state"
+)
+
+func resourceVirtualEnvironmentHAResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentHAResourceComment: {
+				Type:        schema.TypeString,
+				Description: "The resource comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentHAResourceComment,
+			},
+			mkResourceVirtualEnvironmentHAResourceGroup: {
+				Type:        schema.TypeString,
+				Description: "The HA group",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentHAResourceGroup,
+			},
+			mkResourceVirtualEnvironmentHAResourceMaxRelocate: {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of relocation attempts after a failed start",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentHAResourceMaxRelocate,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			mkResourceVirtualEnvironmentHAResourceMaxRestart: {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of restart attempts after a failed start",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentHAResourceMaxRestart,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			mkResourceVirtualEnvironmentHAResourceResourceID: {
+				Type:         schema.TypeString,
+				Description:  "The resource id (vm:<id> or ct:<id>)",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getHAResourceIDValidator(),
+			},
+			mkResourceVirtualEnvironmentHAResourceState: {
+				Type:         schema.TypeString,
+				Description:  "The requested resource state",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentHAResourceState,
+				ValidateFunc: getHAResourceStateValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentHAResourceCreate,
+		Read:   resourceVirtualEnvironmentHAResourceRead,
+		Update: resourceVirtualEnvironmentHAResourceUpdate,
+		Delete: resourceVirtualEnvironmentHAResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentHAResourceCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentHAResourceComment).(string)
+	group := d.Get(mkResourceVirtualEnvironmentHAResourceGroup).(string)
+	maxRelocate := d.Get(mkResourceVirtualEnvironmentHAResourceMaxRelocate).(int)
+	maxRestart := d.Get(mkResourceVirtualEnvironmentHAResourceMaxRestart).(int)
+	resourceID := d.Get(mkResourceVirtualEnvironmentHAResourceResourceID).(string)
+	state := d.Get(mkResourceVirtualEnvironmentHAResourceState).(string)
+
+	body := &proxmox.VirtualEnvironmentHAResourceCreateRequestBody{
+		ID:          resourceID,
+		MaxRelocate: &maxRelocate,
+		MaxRestart:  &maxRestart,
+		State:       &state,
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	}
+
+	if group != "" {
+		body.Group = &group
+	}
+
+	err = veClient.CreateHAResource(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resourceID)
+
+	return resourceVirtualEnvironmentHAResourceRead(d, m)
+}
+
+func resourceVirtualEnvironmentHAResourceRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	resourceID := d.Id()
+	resource, err := veClient.GetHAResource(resourceID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "no such resource") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if resource.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentHAResourceComment, strings.TrimSpace(*resource.Comment))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAResourceComment, dvResourceVirtualEnvironmentHAResourceComment)
+	}
+
+	if resource.Group != nil {
+		d.Set(mkResourceVirtualEnvironmentHAResourceGroup, *resource.Group)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAResourceGroup, dvResourceVirtualEnvironmentHAResourceGroup)
+	}
+
+	if resource.MaxRelocate != nil {
+		d.Set(mkResourceVirtualEnvironmentHAResourceMaxRelocate, int(*resource.MaxRelocate))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAResourceMaxRelocate, dvResourceVirtualEnvironmentHAResourceMaxRelocate)
+	}
+
+	if resource.MaxRestart != nil {
+		d.Set(mkResourceVirtualEnvironmentHAResourceMaxRestart, int(*resource.MaxRestart))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAResourceMaxRestart, dvResourceVirtualEnvironmentHAResourceMaxRestart)
+	}
+
+	if resource.State != nil {
+		d.Set(mkResourceVirtualEnvironmentHAResourceState, *resource.State)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentHAResourceState, dvResourceVirtualEnvironmentHAResourceState)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentHAResourceResourceID, resource.ID)
+
+	return nil
+}
+
+// resourceVirtualEnvironmentHAResourceRemoveGuest removes a guest from the HA configuration, if it is managed by the HA manager.
+func resourceVirtualEnvironmentHAResourceRemoveGuest(veClient *proxmox.VirtualEnvironmentClient, resourceID string) error {
+	resources, err := veClient.ListHAResources(nil)
+
+	if err != nil {
+		// Users without access to the HA configuration cannot have placed the guest under HA management either.
+		if strings.Contains(err.Error(), "HTTP 403") {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, v := range resources {
+		if v.ID != resourceID {
+			continue
+		}
+
+		err = veClient.DeleteHAResource(resourceID)
+
+		if err != nil && !strings.Contains(err.Error(), "HTTP 404") {
+			return err
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentHAResourceUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentHAResourceComment).(string)
+	group := d.Get(mkResourceVirtualEnvironmentHAResourceGroup).(string)
+	maxRelocate := d.Get(mkResourceVirtualEnvironmentHAResourceMaxRelocate).(int)
+	maxRestart := d.Get(mkResourceVirtualEnvironmentHAResourceMaxRestart).(int)
+	state := d.Get(mkResourceVirtualEnvironmentHAResourceState).(string)
+
+	body := &proxmox.VirtualEnvironmentHAResourceUpdateRequestBody{
+		MaxRelocate: &maxRelocate,
+		MaxRestart:  &maxRestart,
+		State:       &state,
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	} else {
+		body.Delete = append(body.Delete, "comment")
+	}
+
+	if group != "" {
+		body.Group = &group
+	} else {
+		body.Delete = append(body.Delete, "group")
+	}
+
+	err = veClient.UpdateHAResource(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentHAResourceRead(d, m)
+}
+
+func resourceVirtualEnvironmentHAResourceDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteHAResource(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "no such resource") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentHAResourceInstantiation tests whether the ResourceVirtualEnvironmentHAResource instance can be instantiated.
+func TestResourceVirtualEnvironmentHAResourceInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentHAResource()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentHAResource")
+	}
+}
+
+// TestResourceVirtualEnvironmentHAResourceSchema tests the resourceVirtualEnvironmentHAResource schema.
+func TestResourceVirtualEnvironmentHAResourceSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentHAResource()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentHAResourceResourceID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentHAResourceComment,
+		mkResourceVirtualEnvironmentHAResourceGroup,
+		mkResourceVirtualEnvironmentHAResourceMaxRelocate,
+		mkResourceVirtualEnvironmentHAResourceMaxRestart,
+		mkResourceVirtualEnvironmentHAResourceState,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentHAResourceComment:     schema.TypeString,
+		mkResourceVirtualEnvironmentHAResourceGroup:       schema.TypeString,
+		mkResourceVirtualEnvironmentHAResourceMaxRelocate: schema.TypeInt,
+		mkResourceVirtualEnvironmentHAResourceMaxRestart:  schema.TypeInt,
+		mkResourceVirtualEnvironmentHAResourceResourceID:  schema.TypeString,
+		mkResourceVirtualEnvironmentHAResourceState:       schema.TypeString,
+	})
+}
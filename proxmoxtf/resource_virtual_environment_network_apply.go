@@ -0,0 +1,89 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentNetworkApplyTimeout = 600
+
+	mkResourceVirtualEnvironmentNetworkApplyNodeName = "node_name"
+	mkResourceVirtualEnvironmentNetworkApplyTriggers = "triggers"
+)
+
+func resourceVirtualEnvironmentNetworkApply() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentNetworkApplyNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkApplyTriggers: {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values, which trigger the pending network configuration to be applied when changed",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: resourceVirtualEnvironmentNetworkApplyCreate,
+		Read:   resourceVirtualEnvironmentNetworkApplyRead,
+		Delete: resourceVirtualEnvironmentNetworkApplyDelete,
+	}
+}
+
+func resourceVirtualEnvironmentNetworkApplyCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkApplyNodeName).(string)
+	err = veClient.ReloadNodeNetwork(nodeName, dvResourceVirtualEnvironmentNetworkApplyTimeout)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(nodeName)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentNetworkApplyRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	changes, err := veClient.GetNodeNetworkChanges(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Pending changes, which were made outside of Terraform or by a failed reload, are handled by recreating the resource.
+	if changes != nil && strings.TrimSpace(*changes) != "" {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentNetworkApplyDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentNetworkApplyInstantiation tests whether the ResourceVirtualEnvironmentNetworkApply instance can be instantiated.
+func TestResourceVirtualEnvironmentNetworkApplyInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkApply()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentNetworkApply")
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkApplySchema tests the resourceVirtualEnvironmentNetworkApply schema.
+func TestResourceVirtualEnvironmentNetworkApplySchema(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkApply()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkApplyNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkApplyTriggers,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentNetworkApplyNodeName: schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkApplyTriggers: schema.TypeMap,
+	})
+}
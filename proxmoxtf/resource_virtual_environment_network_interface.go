@@ -0,0 +1,598 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentNetworkInterfaceAutostart       = true
+	dvResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy  = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceBondMode        = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceBondPrimary     = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware = false
+	dvResourceVirtualEnvironmentNetworkInterfaceCIDR            = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceCIDR6           = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceComment         = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceGateway         = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceGateway6        = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceMTU             = 0
+	dvResourceVirtualEnvironmentNetworkInterfaceOVSBridge       = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceOVSOptions      = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceOVSTag          = 0
+	dvResourceVirtualEnvironmentNetworkInterfaceVLANID          = 0
+	dvResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice   = ""
+
+	mkResourceVirtualEnvironmentNetworkInterfaceAutostart       = "autostart"
+	mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy  = "bond_hash_policy"
+	mkResourceVirtualEnvironmentNetworkInterfaceBondMode        = "bond_mode"
+	mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary     = "bond_primary"
+	mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves      = "bond_slaves"
+	mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts     = "bridge_ports"
+	mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware = "bridge_vlan_aware"
+	mkResourceVirtualEnvironmentNetworkInterfaceCIDR            = "cidr"
+	mkResourceVirtualEnvironmentNetworkInterfaceCIDR6           = "cidr6"
+	mkResourceVirtualEnvironmentNetworkInterfaceComment         = "comment"
+	mkResourceVirtualEnvironmentNetworkInterfaceDigest          = "digest"
+	mkResourceVirtualEnvironmentNetworkInterfaceGateway         = "gateway"
+	mkResourceVirtualEnvironmentNetworkInterfaceGateway6        = "gateway6"
+	mkResourceVirtualEnvironmentNetworkInterfaceMTU             = "mtu"
+	mkResourceVirtualEnvironmentNetworkInterfaceName            = "name"
+	mkResourceVirtualEnvironmentNetworkInterfaceNodeName        = "node_name"
+	mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds        = "ovs_bonds"
+	mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge       = "ovs_bridge"
+	mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions      = "ovs_options"
+	mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts        = "ovs_ports"
+	mkResourceVirtualEnvironmentNetworkInterfaceOVSTag          = "ovs_tag"
+	mkResourceVirtualEnvironmentNetworkInterfaceType            = "type"
+	mkResourceVirtualEnvironmentNetworkInterfaceVLANID          = "vlan_id"
+	mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice   = "vlan_raw_device"
+)
+
+func resourceVirtualEnvironmentNetworkInterface() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentNetworkInterfaceAutostart: {
+				Type:        schema.TypeBool,
+				Description: "Whether to start the interface on boot",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceAutostart,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy: {
+				Type:         schema.TypeString,
+				Description:  "The transmit hash policy for bonds",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy,
+				ValidateFunc: validation.StringInSlice([]string{"", "layer2", "layer2+3", "layer3+4"}, false),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBondMode: {
+				Type:         schema.TypeString,
+				Description:  "The bonding mode",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceBondMode,
+				ValidateFunc: getNetworkInterfaceBondModeValidator(),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary: {
+				Type:        schema.TypeString,
+				Description: "The primary slave for active-backup bonds",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceBondPrimary,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves: {
+				Type:        schema.TypeList,
+				Description: "The bond slaves",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts: {
+				Type:        schema.TypeList,
+				Description: "The bridge ports",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware: {
+				Type:        schema.TypeBool,
+				Description: "Whether the bridge is VLAN aware",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceCIDR: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 address in CIDR notation",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceCIDR,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{""}, false),
+					validation.CIDRNetwork(0, 32),
+				),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceCIDR6: {
+				Type:        schema.TypeString,
+				Description: "The IPv6 address in CIDR notation",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceCIDR6,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{""}, false),
+					validation.CIDRNetwork(0, 128),
+				),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceComment: {
+				Type:        schema.TypeString,
+				Description: "The comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceComment,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceDigest: {
+				Type:        schema.TypeString,
+				Description: "The digest of the configuration",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceGateway: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 gateway",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceGateway,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{""}, false),
+					validation.SingleIP(),
+				),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceGateway6: {
+				Type:        schema.TypeString,
+				Description: "The IPv6 gateway",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceGateway6,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{""}, false),
+					validation.SingleIP(),
+				),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceMTU: {
+				Type:         schema.TypeInt,
+				Description:  "The MTU",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceMTU,
+				ValidateFunc: validation.IntBetween(0, 65520),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceName: {
+				Type:        schema.TypeString,
+				Description: "The interface name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds: {
+				Type:        schema.TypeList,
+				Description: "The interfaces of an OVS bond",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge: {
+				Type:        schema.TypeString,
+				Description: "The OVS bridge for OVS bonds and ports",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceOVSBridge,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions: {
+				Type:        schema.TypeString,
+				Description: "The additional OVS options",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceOVSOptions,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts: {
+				Type:        schema.TypeList,
+				Description: "The ports of an OVS bridge",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceOVSTag: {
+				Type:         schema.TypeInt,
+				Description:  "The VLAN tag for OVS bonds and internal ports",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceOVSTag,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceType: {
+				Type:         schema.TypeString,
+				Description:  "The interface type",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getNetworkInterfaceTypeValidator(),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceVLANID: {
+				Type:         schema.TypeInt,
+				Description:  "The VLAN tag for VLAN interfaces",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceVLANID,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice: {
+				Type:        schema.TypeString,
+				Description: "The parent interface for VLAN interfaces",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice,
+			},
+		},
+		Create: resourceVirtualEnvironmentNetworkInterfaceCreate,
+		Read:   resourceVirtualEnvironmentNetworkInterfaceRead,
+		Update: resourceVirtualEnvironmentNetworkInterfaceUpdate,
+		Delete: resourceVirtualEnvironmentNetworkInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				nodeName, name, err := resourceVirtualEnvironmentNetworkInterfaceParseID(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set(mkResourceVirtualEnvironmentNetworkInterfaceName, name)
+				d.Set(mkResourceVirtualEnvironmentNetworkInterfaceNodeName, nodeName)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+
+	r.CustomizeDiff = getConfigDigestCustomizeDiffFunc(r, mkResourceVirtualEnvironmentNetworkInterfaceDigest)
+
+	return r
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+	updateBody, err := resourceVirtualEnvironmentNetworkInterfaceGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentNodeNetworkDeviceCreateRequestBody{
+		Autostart:          updateBody.Autostart,
+		BondMode:           updateBody.BondMode,
+		BondPrimary:        updateBody.BondPrimary,
+		BondXmitHashPolicy: updateBody.BondXmitHashPolicy,
+		BridgePorts:        updateBody.BridgePorts,
+		BridgeVLANAware:    updateBody.BridgeVLANAware,
+		CIDR:               updateBody.CIDR,
+		CIDR6:              updateBody.CIDR6,
+		Comments:           updateBody.Comments,
+		Gateway:            updateBody.Gateway,
+		Gateway6:           updateBody.Gateway6,
+		Iface:              name,
+		MTU:                updateBody.MTU,
+		OVSBonds:           updateBody.OVSBonds,
+		OVSBridge:          updateBody.OVSBridge,
+		OVSOptions:         updateBody.OVSOptions,
+		OVSPorts:           updateBody.OVSPorts,
+		OVSTag:             updateBody.OVSTag,
+		Slaves:             updateBody.Slaves,
+		Type:               updateBody.Type,
+		VLANID:             updateBody.VLANID,
+		VLANRawDevice:      updateBody.VLANRawDevice,
+	}
+
+	err = veClient.CreateNodeNetworkDevice(nodeName, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", nodeName, name))
+
+	return resourceVirtualEnvironmentNetworkInterfaceRead(d, m)
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceGetUpdateBody(d *schema.ResourceData) (*proxmox.VirtualEnvironmentNodeNetworkDeviceUpdateRequestBody, error) {
+	autostart := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAutostart).(bool))
+	name := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceName).(string)
+	interfaceType := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceType).(string)
+
+	body := &proxmox.VirtualEnvironmentNodeNetworkDeviceUpdateRequestBody{
+		Autostart: &autostart,
+		Type:      interfaceType,
+	}
+
+	// Empty values are removed from the interface configuration instead of being submitted.
+	optionalInt := func(key string, param string) *int {
+		v := d.Get(key).(int)
+
+		if v == 0 {
+			body.Delete = append(body.Delete, param)
+
+			return nil
+		}
+
+		return &v
+	}
+
+	optionalList := func(key string, param string) *string {
+		v := []string{}
+
+		for _, item := range d.Get(key).([]interface{}) {
+			v = append(v, item.(string))
+		}
+
+		if len(v) == 0 {
+			body.Delete = append(body.Delete, param)
+
+			return nil
+		}
+
+		list := strings.Join(v, " ")
+
+		return &list
+	}
+
+	optionalString := func(key string, param string) *string {
+		v := d.Get(key).(string)
+
+		if v == "" {
+			body.Delete = append(body.Delete, param)
+
+			return nil
+		}
+
+		return &v
+	}
+
+	body.CIDR = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceCIDR, "cidr")
+	body.CIDR6 = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceCIDR6, "cidr6")
+	body.Comments = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceComment, "comments")
+	body.Gateway = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceGateway, "gateway")
+	body.Gateway6 = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceGateway6, "gateway6")
+	body.MTU = optionalInt(mkResourceVirtualEnvironmentNetworkInterfaceMTU, "mtu")
+
+	// Only the options, which apply to the interface type, are submitted, as the others would end up in the configuration file.
+	switch interfaceType {
+	case "bond":
+		body.BondMode = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceBondMode, "bond_mode")
+		body.BondPrimary = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary, "bond-primary")
+		body.BondXmitHashPolicy = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy, "bond_xmit_hash_policy")
+		body.Slaves = optionalList(mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves, "slaves")
+
+		if body.Slaves == nil {
+			return nil, fmt.Errorf("The \"%s\" argument must be specified for bonds", mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves)
+		}
+	case "bridge":
+		bridgeVLANAware := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware).(bool))
+
+		body.BridgePorts = optionalList(mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts, "bridge_ports")
+		body.BridgeVLANAware = &bridgeVLANAware
+	case "OVSBond":
+		body.BondMode = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceBondMode, "bond_mode")
+		body.OVSBonds = optionalList(mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds, "ovs_bonds")
+		body.OVSBridge = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge, "ovs_bridge")
+		body.OVSOptions = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions, "ovs_options")
+		body.OVSTag = optionalInt(mkResourceVirtualEnvironmentNetworkInterfaceOVSTag, "ovs_tag")
+
+		if body.OVSBonds == nil || body.OVSBridge == nil {
+			return nil, fmt.Errorf(
+				"The \"%s\" and \"%s\" arguments must be specified for OVS bonds",
+				mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds,
+				mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge,
+			)
+		}
+	case "OVSBridge":
+		body.OVSOptions = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions, "ovs_options")
+		body.OVSPorts = optionalList(mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts, "ovs_ports")
+	case "OVSIntPort", "OVSPort":
+		body.OVSBridge = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge, "ovs_bridge")
+		body.OVSOptions = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions, "ovs_options")
+		body.OVSTag = optionalInt(mkResourceVirtualEnvironmentNetworkInterfaceOVSTag, "ovs_tag")
+
+		if body.OVSBridge == nil {
+			return nil, fmt.Errorf("The \"%s\" argument must be specified for OVS ports", mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge)
+		}
+	case "vlan":
+		body.VLANID = optionalInt(mkResourceVirtualEnvironmentNetworkInterfaceVLANID, "vlan-id")
+		body.VLANRawDevice = optionalString(mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice, "vlan-raw-device")
+
+		// VLAN interfaces named after their parent (e.g. eno1.100) do not require the VLAN tag and the parent interface.
+		if !strings.Contains(name, ".") && (body.VLANID == nil || body.VLANRawDevice == nil) {
+			return nil, fmt.Errorf(
+				"The \"%s\" and \"%s\" arguments must be specified for VLAN interfaces, which are not named after their parent interface",
+				mkResourceVirtualEnvironmentNetworkInterfaceVLANID,
+				mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice,
+			)
+		}
+	}
+
+	return body, nil
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "|", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("The network interface identifier must be of the form \"node|name\"")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName, name, err := resourceVirtualEnvironmentNetworkInterfaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	device, err := veClient.GetNodeNetworkDevice(nodeName, name)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "HTTP 400") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	boolValue := func(v *proxmox.CustomBool) bool {
+		if v != nil {
+			return bool(*v)
+		}
+
+		return false
+	}
+
+	intValue := func(v *proxmox.CustomInt) int {
+		if v != nil {
+			return int(*v)
+		}
+
+		return 0
+	}
+
+	listValue := func(v *string) []interface{} {
+		list := []interface{}{}
+
+		if v != nil {
+			for _, item := range strings.Fields(*v) {
+				list = append(list, item)
+			}
+		}
+
+		return list
+	}
+
+	stringValue := func(v *string) string {
+		if v != nil {
+			return *v
+		}
+
+		return ""
+	}
+
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceAutostart, boolValue(device.Autostart))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy, stringValue(device.BondXmitHashPolicy))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBondMode, stringValue(device.BondMode))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary, stringValue(device.BondPrimary))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves, listValue(device.Slaves))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts, listValue(device.BridgePorts))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware, boolValue(device.BridgeVLANAware))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceCIDR, stringValue(device.CIDR))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceCIDR6, stringValue(device.CIDR6))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceComment, strings.TrimSpace(stringValue(device.Comments)))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceGateway, stringValue(device.Gateway))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceGateway6, stringValue(device.Gateway6))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceMTU, intValue(device.MTU))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceName, name)
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceNodeName, nodeName)
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds, listValue(device.OVSBonds))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge, stringValue(device.OVSBridge))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions, stringValue(device.OVSOptions))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts, listValue(device.OVSPorts))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceOVSTag, intValue(device.OVSTag))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceType, device.Type)
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceVLANID, intValue(device.VLANID))
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice, stringValue(device.VLANRawDevice))
+
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceDigest, getConfigDigest(resourceVirtualEnvironmentNetworkInterface(), d.Get))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName, name, err := resourceVirtualEnvironmentNetworkInterfaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentNetworkInterfaceGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.UpdateNodeNetworkDevice(nodeName, name, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentNetworkInterfaceRead(d, m)
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName, name, err := resourceVirtualEnvironmentNetworkInterfaceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteNodeNetworkDevice(nodeName, name)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,85 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentNetworkInterfaceInstantiation tests whether the ResourceVirtualEnvironmentNetworkInterface instance can be instantiated.
+func TestResourceVirtualEnvironmentNetworkInterfaceInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkInterface()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentNetworkInterface")
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceSchema tests the resourceVirtualEnvironmentNetworkInterface schema.
+func TestResourceVirtualEnvironmentNetworkInterfaceSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkInterface()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceName,
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName,
+		mkResourceVirtualEnvironmentNetworkInterfaceType,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondMode,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR6,
+		mkResourceVirtualEnvironmentNetworkInterfaceComment,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway6,
+		mkResourceVirtualEnvironmentNetworkInterfaceMTU,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSTag,
+		mkResourceVirtualEnvironmentNetworkInterfaceVLANID,
+		mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceDigest,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart:       schema.TypeBool,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondHashPolicy:  schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondMode:        schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondPrimary:     schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceBondSlaves:      schema.TypeList,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts:     schema.TypeList,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgeVLANAware: schema.TypeBool,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:            schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR6:           schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceComment:         schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceDigest:          schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway:         schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway6:        schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceMTU:             schema.TypeInt,
+		mkResourceVirtualEnvironmentNetworkInterfaceName:            schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName:        schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSBonds:        schema.TypeList,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSBridge:       schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSOptions:      schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSPorts:        schema.TypeList,
+		mkResourceVirtualEnvironmentNetworkInterfaceOVSTag:          schema.TypeInt,
+		mkResourceVirtualEnvironmentNetworkInterfaceType:            schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceVLANID:          schema.TypeInt,
+		mkResourceVirtualEnvironmentNetworkInterfaceVLANRawDevice:   schema.TypeString,
+	})
+}
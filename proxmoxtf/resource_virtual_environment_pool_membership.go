@@ -0,0 +1,237 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentPoolMembershipDatastoreID = ""
+	dvResourceVirtualEnvironmentPoolMembershipVMID        = -1
+
+	mkResourceVirtualEnvironmentPoolMembershipDatastoreID = "datastore_id"
+	mkResourceVirtualEnvironmentPoolMembershipNodeName    = "node_name"
+	mkResourceVirtualEnvironmentPoolMembershipPoolID      = "pool_id"
+	mkResourceVirtualEnvironmentPoolMembershipType        = "type"
+	mkResourceVirtualEnvironmentPoolMembershipVMID        = "vm_id"
+)
+
+func resourceVirtualEnvironmentPoolMembership() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentPoolMembershipDatastoreID: {
+				Type:          schema.TypeString,
+				Description:   "The datastore id",
+				Optional:      true,
+				ForceNew:      true,
+				Default:       dvResourceVirtualEnvironmentPoolMembershipDatastoreID,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentPoolMembershipVMID},
+			},
+			mkResourceVirtualEnvironmentPoolMembershipNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentPoolMembershipPoolID: {
+				Type:        schema.TypeString,
+				Description: "The pool id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentPoolMembershipType: {
+				Type:        schema.TypeString,
+				Description: "The member type",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentPoolMembershipVMID: {
+				Type:          schema.TypeInt,
+				Description:   "The virtual machine id",
+				Optional:      true,
+				ForceNew:      true,
+				Default:       dvResourceVirtualEnvironmentPoolMembershipVMID,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentPoolMembershipDatastoreID},
+				ValidateFunc:  getVMIDValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentPoolMembershipCreate,
+		Read:   resourceVirtualEnvironmentPoolMembershipRead,
+		Delete: resourceVirtualEnvironmentPoolMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentPoolMembershipImport,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentPoolMembershipCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentPoolMembershipGetUpdateBody(d, false)
+
+	if err != nil {
+		return err
+	}
+
+	poolID := d.Get(mkResourceVirtualEnvironmentPoolMembershipPoolID).(string)
+	err = veClient.UpdatePool(poolID, body)
+
+	if err != nil {
+		return err
+	}
+
+	if len(body.Storage) > 0 {
+		d.SetId(resourceVirtualEnvironmentPoolMembershipGetID(poolID, "storage", body.Storage[0]))
+	} else {
+		d.SetId(resourceVirtualEnvironmentPoolMembershipGetID(poolID, "vm", body.VMs[0]))
+	}
+
+	return resourceVirtualEnvironmentPoolMembershipRead(d, m)
+}
+
+func resourceVirtualEnvironmentPoolMembershipGetID(poolID, memberType, memberID string) string {
+	return fmt.Sprintf("%s|%s|%s", poolID, memberType, memberID)
+}
+
+func resourceVirtualEnvironmentPoolMembershipGetUpdateBody(d *schema.ResourceData, delete bool) (*proxmox.VirtualEnvironmentPoolUpdateRequestBody, error) {
+	datastoreID := d.Get(mkResourceVirtualEnvironmentPoolMembershipDatastoreID).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentPoolMembershipVMID).(int)
+
+	body := &proxmox.VirtualEnvironmentPoolUpdateRequestBody{}
+
+	if datastoreID != "" {
+		body.Storage = []string{datastoreID}
+	} else if vmID != -1 {
+		body.VMs = []string{strconv.Itoa(vmID)}
+	} else {
+		return nil, fmt.Errorf(
+			"One of the \"%s\" or \"%s\" arguments must be specified",
+			mkResourceVirtualEnvironmentPoolMembershipDatastoreID,
+			mkResourceVirtualEnvironmentPoolMembershipVMID,
+		)
+	}
+
+	if delete {
+		membershipDelete := proxmox.CustomBool(true)
+		body.Delete = &membershipDelete
+	}
+
+	return body, nil
+}
+
+func resourceVirtualEnvironmentPoolMembershipImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return nil, errors.New("The identifier must be of the form \"pool|type|member\" (e.g. \"production|vm|100\")")
+	}
+
+	d.Set(mkResourceVirtualEnvironmentPoolMembershipPoolID, parts[0])
+
+	switch parts[1] {
+	case "storage":
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipDatastoreID, parts[2])
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipVMID, dvResourceVirtualEnvironmentPoolMembershipVMID)
+	case "vm":
+		vmID, err := strconv.Atoi(parts[2])
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid virtual machine id \"%s\"", parts[2])
+		}
+
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipDatastoreID, dvResourceVirtualEnvironmentPoolMembershipDatastoreID)
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipVMID, vmID)
+	default:
+		return nil, fmt.Errorf("Unsupported member type \"%s\" (must be storage or vm)", parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentPoolMembershipRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	datastoreID := d.Get(mkResourceVirtualEnvironmentPoolMembershipDatastoreID).(string)
+	poolID := d.Get(mkResourceVirtualEnvironmentPoolMembershipPoolID).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentPoolMembershipVMID).(int)
+
+	pool, err := veClient.GetPool(poolID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	for _, v := range pool.Members {
+		if datastoreID != "" {
+			if v.Type != "storage" || v.DatastoreID == nil || *v.DatastoreID != datastoreID {
+				continue
+			}
+		} else if v.VMID == nil || *v.VMID != vmID {
+			continue
+		}
+
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipNodeName, v.Node)
+		d.Set(mkResourceVirtualEnvironmentPoolMembershipType, v.Type)
+
+		return nil
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVirtualEnvironmentPoolMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentPoolMembershipGetUpdateBody(d, true)
+
+	if err != nil {
+		return err
+	}
+
+	poolID := d.Get(mkResourceVirtualEnvironmentPoolMembershipPoolID).(string)
+	err = veClient.UpdatePool(poolID, body)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,47 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentPoolMembershipInstantiation tests whether the ResourceVirtualEnvironmentPoolMembership instance can be instantiated.
+func TestResourceVirtualEnvironmentPoolMembershipInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentPoolMembership()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentPoolMembership")
+	}
+}
+
+// TestResourceVirtualEnvironmentPoolMembershipSchema tests the resourceVirtualEnvironmentPoolMembership schema.
+func TestResourceVirtualEnvironmentPoolMembershipSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentPoolMembership()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentPoolMembershipPoolID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentPoolMembershipDatastoreID,
+		mkResourceVirtualEnvironmentPoolMembershipVMID,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentPoolMembershipNodeName,
+		mkResourceVirtualEnvironmentPoolMembershipType,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentPoolMembershipDatastoreID: schema.TypeString,
+		mkResourceVirtualEnvironmentPoolMembershipNodeName:    schema.TypeString,
+		mkResourceVirtualEnvironmentPoolMembershipPoolID:      schema.TypeString,
+		mkResourceVirtualEnvironmentPoolMembershipType:        schema.TypeString,
+		mkResourceVirtualEnvironmentPoolMembershipVMID:        schema.TypeInt,
+	})
+}
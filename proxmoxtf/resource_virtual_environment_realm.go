@@ -0,0 +1,920 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentRealmComment                 = ""
+	dvResourceVirtualEnvironmentRealmDefault                 = false
+	dvResourceVirtualEnvironmentRealmDirectoryBaseDN         = ""
+	dvResourceVirtualEnvironmentRealmDirectoryBindDN         = ""
+	dvResourceVirtualEnvironmentRealmDirectoryBindPassword   = ""
+	dvResourceVirtualEnvironmentRealmDirectoryCAPath         = ""
+	dvResourceVirtualEnvironmentRealmDirectoryFilter         = ""
+	dvResourceVirtualEnvironmentRealmDirectoryGroupClasses   = ""
+	dvResourceVirtualEnvironmentRealmDirectoryGroupDN        = ""
+	dvResourceVirtualEnvironmentRealmDirectoryGroupFilter    = ""
+	dvResourceVirtualEnvironmentRealmDirectoryGroupNameAttr  = ""
+	dvResourceVirtualEnvironmentRealmDirectoryMode           = "ldap"
+	dvResourceVirtualEnvironmentRealmDirectoryPort           = 0
+	dvResourceVirtualEnvironmentRealmDirectoryServer2        = ""
+	dvResourceVirtualEnvironmentRealmDirectorySyncAttributes = ""
+	dvResourceVirtualEnvironmentRealmDirectoryUserClasses    = ""
+	dvResourceVirtualEnvironmentRealmDirectoryVerify         = false
+	dvResourceVirtualEnvironmentRealmOpenIDACRValues         = ""
+	dvResourceVirtualEnvironmentRealmOpenIDAutoCreate        = false
+	dvResourceVirtualEnvironmentRealmOpenIDClientKey         = ""
+	dvResourceVirtualEnvironmentRealmOpenIDPrompt            = ""
+	dvResourceVirtualEnvironmentRealmOpenIDScopes            = "email profile"
+	dvResourceVirtualEnvironmentRealmOpenIDUsernameClaim     = ""
+	dvResourceVirtualEnvironmentRealmSyncEnableNew           = true
+	dvResourceVirtualEnvironmentRealmSyncScope               = "both"
+	dvResourceVirtualEnvironmentRealmSyncTimeout             = 1800
+	dvResourceVirtualEnvironmentRealmSyncTrigger             = ""
+	dvResourceVirtualEnvironmentRealmTFAOATHDigits           = 6
+	dvResourceVirtualEnvironmentRealmTFAOATHStep             = 30
+	dvResourceVirtualEnvironmentRealmTFAYubicoID             = ""
+	dvResourceVirtualEnvironmentRealmTFAYubicoKey            = ""
+	dvResourceVirtualEnvironmentRealmTFAYubicoURL            = ""
+
+	mkResourceVirtualEnvironmentRealmAD                      = "ad"
+	mkResourceVirtualEnvironmentRealmComment                 = "comment"
+	mkResourceVirtualEnvironmentRealmDefault                 = "default"
+	mkResourceVirtualEnvironmentRealmDirectoryBaseDN         = "base_dn"
+	mkResourceVirtualEnvironmentRealmDirectoryBindDN         = "bind_dn"
+	mkResourceVirtualEnvironmentRealmDirectoryBindPassword   = "bind_password"
+	mkResourceVirtualEnvironmentRealmDirectoryCAPath         = "ca_path"
+	mkResourceVirtualEnvironmentRealmDirectoryDomain         = "domain"
+	mkResourceVirtualEnvironmentRealmDirectoryFilter         = "filter"
+	mkResourceVirtualEnvironmentRealmDirectoryGroupClasses   = "group_classes"
+	mkResourceVirtualEnvironmentRealmDirectoryGroupDN        = "group_dn"
+	mkResourceVirtualEnvironmentRealmDirectoryGroupFilter    = "group_filter"
+	mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr  = "group_name_attribute"
+	mkResourceVirtualEnvironmentRealmDirectoryMode           = "mode"
+	mkResourceVirtualEnvironmentRealmDirectoryPort           = "port"
+	mkResourceVirtualEnvironmentRealmDirectoryServer1        = "server1"
+	mkResourceVirtualEnvironmentRealmDirectoryServer2        = "server2"
+	mkResourceVirtualEnvironmentRealmDirectorySyncAttributes = "sync_attributes"
+	mkResourceVirtualEnvironmentRealmDirectoryUserAttr       = "user_attribute"
+	mkResourceVirtualEnvironmentRealmDirectoryUserClasses    = "user_classes"
+	mkResourceVirtualEnvironmentRealmDirectoryVerify         = "verify_certificate"
+	mkResourceVirtualEnvironmentRealmLDAP                    = "ldap"
+	mkResourceVirtualEnvironmentRealmOpenID                  = "openid"
+	mkResourceVirtualEnvironmentRealmOpenIDACRValues         = "acr_values"
+	mkResourceVirtualEnvironmentRealmOpenIDAutoCreate        = "autocreate"
+	mkResourceVirtualEnvironmentRealmOpenIDClientID          = "client_id"
+	mkResourceVirtualEnvironmentRealmOpenIDClientKey         = "client_key"
+	mkResourceVirtualEnvironmentRealmOpenIDIssuerURL         = "issuer_url"
+	mkResourceVirtualEnvironmentRealmOpenIDPrompt            = "prompt"
+	mkResourceVirtualEnvironmentRealmOpenIDScopes            = "scopes"
+	mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim     = "username_claim"
+	mkResourceVirtualEnvironmentRealmRealm                   = "realm"
+	mkResourceVirtualEnvironmentRealmSync                    = "sync"
+	mkResourceVirtualEnvironmentRealmSyncEnableNew           = "enable_new"
+	mkResourceVirtualEnvironmentRealmSyncRemoveVanished      = "remove_vanished"
+	mkResourceVirtualEnvironmentRealmSyncScope               = "scope"
+	mkResourceVirtualEnvironmentRealmSyncTrigger             = "trigger"
+	mkResourceVirtualEnvironmentRealmTFA                     = "tfa"
+	mkResourceVirtualEnvironmentRealmTFAOATHDigits           = "oath_digits"
+	mkResourceVirtualEnvironmentRealmTFAOATHStep             = "oath_step"
+	mkResourceVirtualEnvironmentRealmTFAType                 = "type"
+	mkResourceVirtualEnvironmentRealmTFAYubicoID             = "yubico_id"
+	mkResourceVirtualEnvironmentRealmTFAYubicoKey            = "yubico_key"
+	mkResourceVirtualEnvironmentRealmTFAYubicoURL            = "yubico_url"
+	mkResourceVirtualEnvironmentRealmType                    = "type"
+)
+
+func resourceVirtualEnvironmentRealm() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentRealmAD: {
+				Type:          schema.TypeList,
+				Description:   "The Active Directory settings",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentRealmLDAP, mkResourceVirtualEnvironmentRealmOpenID},
+				Elem: &schema.Resource{
+					Schema: resourceVirtualEnvironmentRealmDirectorySchema(true),
+				},
+			},
+			mkResourceVirtualEnvironmentRealmComment: {
+				Type:        schema.TypeString,
+				Description: "The realm comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentRealmComment,
+			},
+			mkResourceVirtualEnvironmentRealmDefault: {
+				Type:        schema.TypeBool,
+				Description: "Whether to use this realm as the default realm",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentRealmDefault,
+			},
+			mkResourceVirtualEnvironmentRealmLDAP: {
+				Type:          schema.TypeList,
+				Description:   "The LDAP settings",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentRealmAD, mkResourceVirtualEnvironmentRealmOpenID},
+				Elem: &schema.Resource{
+					Schema: resourceVirtualEnvironmentRealmDirectorySchema(false),
+				},
+			},
+			mkResourceVirtualEnvironmentRealmOpenID: {
+				Type:          schema.TypeList,
+				Description:   "The OpenID Connect settings",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentRealmAD, mkResourceVirtualEnvironmentRealmLDAP},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentRealmOpenIDACRValues: {
+							Type:        schema.TypeString,
+							Description: "The authentication context class reference values",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDACRValues,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDAutoCreate: {
+							Type:        schema.TypeBool,
+							Description: "Whether to automatically create users",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDAutoCreate,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDClientID: {
+							Type:        schema.TypeString,
+							Description: "The client id",
+							Required:    true,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDClientKey: {
+							Type:        schema.TypeString,
+							Description: "The client key",
+							Optional:    true,
+							Sensitive:   true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDClientKey,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDIssuerURL: {
+							Type:        schema.TypeString,
+							Description: "The issuer URL",
+							Required:    true,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDPrompt: {
+							Type:        schema.TypeString,
+							Description: "The prompt to show when authenticating",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDPrompt,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDScopes: {
+							Type:        schema.TypeString,
+							Description: "The space separated list of scopes",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDScopes,
+						},
+						mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim: {
+							Type:        schema.TypeString,
+							Description: "The claim to use as the username",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentRealmOpenIDUsernameClaim,
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentRealmRealm: {
+				Type:        schema.TypeString,
+				Description: "The realm id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentRealmSync: {
+				Type:        schema.TypeList,
+				Description: "The synchronization settings",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentRealmSyncEnableNew: {
+							Type:        schema.TypeBool,
+							Description: "Whether to enable new users",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmSyncEnableNew,
+						},
+						mkResourceVirtualEnvironmentRealmSyncRemoveVanished: {
+							Type:        schema.TypeList,
+							Description: "The properties to remove when users and groups vanish",
+							Optional:    true,
+							DefaultFunc: func() (interface{}, error) {
+								return []interface{}{}, nil
+							},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: getRealmSyncRemoveVanishedValidator(),
+							},
+						},
+						mkResourceVirtualEnvironmentRealmSyncScope: {
+							Type:         schema.TypeString,
+							Description:  "The synchronization scope",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentRealmSyncScope,
+							ValidateFunc: getRealmSyncScopeValidator(),
+						},
+						mkResourceVirtualEnvironmentRealmSyncTrigger: {
+							Type:        schema.TypeString,
+							Description: "An arbitrary value, which triggers a synchronization when changed",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmSyncTrigger,
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentRealmTFA: {
+				Type:        schema.TypeList,
+				Description: "The two-factor authentication settings",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentRealmTFAOATHDigits: {
+							Type:         schema.TypeInt,
+							Description:  "The number of digits in OATH tokens",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentRealmTFAOATHDigits,
+							ValidateFunc: validation.IntBetween(6, 8),
+						},
+						mkResourceVirtualEnvironmentRealmTFAOATHStep: {
+							Type:         schema.TypeInt,
+							Description:  "The OATH time step in seconds",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentRealmTFAOATHStep,
+							ValidateFunc: validation.IntAtLeast(10),
+						},
+						mkResourceVirtualEnvironmentRealmTFAType: {
+							Type:         schema.TypeString,
+							Description:  "The two-factor authentication type",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"oath", "yubico"}, false),
+						},
+						mkResourceVirtualEnvironmentRealmTFAYubicoID: {
+							Type:        schema.TypeString,
+							Description: "The Yubico API id",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmTFAYubicoID,
+						},
+						mkResourceVirtualEnvironmentRealmTFAYubicoKey: {
+							Type:        schema.TypeString,
+							Description: "The Yubico API key",
+							Optional:    true,
+							Sensitive:   true,
+							Default:     dvResourceVirtualEnvironmentRealmTFAYubicoKey,
+						},
+						mkResourceVirtualEnvironmentRealmTFAYubicoURL: {
+							Type:        schema.TypeString,
+							Description: "The Yubico API URL",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentRealmTFAYubicoURL,
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentRealmType: {
+				Type:        schema.TypeString,
+				Description: "The realm type",
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: resourceVirtualEnvironmentRealmCustomizeDiff,
+		Create:        resourceVirtualEnvironmentRealmCreate,
+		Read:          resourceVirtualEnvironmentRealmRead,
+		Update:        resourceVirtualEnvironmentRealmUpdate,
+		Delete:        resourceVirtualEnvironmentRealmDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentRealmCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, _, err := resourceVirtualEnvironmentRealmGetRequestBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.CreateRealm(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(body.ID)
+
+	err = resourceVirtualEnvironmentRealmSync(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentRealmRead(d, m)
+}
+
+func resourceVirtualEnvironmentRealmCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	realmType := resourceVirtualEnvironmentRealmGetType(d)
+	oldRealmType := d.Get(mkResourceVirtualEnvironmentRealmType).(string)
+
+	if realmType == oldRealmType {
+		return nil
+	}
+
+	err := d.SetNew(mkResourceVirtualEnvironmentRealmType, realmType)
+
+	if err != nil {
+		return err
+	}
+
+	if d.Id() != "" {
+		return d.ForceNew(mkResourceVirtualEnvironmentRealmType)
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentRealmDirectorySchema(ad bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		mkResourceVirtualEnvironmentRealmDirectoryBaseDN: {
+			Type:        schema.TypeString,
+			Description: "The base DN",
+			Optional:    ad,
+			Required:    !ad,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryBindDN: {
+			Type:        schema.TypeString,
+			Description: "The bind DN",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryBindDN,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryBindPassword: {
+			Type:        schema.TypeString,
+			Description: "The bind password",
+			Optional:    true,
+			Sensitive:   true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryBindPassword,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryCAPath: {
+			Type:        schema.TypeString,
+			Description: "The path to the CA certificate store",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryCAPath,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryFilter: {
+			Type:        schema.TypeString,
+			Description: "The LDAP filter for user synchronization",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryFilter,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryGroupClasses: {
+			Type:        schema.TypeString,
+			Description: "The comma separated list of object classes for groups",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryGroupClasses,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryGroupDN: {
+			Type:        schema.TypeString,
+			Description: "The base DN for groups",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryGroupDN,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryGroupFilter: {
+			Type:        schema.TypeString,
+			Description: "The LDAP filter for group synchronization",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryGroupFilter,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr: {
+			Type:        schema.TypeString,
+			Description: "The LDAP attribute representing a group's name",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryGroupNameAttr,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryMode: {
+			Type:         schema.TypeString,
+			Description:  "The connection mode",
+			Optional:     true,
+			Default:      dvResourceVirtualEnvironmentRealmDirectoryMode,
+			ValidateFunc: getRealmDirectoryModeValidator(),
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryPort: {
+			Type:         schema.TypeInt,
+			Description:  "The server port",
+			Optional:     true,
+			Default:      dvResourceVirtualEnvironmentRealmDirectoryPort,
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryServer1: {
+			Type:        schema.TypeString,
+			Description: "The server address",
+			Required:    true,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryServer2: {
+			Type:        schema.TypeString,
+			Description: "The fallback server address",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryServer2,
+		},
+		mkResourceVirtualEnvironmentRealmDirectorySyncAttributes: {
+			Type:        schema.TypeString,
+			Description: "The comma separated list of key=value pairs for mapping LDAP attributes to user properties",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectorySyncAttributes,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryUserClasses: {
+			Type:        schema.TypeString,
+			Description: "The comma separated list of object classes for users",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryUserClasses,
+		},
+		mkResourceVirtualEnvironmentRealmDirectoryVerify: {
+			Type:        schema.TypeBool,
+			Description: "Whether to verify the server's certificate",
+			Optional:    true,
+			Default:     dvResourceVirtualEnvironmentRealmDirectoryVerify,
+		},
+	}
+
+	if ad {
+		s[mkResourceVirtualEnvironmentRealmDirectoryBaseDN].Default = dvResourceVirtualEnvironmentRealmDirectoryBaseDN
+		s[mkResourceVirtualEnvironmentRealmDirectoryDomain] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The domain name",
+			Required:    true,
+		}
+	} else {
+		s[mkResourceVirtualEnvironmentRealmDirectoryUserAttr] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The LDAP attribute representing a user's name",
+			Required:    true,
+		}
+	}
+
+	return s
+}
+
+func resourceVirtualEnvironmentRealmGetRequestBody(d *schema.ResourceData) (*proxmox.VirtualEnvironmentRealmCreateRequestBody, []string, error) {
+	comment := d.Get(mkResourceVirtualEnvironmentRealmComment).(string)
+	isDefault := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentRealmDefault).(bool))
+
+	body := &proxmox.VirtualEnvironmentRealmCreateRequestBody{
+		Comment: &comment,
+		Default: &isDefault,
+		ID:      d.Get(mkResourceVirtualEnvironmentRealmRealm).(string),
+		Type:    resourceVirtualEnvironmentRealmGetType(d),
+	}
+
+	del := []string{}
+
+	// Empty strings are removed from the realm configuration instead of being submitted.
+	optionalString := func(block map[string]interface{}, key string, param string) *string {
+		v, ok := block[key].(string)
+
+		if !ok || v == "" {
+			del = append(del, param)
+
+			return nil
+		}
+
+		return &v
+	}
+
+	switch body.Type {
+	case "ad", "ldap":
+		block := d.Get(body.Type).([]interface{})[0].(map[string]interface{})
+		port := block[mkResourceVirtualEnvironmentRealmDirectoryPort].(int)
+		verify := proxmox.CustomBool(block[mkResourceVirtualEnvironmentRealmDirectoryVerify].(bool))
+
+		body.BaseDN = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryBaseDN, "base_dn")
+		body.BindDN = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryBindDN, "bind_dn")
+		body.CAPath = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryCAPath, "capath")
+		body.Filter = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryFilter, "filter")
+		body.GroupClasses = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryGroupClasses, "group_classes")
+		body.GroupDN = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryGroupDN, "group_dn")
+		body.GroupFilter = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryGroupFilter, "group_filter")
+		body.GroupNameAttribute = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr, "group_name_attr")
+		body.Mode = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryMode, "mode")
+		body.Server1 = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryServer1, "server1")
+		body.Server2 = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryServer2, "server2")
+		body.SyncAttributes = optionalString(block, mkResourceVirtualEnvironmentRealmDirectorySyncAttributes, "sync_attributes")
+		body.UserClasses = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryUserClasses, "user_classes")
+		body.VerifyCertificate = &verify
+
+		if body.Type == "ad" {
+			body.Domain = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryDomain, "domain")
+		} else {
+			body.UserAttribute = optionalString(block, mkResourceVirtualEnvironmentRealmDirectoryUserAttr, "user_attr")
+		}
+
+		if password := block[mkResourceVirtualEnvironmentRealmDirectoryBindPassword].(string); password != "" {
+			body.Password = &password
+		} else {
+			del = append(del, "password")
+		}
+
+		if port > 0 {
+			body.Port = &port
+		} else {
+			del = append(del, "port")
+		}
+	case "openid":
+		block := d.Get(body.Type).([]interface{})[0].(map[string]interface{})
+		autoCreate := proxmox.CustomBool(block[mkResourceVirtualEnvironmentRealmOpenIDAutoCreate].(bool))
+
+		body.ACRValues = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDACRValues, "acr-values")
+		body.AutoCreate = &autoCreate
+		body.ClientID = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDClientID, "client-id")
+		body.ClientKey = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDClientKey, "client-key")
+		body.IssuerURL = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDIssuerURL, "issuer-url")
+		body.Prompt = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDPrompt, "prompt")
+		body.Scopes = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDScopes, "scopes")
+		body.UsernameClaim = optionalString(block, mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim, "username-claim")
+	default:
+		return nil, nil, fmt.Errorf(
+			"One of the \"%s\", \"%s\" or \"%s\" blocks must be specified",
+			mkResourceVirtualEnvironmentRealmAD,
+			mkResourceVirtualEnvironmentRealmLDAP,
+			mkResourceVirtualEnvironmentRealmOpenID,
+		)
+	}
+
+	tfa := d.Get(mkResourceVirtualEnvironmentRealmTFA).([]interface{})
+
+	if len(tfa) > 0 {
+		tfaBlock := tfa[0].(map[string]interface{})
+		tfaType := tfaBlock[mkResourceVirtualEnvironmentRealmTFAType].(string)
+		tfaOptions := []string{fmt.Sprintf("type=%s", tfaType)}
+
+		if tfaType == "oath" {
+			tfaOptions = append(
+				tfaOptions,
+				fmt.Sprintf("digits=%d", tfaBlock[mkResourceVirtualEnvironmentRealmTFAOATHDigits].(int)),
+				fmt.Sprintf("step=%d", tfaBlock[mkResourceVirtualEnvironmentRealmTFAOATHStep].(int)),
+			)
+		} else {
+			tfaOptions = append(
+				tfaOptions,
+				fmt.Sprintf("id=%s", tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoID].(string)),
+				fmt.Sprintf("key=%s", tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoKey].(string)),
+			)
+
+			if url := tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoURL].(string); url != "" {
+				tfaOptions = append(tfaOptions, fmt.Sprintf("url=%s", url))
+			}
+		}
+
+		tfaValue := strings.Join(tfaOptions, ",")
+		body.TFA = &tfaValue
+	} else {
+		del = append(del, "tfa")
+	}
+
+	return body, del, nil
+}
+
+func resourceVirtualEnvironmentRealmGetType(d interface {
+	Get(string) interface{}
+}) string {
+	for _, v := range []string{
+		mkResourceVirtualEnvironmentRealmAD,
+		mkResourceVirtualEnvironmentRealmLDAP,
+		mkResourceVirtualEnvironmentRealmOpenID,
+	} {
+		if len(d.Get(v).([]interface{})) > 0 {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func resourceVirtualEnvironmentRealmRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	realm, err := veClient.GetRealm(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentRealmRealm, d.Id())
+	d.Set(mkResourceVirtualEnvironmentRealmType, realm.Type)
+
+	if realm.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentRealmComment, realm.Comment)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentRealmComment, "")
+	}
+
+	if realm.Default != nil {
+		d.Set(mkResourceVirtualEnvironmentRealmDefault, bool(*realm.Default))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentRealmDefault, false)
+	}
+
+	stringValue := func(v *string) string {
+		if v != nil {
+			return *v
+		}
+
+		return ""
+	}
+
+	boolValue := func(v *proxmox.CustomBool) bool {
+		if v != nil {
+			return bool(*v)
+		}
+
+		return false
+	}
+
+	switch realm.Type {
+	case "ad", "ldap":
+		block := map[string]interface{}{}
+		currentBlock := d.Get(realm.Type).([]interface{})
+
+		// The bind password is never returned by the API, which is why the current value must be retained.
+		if len(currentBlock) > 0 {
+			block[mkResourceVirtualEnvironmentRealmDirectoryBindPassword] = currentBlock[0].(map[string]interface{})[mkResourceVirtualEnvironmentRealmDirectoryBindPassword]
+		} else {
+			block[mkResourceVirtualEnvironmentRealmDirectoryBindPassword] = dvResourceVirtualEnvironmentRealmDirectoryBindPassword
+		}
+
+		block[mkResourceVirtualEnvironmentRealmDirectoryBaseDN] = stringValue(realm.BaseDN)
+		block[mkResourceVirtualEnvironmentRealmDirectoryBindDN] = stringValue(realm.BindDN)
+		block[mkResourceVirtualEnvironmentRealmDirectoryCAPath] = stringValue(realm.CAPath)
+		block[mkResourceVirtualEnvironmentRealmDirectoryFilter] = stringValue(realm.Filter)
+		block[mkResourceVirtualEnvironmentRealmDirectoryGroupClasses] = stringValue(realm.GroupClasses)
+		block[mkResourceVirtualEnvironmentRealmDirectoryGroupDN] = stringValue(realm.GroupDN)
+		block[mkResourceVirtualEnvironmentRealmDirectoryGroupFilter] = stringValue(realm.GroupFilter)
+		block[mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr] = stringValue(realm.GroupNameAttribute)
+
+		if realm.Mode != nil {
+			block[mkResourceVirtualEnvironmentRealmDirectoryMode] = *realm.Mode
+		} else {
+			block[mkResourceVirtualEnvironmentRealmDirectoryMode] = dvResourceVirtualEnvironmentRealmDirectoryMode
+		}
+
+		if realm.Port != nil {
+			block[mkResourceVirtualEnvironmentRealmDirectoryPort] = int(*realm.Port)
+		} else {
+			block[mkResourceVirtualEnvironmentRealmDirectoryPort] = dvResourceVirtualEnvironmentRealmDirectoryPort
+		}
+
+		block[mkResourceVirtualEnvironmentRealmDirectoryServer1] = stringValue(realm.Server1)
+		block[mkResourceVirtualEnvironmentRealmDirectoryServer2] = stringValue(realm.Server2)
+		block[mkResourceVirtualEnvironmentRealmDirectorySyncAttributes] = stringValue(realm.SyncAttributes)
+		block[mkResourceVirtualEnvironmentRealmDirectoryUserClasses] = stringValue(realm.UserClasses)
+		block[mkResourceVirtualEnvironmentRealmDirectoryVerify] = boolValue(realm.VerifyCertificate)
+
+		if realm.Type == "ad" {
+			block[mkResourceVirtualEnvironmentRealmDirectoryDomain] = stringValue(realm.Domain)
+		} else {
+			block[mkResourceVirtualEnvironmentRealmDirectoryUserAttr] = stringValue(realm.UserAttribute)
+		}
+
+		d.Set(realm.Type, []interface{}{block})
+	case "openid":
+		block := map[string]interface{}{}
+		currentBlock := d.Get(realm.Type).([]interface{})
+
+		// The client key is never returned by the API, which is why the current value must be retained.
+		if len(currentBlock) > 0 {
+			block[mkResourceVirtualEnvironmentRealmOpenIDClientKey] = currentBlock[0].(map[string]interface{})[mkResourceVirtualEnvironmentRealmOpenIDClientKey]
+		} else {
+			block[mkResourceVirtualEnvironmentRealmOpenIDClientKey] = dvResourceVirtualEnvironmentRealmOpenIDClientKey
+		}
+
+		block[mkResourceVirtualEnvironmentRealmOpenIDACRValues] = stringValue(realm.ACRValues)
+		block[mkResourceVirtualEnvironmentRealmOpenIDAutoCreate] = boolValue(realm.AutoCreate)
+		block[mkResourceVirtualEnvironmentRealmOpenIDClientID] = stringValue(realm.ClientID)
+		block[mkResourceVirtualEnvironmentRealmOpenIDIssuerURL] = stringValue(realm.IssuerURL)
+		block[mkResourceVirtualEnvironmentRealmOpenIDPrompt] = stringValue(realm.Prompt)
+
+		if realm.Scopes != nil {
+			block[mkResourceVirtualEnvironmentRealmOpenIDScopes] = *realm.Scopes
+		} else {
+			block[mkResourceVirtualEnvironmentRealmOpenIDScopes] = dvResourceVirtualEnvironmentRealmOpenIDScopes
+		}
+
+		block[mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim] = stringValue(realm.UsernameClaim)
+
+		d.Set(realm.Type, []interface{}{block})
+	}
+
+	if realm.TFA != nil && *realm.TFA != "" {
+		tfaBlock := map[string]interface{}{
+			mkResourceVirtualEnvironmentRealmTFAOATHDigits: dvResourceVirtualEnvironmentRealmTFAOATHDigits,
+			mkResourceVirtualEnvironmentRealmTFAOATHStep:   dvResourceVirtualEnvironmentRealmTFAOATHStep,
+			mkResourceVirtualEnvironmentRealmTFAYubicoID:   dvResourceVirtualEnvironmentRealmTFAYubicoID,
+			mkResourceVirtualEnvironmentRealmTFAYubicoKey:  dvResourceVirtualEnvironmentRealmTFAYubicoKey,
+			mkResourceVirtualEnvironmentRealmTFAYubicoURL:  dvResourceVirtualEnvironmentRealmTFAYubicoURL,
+		}
+
+		for _, option := range strings.Split(*realm.TFA, ",") {
+			kv := strings.SplitN(option, "=", 2)
+
+			if len(kv) != 2 {
+				continue
+			}
+
+			switch kv[0] {
+			case "digits":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAOATHDigits], _ = strconv.Atoi(kv[1])
+			case "id":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoID] = kv[1]
+			case "key":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoKey] = kv[1]
+			case "step":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAOATHStep], _ = strconv.Atoi(kv[1])
+			case "type":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAType] = kv[1]
+			case "url":
+				tfaBlock[mkResourceVirtualEnvironmentRealmTFAYubicoURL] = kv[1]
+			}
+		}
+
+		d.Set(mkResourceVirtualEnvironmentRealmTFA, []interface{}{tfaBlock})
+	} else {
+		d.Set(mkResourceVirtualEnvironmentRealmTFA, []interface{}{})
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentRealmSync(d *schema.ResourceData, m interface{}) error {
+	sync := d.Get(mkResourceVirtualEnvironmentRealmSync).([]interface{})
+
+	if len(sync) == 0 {
+		return nil
+	}
+
+	realmType := resourceVirtualEnvironmentRealmGetType(d)
+
+	if realmType != "ad" && realmType != "ldap" {
+		return fmt.Errorf("The \"%s\" block is only supported for Active Directory and LDAP realms", mkResourceVirtualEnvironmentRealmSync)
+	}
+
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	syncBlock := sync[0].(map[string]interface{})
+	enableNew := proxmox.CustomBool(syncBlock[mkResourceVirtualEnvironmentRealmSyncEnableNew].(bool))
+	scope := syncBlock[mkResourceVirtualEnvironmentRealmSyncScope].(string)
+
+	body := &proxmox.VirtualEnvironmentRealmSyncRequestBody{
+		EnableNew: &enableNew,
+		Scope:     &scope,
+	}
+
+	removeVanished := []string{}
+
+	for _, v := range syncBlock[mkResourceVirtualEnvironmentRealmSyncRemoveVanished].([]interface{}) {
+		removeVanished = append(removeVanished, v.(string))
+	}
+
+	sort.Strings(removeVanished)
+
+	if len(removeVanished) > 0 {
+		removeVanishedValue := strings.Join(removeVanished, ";")
+		body.RemoveVanished = &removeVanishedValue
+	} else {
+		removeVanishedValue := "none"
+		body.RemoveVanished = &removeVanishedValue
+	}
+
+	return veClient.SyncRealm(d.Id(), dvResourceVirtualEnvironmentRealmSyncTimeout, body)
+}
+
+func resourceVirtualEnvironmentRealmUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, del, err := resourceVirtualEnvironmentRealmGetRequestBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	updateBody := &proxmox.VirtualEnvironmentRealmUpdateRequestBody{
+		ACRValues:           body.ACRValues,
+		AutoCreate:          body.AutoCreate,
+		BaseDN:              body.BaseDN,
+		BindDN:              body.BindDN,
+		CAPath:              body.CAPath,
+		CaseSensitive:       body.CaseSensitive,
+		ClientID:            body.ClientID,
+		ClientKey:           body.ClientKey,
+		Comment:             body.Comment,
+		Default:             body.Default,
+		Domain:              body.Domain,
+		Filter:              body.Filter,
+		GroupClasses:        body.GroupClasses,
+		GroupDN:             body.GroupDN,
+		GroupFilter:         body.GroupFilter,
+		GroupNameAttribute:  body.GroupNameAttribute,
+		IssuerURL:           body.IssuerURL,
+		Mode:                body.Mode,
+		Password:            body.Password,
+		Port:                body.Port,
+		Prompt:              body.Prompt,
+		Scopes:              body.Scopes,
+		Server1:             body.Server1,
+		Server2:             body.Server2,
+		SyncAttributes:      body.SyncAttributes,
+		SyncDefaultsOptions: body.SyncDefaultsOptions,
+		TFA:                 body.TFA,
+		UserAttribute:       body.UserAttribute,
+		UserClasses:         body.UserClasses,
+		VerifyCertificate:   body.VerifyCertificate,
+	}
+
+	// The username claim cannot be modified, which is why it must never be deleted.
+	for _, v := range del {
+		if v != "username-claim" {
+			updateBody.Delete = append(updateBody.Delete, v)
+		}
+	}
+
+	err = veClient.UpdateRealm(d.Id(), updateBody)
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange(mkResourceVirtualEnvironmentRealmSync) {
+		err = resourceVirtualEnvironmentRealmSync(d, m)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceVirtualEnvironmentRealmRead(d, m)
+}
+
+func resourceVirtualEnvironmentRealmDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteRealm(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
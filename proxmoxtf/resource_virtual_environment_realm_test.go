@@ -0,0 +1,174 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentRealmInstantiation tests whether the ResourceVirtualEnvironmentRealm instance can be instantiated.
+func TestResourceVirtualEnvironmentRealmInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentRealm()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentRealm")
+	}
+}
+
+// TestResourceVirtualEnvironmentRealmSchema tests the resourceVirtualEnvironmentRealm schema.
+func TestResourceVirtualEnvironmentRealmSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentRealm()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentRealmRealm,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentRealmAD,
+		mkResourceVirtualEnvironmentRealmComment,
+		mkResourceVirtualEnvironmentRealmDefault,
+		mkResourceVirtualEnvironmentRealmLDAP,
+		mkResourceVirtualEnvironmentRealmOpenID,
+		mkResourceVirtualEnvironmentRealmSync,
+		mkResourceVirtualEnvironmentRealmTFA,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentRealmType,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentRealmAD:      schema.TypeList,
+		mkResourceVirtualEnvironmentRealmComment: schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDefault: schema.TypeBool,
+		mkResourceVirtualEnvironmentRealmLDAP:    schema.TypeList,
+		mkResourceVirtualEnvironmentRealmOpenID:  schema.TypeList,
+		mkResourceVirtualEnvironmentRealmRealm:   schema.TypeString,
+		mkResourceVirtualEnvironmentRealmSync:    schema.TypeList,
+		mkResourceVirtualEnvironmentRealmTFA:     schema.TypeList,
+		mkResourceVirtualEnvironmentRealmType:    schema.TypeString,
+	})
+
+	adSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentRealmAD)
+
+	testRequiredArguments(t, adSchema, []string{
+		mkResourceVirtualEnvironmentRealmDirectoryDomain,
+		mkResourceVirtualEnvironmentRealmDirectoryServer1,
+	})
+
+	testOptionalArguments(t, adSchema, []string{
+		mkResourceVirtualEnvironmentRealmDirectoryBaseDN,
+		mkResourceVirtualEnvironmentRealmDirectoryBindDN,
+		mkResourceVirtualEnvironmentRealmDirectoryBindPassword,
+		mkResourceVirtualEnvironmentRealmDirectoryCAPath,
+		mkResourceVirtualEnvironmentRealmDirectoryFilter,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupClasses,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupDN,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupFilter,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr,
+		mkResourceVirtualEnvironmentRealmDirectoryMode,
+		mkResourceVirtualEnvironmentRealmDirectoryPort,
+		mkResourceVirtualEnvironmentRealmDirectoryServer2,
+		mkResourceVirtualEnvironmentRealmDirectorySyncAttributes,
+		mkResourceVirtualEnvironmentRealmDirectoryUserClasses,
+		mkResourceVirtualEnvironmentRealmDirectoryVerify,
+	})
+
+	ldapSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentRealmLDAP)
+
+	testRequiredArguments(t, ldapSchema, []string{
+		mkResourceVirtualEnvironmentRealmDirectoryBaseDN,
+		mkResourceVirtualEnvironmentRealmDirectoryServer1,
+		mkResourceVirtualEnvironmentRealmDirectoryUserAttr,
+	})
+
+	testValueTypes(t, ldapSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentRealmDirectoryBaseDN:         schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryBindDN:         schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryBindPassword:   schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryCAPath:         schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryFilter:         schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupClasses:   schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupDN:        schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupFilter:    schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryGroupNameAttr:  schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryMode:           schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryPort:           schema.TypeInt,
+		mkResourceVirtualEnvironmentRealmDirectoryServer1:        schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryServer2:        schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectorySyncAttributes: schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryUserAttr:       schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryUserClasses:    schema.TypeString,
+		mkResourceVirtualEnvironmentRealmDirectoryVerify:         schema.TypeBool,
+	})
+
+	openIDSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentRealmOpenID)
+
+	testRequiredArguments(t, openIDSchema, []string{
+		mkResourceVirtualEnvironmentRealmOpenIDClientID,
+		mkResourceVirtualEnvironmentRealmOpenIDIssuerURL,
+	})
+
+	testOptionalArguments(t, openIDSchema, []string{
+		mkResourceVirtualEnvironmentRealmOpenIDACRValues,
+		mkResourceVirtualEnvironmentRealmOpenIDAutoCreate,
+		mkResourceVirtualEnvironmentRealmOpenIDClientKey,
+		mkResourceVirtualEnvironmentRealmOpenIDPrompt,
+		mkResourceVirtualEnvironmentRealmOpenIDScopes,
+		mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim,
+	})
+
+	testValueTypes(t, openIDSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentRealmOpenIDACRValues:     schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDAutoCreate:    schema.TypeBool,
+		mkResourceVirtualEnvironmentRealmOpenIDClientID:      schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDClientKey:     schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDIssuerURL:     schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDPrompt:        schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDScopes:        schema.TypeString,
+		mkResourceVirtualEnvironmentRealmOpenIDUsernameClaim: schema.TypeString,
+	})
+
+	syncSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentRealmSync)
+
+	testOptionalArguments(t, syncSchema, []string{
+		mkResourceVirtualEnvironmentRealmSyncEnableNew,
+		mkResourceVirtualEnvironmentRealmSyncRemoveVanished,
+		mkResourceVirtualEnvironmentRealmSyncScope,
+		mkResourceVirtualEnvironmentRealmSyncTrigger,
+	})
+
+	testValueTypes(t, syncSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentRealmSyncEnableNew:      schema.TypeBool,
+		mkResourceVirtualEnvironmentRealmSyncRemoveVanished: schema.TypeList,
+		mkResourceVirtualEnvironmentRealmSyncScope:          schema.TypeString,
+		mkResourceVirtualEnvironmentRealmSyncTrigger:        schema.TypeString,
+	})
+
+	tfaSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentRealmTFA)
+
+	testRequiredArguments(t, tfaSchema, []string{
+		mkResourceVirtualEnvironmentRealmTFAType,
+	})
+
+	testOptionalArguments(t, tfaSchema, []string{
+		mkResourceVirtualEnvironmentRealmTFAOATHDigits,
+		mkResourceVirtualEnvironmentRealmTFAOATHStep,
+		mkResourceVirtualEnvironmentRealmTFAYubicoID,
+		mkResourceVirtualEnvironmentRealmTFAYubicoKey,
+		mkResourceVirtualEnvironmentRealmTFAYubicoURL,
+	})
+
+	testValueTypes(t, tfaSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentRealmTFAOATHDigits: schema.TypeInt,
+		mkResourceVirtualEnvironmentRealmTFAOATHStep:   schema.TypeInt,
+		mkResourceVirtualEnvironmentRealmTFAType:       schema.TypeString,
+		mkResourceVirtualEnvironmentRealmTFAYubicoID:   schema.TypeString,
+		mkResourceVirtualEnvironmentRealmTFAYubicoKey:  schema.TypeString,
+		mkResourceVirtualEnvironmentRealmTFAYubicoURL:  schema.TypeString,
+	})
+}
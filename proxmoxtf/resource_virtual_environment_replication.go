@@ -0,0 +1,260 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentReplicationComment   = ""
+	dvResourceVirtualEnvironmentReplicationEnabled   = true
+	dvResourceVirtualEnvironmentReplicationJobNumber = 0
+	dvResourceVirtualEnvironmentReplicationRateLimit = 0
+	dvResourceVirtualEnvironmentReplicationSchedule  = "*/15"
+
+	mkResourceVirtualEnvironmentReplicationComment    = "comment"
+	mkResourceVirtualEnvironmentReplicationEnabled    = "enabled"
+	mkResourceVirtualEnvironmentReplicationJobNumber  = "job_number"
+	mkResourceVirtualEnvironmentReplicationRateLimit  = "rate_limit"
+	mkResourceVirtualEnvironmentReplicationSchedule   = "schedule"
+	mkResourceVirtualEnvironmentReplicationTargetNode = "target_node"
+	mkResourceVirtualEnvironmentReplicationVMID       = "vm_id"
+)
+
+func resourceVirtualEnvironmentReplication() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentReplicationComment: {
+				Type:        schema.TypeString,
+				Description: "The job comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentReplicationComment,
+			},
+			mkResourceVirtualEnvironmentReplicationEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the job is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentReplicationEnabled,
+			},
+			mkResourceVirtualEnvironmentReplicationJobNumber: {
+				Type:         schema.TypeInt,
+				Description:  "The job number, which must be unique for the guest",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dvResourceVirtualEnvironmentReplicationJobNumber,
+				ValidateFunc: validation.IntBetween(0, 999999999),
+			},
+			mkResourceVirtualEnvironmentReplicationRateLimit: {
+				Type:        schema.TypeFloat,
+				Description: "The rate limit in megabytes per second",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentReplicationRateLimit,
+			},
+			mkResourceVirtualEnvironmentReplicationSchedule: {
+				Type:         schema.TypeString,
+				Description:  "The schedule as a calendar event",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentReplicationSchedule,
+				ValidateFunc: getCalendarEventValidator(),
+			},
+			mkResourceVirtualEnvironmentReplicationTargetNode: {
+				Type:        schema.TypeString,
+				Description: "The name of the target node",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentReplicationVMID: {
+				Type:         schema.TypeInt,
+				Description:  "The id of the guest to replicate",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getVMIDValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentReplicationCreate,
+		Read:   resourceVirtualEnvironmentReplicationRead,
+		Update: resourceVirtualEnvironmentReplicationUpdate,
+		Delete: resourceVirtualEnvironmentReplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentReplicationCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentReplicationComment).(string)
+	enabled := d.Get(mkResourceVirtualEnvironmentReplicationEnabled).(bool)
+	jobNumber := d.Get(mkResourceVirtualEnvironmentReplicationJobNumber).(int)
+	rateLimit := d.Get(mkResourceVirtualEnvironmentReplicationRateLimit).(float64)
+	schedule := d.Get(mkResourceVirtualEnvironmentReplicationSchedule).(string)
+	targetNode := d.Get(mkResourceVirtualEnvironmentReplicationTargetNode).(string)
+	vmID := d.Get(mkResourceVirtualEnvironmentReplicationVMID).(int)
+
+	id := fmt.Sprintf("%d-%d", vmID, jobNumber)
+	body := &proxmox.VirtualEnvironmentReplicationJobCreateRequestBody{
+		ID:       id,
+		Schedule: &schedule,
+		Target:   targetNode,
+		Type:     "local",
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	}
+
+	if !enabled {
+		disable := proxmox.CustomBool(true)
+		body.Disable = &disable
+	}
+
+	if rateLimit > 0 {
+		body.Rate = &rateLimit
+	}
+
+	err = veClient.CreateReplicationJob(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceVirtualEnvironmentReplicationRead(d, m)
+}
+
+func resourceVirtualEnvironmentReplicationRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	job, err := veClient.GetReplicationJob(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if job.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentReplicationComment, strings.TrimSpace(*job.Comment))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentReplicationComment, dvResourceVirtualEnvironmentReplicationComment)
+	}
+
+	if job.Disable != nil {
+		d.Set(mkResourceVirtualEnvironmentReplicationEnabled, !bool(*job.Disable))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentReplicationEnabled, dvResourceVirtualEnvironmentReplicationEnabled)
+	}
+
+	if job.Rate != nil {
+		d.Set(mkResourceVirtualEnvironmentReplicationRateLimit, *job.Rate)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentReplicationRateLimit, dvResourceVirtualEnvironmentReplicationRateLimit)
+	}
+
+	if job.Schedule != nil {
+		d.Set(mkResourceVirtualEnvironmentReplicationSchedule, *job.Schedule)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentReplicationSchedule, dvResourceVirtualEnvironmentReplicationSchedule)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentReplicationJobNumber, job.JobNum)
+	d.Set(mkResourceVirtualEnvironmentReplicationTargetNode, job.Target)
+	d.Set(mkResourceVirtualEnvironmentReplicationVMID, job.Guest)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentReplicationUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentReplicationComment).(string)
+	disable := proxmox.CustomBool(!d.Get(mkResourceVirtualEnvironmentReplicationEnabled).(bool))
+	rateLimit := d.Get(mkResourceVirtualEnvironmentReplicationRateLimit).(float64)
+	schedule := d.Get(mkResourceVirtualEnvironmentReplicationSchedule).(string)
+
+	body := &proxmox.VirtualEnvironmentReplicationJobUpdateRequestBody{
+		Schedule: &schedule,
+	}
+
+	if comment != "" {
+		body.Comment = &comment
+	} else {
+		body.Delete = append(body.Delete, "comment")
+	}
+
+	if disable {
+		body.Disable = &disable
+	} else {
+		body.Delete = append(body.Delete, "disable")
+	}
+
+	if rateLimit > 0 {
+		body.Rate = &rateLimit
+	} else {
+		body.Delete = append(body.Delete, "rate")
+	}
+
+	err = veClient.UpdateReplicationJob(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentReplicationRead(d, m)
+}
+
+func resourceVirtualEnvironmentReplicationDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteReplicationJob(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
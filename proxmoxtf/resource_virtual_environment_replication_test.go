@@ -0,0 +1,48 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentReplicationInstantiation tests whether the ResourceVirtualEnvironmentReplication instance can be instantiated.
+func TestResourceVirtualEnvironmentReplicationInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentReplication()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentReplication")
+	}
+}
+
+// TestResourceVirtualEnvironmentReplicationSchema tests the resourceVirtualEnvironmentReplication schema.
+func TestResourceVirtualEnvironmentReplicationSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentReplication()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentReplicationTargetNode,
+		mkResourceVirtualEnvironmentReplicationVMID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentReplicationComment,
+		mkResourceVirtualEnvironmentReplicationEnabled,
+		mkResourceVirtualEnvironmentReplicationJobNumber,
+		mkResourceVirtualEnvironmentReplicationRateLimit,
+		mkResourceVirtualEnvironmentReplicationSchedule,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentReplicationComment:    schema.TypeString,
+		mkResourceVirtualEnvironmentReplicationEnabled:    schema.TypeBool,
+		mkResourceVirtualEnvironmentReplicationJobNumber:  schema.TypeInt,
+		mkResourceVirtualEnvironmentReplicationRateLimit:  schema.TypeFloat,
+		mkResourceVirtualEnvironmentReplicationSchedule:   schema.TypeString,
+		mkResourceVirtualEnvironmentReplicationTargetNode: schema.TypeString,
+		mkResourceVirtualEnvironmentReplicationVMID:       schema.TypeInt,
+	})
+}
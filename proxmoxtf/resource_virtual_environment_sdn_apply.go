@@ -0,0 +1,149 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentSDNApplyTimeout = 600
+
+	mkResourceVirtualEnvironmentSDNApplyTriggers = "triggers"
+)
+
+func resourceVirtualEnvironmentSDNApply() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSDNApplyTriggers: {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values, which trigger the pending SDN configuration to be applied when changed",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: resourceVirtualEnvironmentSDNApplyCreate,
+		Read:   resourceVirtualEnvironmentSDNApplyRead,
+		Delete: resourceVirtualEnvironmentSDNApplyDelete,
+	}
+}
+
+func resourceVirtualEnvironmentSDNApplyCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.ApplySDN(dvResourceVirtualEnvironmentSDNApplyTimeout)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("sdn")
+
+	return nil
+}
+
+// resourceVirtualEnvironmentSDNApplyHasPendingChanges determines whether any zones, VNets, subnets or controllers have changes, which have yet to be applied.
+func resourceVirtualEnvironmentSDNApplyHasPendingChanges(veClient *proxmox.VirtualEnvironmentClient) (bool, error) {
+	pending := proxmox.CustomBool(true)
+	listBody := &proxmox.VirtualEnvironmentSDNListRequestBody{
+		Pending: &pending,
+	}
+
+	isPending := func(state *string) bool {
+		return state != nil && *state != ""
+	}
+
+	zones, err := veClient.ListSDNZones(listBody)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range zones {
+		if isPending(v.State) {
+			return true, nil
+		}
+	}
+
+	vnets, err := veClient.ListSDNVNets(listBody)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range vnets {
+		if isPending(v.State) {
+			return true, nil
+		}
+
+		subnets, err := veClient.ListSDNSubnets(v.ID, listBody)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, s := range subnets {
+			if isPending(s.State) {
+				return true, nil
+			}
+		}
+	}
+
+	controllers, err := veClient.ListSDNControllers(listBody)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range controllers {
+		if isPending(v.State) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func resourceVirtualEnvironmentSDNApplyRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	hasPendingChanges, err := resourceVirtualEnvironmentSDNApplyHasPendingChanges(veClient)
+
+	if err != nil {
+		// Older API versions do not support listing pending changes, in which case only the triggers are taken into account.
+		if strings.Contains(err.Error(), "HTTP 400") {
+			return nil
+		}
+
+		return err
+	}
+
+	// Pending changes, which were made outside of Terraform or by a failed apply, are handled by recreating the resource.
+	if hasPendingChanges {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSDNApplyDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+
+	return nil
+}
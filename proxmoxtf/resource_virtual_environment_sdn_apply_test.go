@@ -0,0 +1,33 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSDNApplyInstantiation tests whether the ResourceVirtualEnvironmentSDNApply instance can be instantiated.
+func TestResourceVirtualEnvironmentSDNApplyInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNApply()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSDNApply")
+	}
+}
+
+// TestResourceVirtualEnvironmentSDNApplySchema tests the resourceVirtualEnvironmentSDNApply schema.
+func TestResourceVirtualEnvironmentSDNApplySchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNApply()
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNApplyTriggers,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNApplyTriggers: schema.TypeMap,
+	})
+}
@@ -0,0 +1,329 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentSDNControllerEBGP         = false
+	dvResourceVirtualEnvironmentSDNControllerEBGPMultihop = 0
+	dvResourceVirtualEnvironmentSDNControllerLoopback     = ""
+	dvResourceVirtualEnvironmentSDNControllerNodeName     = ""
+
+	mkResourceVirtualEnvironmentSDNControllerASN          = "asn"
+	mkResourceVirtualEnvironmentSDNControllerControllerID = "controller_id"
+	mkResourceVirtualEnvironmentSDNControllerDigest       = "digest"
+	mkResourceVirtualEnvironmentSDNControllerEBGP         = "ebgp"
+	mkResourceVirtualEnvironmentSDNControllerEBGPMultihop = "ebgp_multihop"
+	mkResourceVirtualEnvironmentSDNControllerLoopback     = "loopback"
+	mkResourceVirtualEnvironmentSDNControllerNodeName     = "node_name"
+	mkResourceVirtualEnvironmentSDNControllerPeers        = "peers"
+	mkResourceVirtualEnvironmentSDNControllerType         = "type"
+)
+
+func resourceVirtualEnvironmentSDNController() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSDNControllerASN: {
+				Type:         schema.TypeInt,
+				Description:  "The autonomous system number",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			mkResourceVirtualEnvironmentSDNControllerControllerID: {
+				Type:         schema.TypeString,
+				Description:  "The controller id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNIDValidator(),
+			},
+			mkResourceVirtualEnvironmentSDNControllerDigest: {
+				Type:        schema.TypeString,
+				Description: "The digest of the configuration",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSDNControllerEBGP: {
+				Type:        schema.TypeBool,
+				Description: "Whether to use external BGP for BGP controllers",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNControllerEBGP,
+			},
+			mkResourceVirtualEnvironmentSDNControllerEBGPMultihop: {
+				Type:         schema.TypeInt,
+				Description:  "The maximum number of hops for external BGP peers",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNControllerEBGPMultihop,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			mkResourceVirtualEnvironmentSDNControllerLoopback: {
+				Type:        schema.TypeString,
+				Description: "The source loopback interface for BGP controllers",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNControllerLoopback,
+			},
+			mkResourceVirtualEnvironmentSDNControllerNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name for BGP controllers",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentSDNControllerNodeName,
+			},
+			mkResourceVirtualEnvironmentSDNControllerPeers: {
+				Type:        schema.TypeList,
+				Description: "The peer addresses",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentSDNControllerType: {
+				Type:         schema.TypeString,
+				Description:  "The controller type",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNControllerTypeValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentSDNControllerCreate,
+		Read:   resourceVirtualEnvironmentSDNControllerRead,
+		Update: resourceVirtualEnvironmentSDNControllerUpdate,
+		Delete: resourceVirtualEnvironmentSDNControllerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+
+	r.CustomizeDiff = getConfigDigestCustomizeDiffFunc(r, mkResourceVirtualEnvironmentSDNControllerDigest)
+
+	return r
+}
+
+func resourceVirtualEnvironmentSDNControllerCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	updateBody, err := resourceVirtualEnvironmentSDNControllerGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentSDNControllerCreateRequestBody{
+		ASN:          updateBody.ASN,
+		EBGP:         updateBody.EBGP,
+		EBGPMultihop: updateBody.EBGPMultihop,
+		ID:           d.Get(mkResourceVirtualEnvironmentSDNControllerControllerID).(string),
+		Loopback:     updateBody.Loopback,
+		Node:         updateBody.Node,
+		Peers:        updateBody.Peers,
+		Type:         d.Get(mkResourceVirtualEnvironmentSDNControllerType).(string),
+	}
+
+	err = veClient.CreateSDNController(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(body.ID)
+
+	return resourceVirtualEnvironmentSDNControllerRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNControllerGetUpdateBody(d *schema.ResourceData) (*proxmox.VirtualEnvironmentSDNControllerUpdateRequestBody, error) {
+	asn := d.Get(mkResourceVirtualEnvironmentSDNControllerASN).(int)
+	controllerType := d.Get(mkResourceVirtualEnvironmentSDNControllerType).(string)
+	ebgp := d.Get(mkResourceVirtualEnvironmentSDNControllerEBGP).(bool)
+	ebgpMultihop := d.Get(mkResourceVirtualEnvironmentSDNControllerEBGPMultihop).(int)
+	loopback := d.Get(mkResourceVirtualEnvironmentSDNControllerLoopback).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentSDNControllerNodeName).(string)
+	peers := d.Get(mkResourceVirtualEnvironmentSDNControllerPeers).([]interface{})
+
+	body := &proxmox.VirtualEnvironmentSDNControllerUpdateRequestBody{
+		ASN: &asn,
+	}
+
+	for _, v := range peers {
+		body.Peers = append(body.Peers, v.(string))
+	}
+
+	if controllerType == "evpn" {
+		if len(body.Peers) == 0 {
+			return nil, fmt.Errorf("The \"%s\" argument must be specified for EVPN controllers", mkResourceVirtualEnvironmentSDNControllerPeers)
+		}
+
+		if ebgp || ebgpMultihop > 0 || loopback != "" || nodeName != "" {
+			return nil, fmt.Errorf(
+				"The \"%s\", \"%s\", \"%s\" and \"%s\" arguments are only supported by BGP controllers",
+				mkResourceVirtualEnvironmentSDNControllerEBGP,
+				mkResourceVirtualEnvironmentSDNControllerEBGPMultihop,
+				mkResourceVirtualEnvironmentSDNControllerLoopback,
+				mkResourceVirtualEnvironmentSDNControllerNodeName,
+			)
+		}
+
+		return body, nil
+	}
+
+	if nodeName == "" {
+		return nil, fmt.Errorf("The \"%s\" argument must be specified for BGP controllers", mkResourceVirtualEnvironmentSDNControllerNodeName)
+	}
+
+	ebgpValue := proxmox.CustomBool(ebgp)
+
+	body.EBGP = &ebgpValue
+	body.Node = &nodeName
+
+	if len(body.Peers) == 0 {
+		body.Delete = append(body.Delete, "peers")
+	}
+
+	if ebgpMultihop > 0 {
+		body.EBGPMultihop = &ebgpMultihop
+	} else {
+		body.Delete = append(body.Delete, "ebgp-multihop")
+	}
+
+	if loopback != "" {
+		body.Loopback = &loopback
+	} else {
+		body.Delete = append(body.Delete, "loopback")
+	}
+
+	return body, nil
+}
+
+func resourceVirtualEnvironmentSDNControllerRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	controller, err := veClient.GetSDNController(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if controller.ASN != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerASN, int(*controller.ASN))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerASN, 0)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNControllerControllerID, d.Id())
+
+	if controller.EBGP != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerEBGP, bool(*controller.EBGP))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerEBGP, dvResourceVirtualEnvironmentSDNControllerEBGP)
+	}
+
+	if controller.EBGPMultihop != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerEBGPMultihop, int(*controller.EBGPMultihop))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerEBGPMultihop, dvResourceVirtualEnvironmentSDNControllerEBGPMultihop)
+	}
+
+	if controller.Loopback != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerLoopback, *controller.Loopback)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerLoopback, dvResourceVirtualEnvironmentSDNControllerLoopback)
+	}
+
+	if controller.Node != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerNodeName, *controller.Node)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNControllerNodeName, dvResourceVirtualEnvironmentSDNControllerNodeName)
+	}
+
+	peers := []interface{}{}
+
+	if controller.Peers != nil {
+		for _, v := range *controller.Peers {
+			if v != "" {
+				peers = append(peers, v)
+			}
+		}
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNControllerPeers, peers)
+	d.Set(mkResourceVirtualEnvironmentSDNControllerType, controller.Type)
+
+	d.Set(mkResourceVirtualEnvironmentSDNControllerDigest, getConfigDigest(resourceVirtualEnvironmentSDNController(), d.Get))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSDNControllerUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, err := resourceVirtualEnvironmentSDNControllerGetUpdateBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	// The node of a BGP controller cannot be modified.
+	body.Node = nil
+
+	err = veClient.UpdateSDNController(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentSDNControllerRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNControllerDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteSDNController(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
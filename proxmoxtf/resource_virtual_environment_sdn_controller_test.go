@@ -0,0 +1,55 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSDNControllerInstantiation tests whether the ResourceVirtualEnvironmentSDNController instance can be instantiated.
+func TestResourceVirtualEnvironmentSDNControllerInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNController()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSDNController")
+	}
+}
+
+// TestResourceVirtualEnvironmentSDNControllerSchema tests the resourceVirtualEnvironmentSDNController schema.
+func TestResourceVirtualEnvironmentSDNControllerSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNController()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNControllerASN,
+		mkResourceVirtualEnvironmentSDNControllerControllerID,
+		mkResourceVirtualEnvironmentSDNControllerType,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNControllerEBGP,
+		mkResourceVirtualEnvironmentSDNControllerEBGPMultihop,
+		mkResourceVirtualEnvironmentSDNControllerLoopback,
+		mkResourceVirtualEnvironmentSDNControllerNodeName,
+		mkResourceVirtualEnvironmentSDNControllerPeers,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentSDNControllerDigest,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNControllerASN:          schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNControllerControllerID: schema.TypeString,
+		mkResourceVirtualEnvironmentSDNControllerDigest:       schema.TypeString,
+		mkResourceVirtualEnvironmentSDNControllerEBGP:         schema.TypeBool,
+		mkResourceVirtualEnvironmentSDNControllerEBGPMultihop: schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNControllerLoopback:     schema.TypeString,
+		mkResourceVirtualEnvironmentSDNControllerNodeName:     schema.TypeString,
+		mkResourceVirtualEnvironmentSDNControllerPeers:        schema.TypeList,
+		mkResourceVirtualEnvironmentSDNControllerType:         schema.TypeString,
+	})
+}
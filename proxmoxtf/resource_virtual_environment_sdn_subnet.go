@@ -0,0 +1,380 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentSDNSubnetDHCPDNSServer = ""
+	dvResourceVirtualEnvironmentSDNSubnetDNSZonePrefix = ""
+	dvResourceVirtualEnvironmentSDNSubnetGateway       = ""
+	dvResourceVirtualEnvironmentSDNSubnetSNAT          = false
+
+	mkResourceVirtualEnvironmentSDNSubnetCIDR                  = "cidr"
+	mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer         = "dhcp_dns_server"
+	mkResourceVirtualEnvironmentSDNSubnetDHCPRange             = "dhcp_range"
+	mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress   = "end_address"
+	mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress = "start_address"
+	mkResourceVirtualEnvironmentSDNSubnetDigest                = "digest"
+	mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix         = "dns_zone_prefix"
+	mkResourceVirtualEnvironmentSDNSubnetGateway               = "gateway"
+	mkResourceVirtualEnvironmentSDNSubnetSNAT                  = "snat"
+	mkResourceVirtualEnvironmentSDNSubnetSubnetID              = "subnet_id"
+	mkResourceVirtualEnvironmentSDNSubnetVNetID                = "vnet_id"
+	mkResourceVirtualEnvironmentSDNSubnetZone                  = "zone"
+)
+
+func resourceVirtualEnvironmentSDNSubnet() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSDNSubnetCIDR: {
+				Type:         schema.TypeString,
+				Description:  "The subnet in CIDR notation",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 128),
+			},
+			mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer: {
+				Type:        schema.TypeString,
+				Description: "The DNS server announced by the DHCP server",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNSubnetDHCPDNSServer,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetDHCPRange: {
+				Type:        schema.TypeList,
+				Description: "The DHCP ranges",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress: {
+							Type:         schema.TypeString,
+							Description:  "The last address in the range",
+							Required:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress: {
+							Type:         schema.TypeString,
+							Description:  "The first address in the range",
+							Required:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+					},
+				},
+			},
+			mkResourceVirtualEnvironmentSDNSubnetDigest: {
+				Type:        schema.TypeString,
+				Description: "The digest of the configuration",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix: {
+				Type:        schema.TypeString,
+				Description: "The DNS zone prefix",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNSubnetDNSZonePrefix,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetGateway: {
+				Type:        schema.TypeString,
+				Description: "The gateway address",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNSubnetGateway,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetSNAT: {
+				Type:        schema.TypeBool,
+				Description: "Whether to enable source NAT for traffic leaving the subnet",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNSubnetSNAT,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetSubnetID: {
+				Type:        schema.TypeString,
+				Description: "The subnet id",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSDNSubnetVNetID: {
+				Type:         schema.TypeString,
+				Description:  "The VNet id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNIDValidator(),
+			},
+			mkResourceVirtualEnvironmentSDNSubnetZone: {
+				Type:        schema.TypeString,
+				Description: "The zone id",
+				Computed:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentSDNSubnetCreate,
+		Read:   resourceVirtualEnvironmentSDNSubnetRead,
+		Update: resourceVirtualEnvironmentSDNSubnetUpdate,
+		Delete: resourceVirtualEnvironmentSDNSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				vnetID, cidr, err := resourceVirtualEnvironmentSDNSubnetParseID(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set(mkResourceVirtualEnvironmentSDNSubnetCIDR, cidr)
+				d.Set(mkResourceVirtualEnvironmentSDNSubnetVNetID, vnetID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+
+	r.CustomizeDiff = getConfigDigestCustomizeDiffFunc(r, mkResourceVirtualEnvironmentSDNSubnetDigest)
+
+	return r
+}
+
+func resourceVirtualEnvironmentSDNSubnetCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	cidr := d.Get(mkResourceVirtualEnvironmentSDNSubnetCIDR).(string)
+	vnetID := d.Get(mkResourceVirtualEnvironmentSDNSubnetVNetID).(string)
+	updateBody := resourceVirtualEnvironmentSDNSubnetGetUpdateBody(d)
+
+	body := &proxmox.VirtualEnvironmentSDNSubnetCreateRequestBody{
+		DHCPDNSServer: updateBody.DHCPDNSServer,
+		DHCPRange:     updateBody.DHCPRange,
+		DNSZonePrefix: updateBody.DNSZonePrefix,
+		Gateway:       updateBody.Gateway,
+		SNAT:          updateBody.SNAT,
+		Subnet:        cidr,
+		Type:          "subnet",
+	}
+
+	err = veClient.CreateSDNSubnet(vnetID, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", vnetID, cidr))
+
+	return resourceVirtualEnvironmentSDNSubnetRead(d, m)
+}
+
+// resourceVirtualEnvironmentSDNSubnetFind retrieves a subnet by its CIDR, as the API identifies subnets by a combination of the zone and the network address.
+func resourceVirtualEnvironmentSDNSubnetFind(veClient *proxmox.VirtualEnvironmentClient, vnetID string, cidr string) (*proxmox.VirtualEnvironmentSDNSubnetListResponseData, error) {
+	subnets, err := veClient.ListSDNSubnets(vnetID, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := fmt.Sprintf("-%s", strings.Replace(cidr, "/", "-", 1))
+
+	for _, subnet := range subnets {
+		if subnet.CIDR == cidr || (subnet.CIDR == "" && strings.HasSuffix(subnet.ID, suffix)) {
+			return subnet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceVirtualEnvironmentSDNSubnetGetUpdateBody(d *schema.ResourceData) *proxmox.VirtualEnvironmentSDNSubnetUpdateRequestBody {
+	dhcpDNSServer := d.Get(mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer).(string)
+	dhcpRange := d.Get(mkResourceVirtualEnvironmentSDNSubnetDHCPRange).([]interface{})
+	dnsZonePrefix := d.Get(mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix).(string)
+	gateway := d.Get(mkResourceVirtualEnvironmentSDNSubnetGateway).(string)
+	snat := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentSDNSubnetSNAT).(bool))
+
+	body := &proxmox.VirtualEnvironmentSDNSubnetUpdateRequestBody{
+		SNAT: &snat,
+	}
+
+	if dhcpDNSServer != "" {
+		body.DHCPDNSServer = &dhcpDNSServer
+	} else {
+		body.Delete = append(body.Delete, "dhcp-dns-server")
+	}
+
+	if len(dhcpRange) > 0 {
+		for _, v := range dhcpRange {
+			block := v.(map[string]interface{})
+
+			body.DHCPRange = append(body.DHCPRange, proxmox.CustomSDNDHCPRange{
+				EndAddress:   block[mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress].(string),
+				StartAddress: block[mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress].(string),
+			})
+		}
+	} else {
+		body.Delete = append(body.Delete, "dhcp-range")
+	}
+
+	if dnsZonePrefix != "" {
+		body.DNSZonePrefix = &dnsZonePrefix
+	} else {
+		body.Delete = append(body.Delete, "dnszoneprefix")
+	}
+
+	if gateway != "" {
+		body.Gateway = &gateway
+	} else {
+		body.Delete = append(body.Delete, "gateway")
+	}
+
+	return body
+}
+
+func resourceVirtualEnvironmentSDNSubnetParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "|", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("The subnet identifier must be of the form \"vnet|cidr\"")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceVirtualEnvironmentSDNSubnetRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	vnetID, cidr, err := resourceVirtualEnvironmentSDNSubnetParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	subnet, err := resourceVirtualEnvironmentSDNSubnetFind(veClient, vnetID, cidr)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if subnet == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNSubnetCIDR, cidr)
+
+	if subnet.DHCPDNSServer != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer, *subnet.DHCPDNSServer)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer, dvResourceVirtualEnvironmentSDNSubnetDHCPDNSServer)
+	}
+
+	dhcpRange := []interface{}{}
+
+	for _, v := range subnet.DHCPRange {
+		dhcpRange = append(dhcpRange, map[string]interface{}{
+			mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress:   v.EndAddress,
+			mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress: v.StartAddress,
+		})
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNSubnetDHCPRange, dhcpRange)
+
+	if subnet.DNSZonePrefix != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix, *subnet.DNSZonePrefix)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix, dvResourceVirtualEnvironmentSDNSubnetDNSZonePrefix)
+	}
+
+	if subnet.Gateway != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetGateway, *subnet.Gateway)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetGateway, dvResourceVirtualEnvironmentSDNSubnetGateway)
+	}
+
+	if subnet.SNAT != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetSNAT, bool(*subnet.SNAT))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetSNAT, dvResourceVirtualEnvironmentSDNSubnetSNAT)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNSubnetSubnetID, subnet.ID)
+	d.Set(mkResourceVirtualEnvironmentSDNSubnetVNetID, vnetID)
+
+	if subnet.Zone != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetZone, *subnet.Zone)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNSubnetZone, "")
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNSubnetDigest, getConfigDigest(resourceVirtualEnvironmentSDNSubnet(), d.Get))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSDNSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	vnetID := d.Get(mkResourceVirtualEnvironmentSDNSubnetVNetID).(string)
+	subnetID := d.Get(mkResourceVirtualEnvironmentSDNSubnetSubnetID).(string)
+	body := resourceVirtualEnvironmentSDNSubnetGetUpdateBody(d)
+
+	err = veClient.UpdateSDNSubnet(vnetID, subnetID, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentSDNSubnetRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNSubnetDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	vnetID := d.Get(mkResourceVirtualEnvironmentSDNSubnetVNetID).(string)
+	subnetID := d.Get(mkResourceVirtualEnvironmentSDNSubnetSubnetID).(string)
+
+	err = veClient.DeleteSDNSubnet(vnetID, subnetID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
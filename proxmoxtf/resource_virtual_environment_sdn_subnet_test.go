@@ -0,0 +1,69 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSDNSubnetInstantiation tests whether the ResourceVirtualEnvironmentSDNSubnet instance can be instantiated.
+func TestResourceVirtualEnvironmentSDNSubnetInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNSubnet()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSDNSubnet")
+	}
+}
+
+// TestResourceVirtualEnvironmentSDNSubnetSchema tests the resourceVirtualEnvironmentSDNSubnet schema.
+func TestResourceVirtualEnvironmentSDNSubnetSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNSubnet()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNSubnetCIDR,
+		mkResourceVirtualEnvironmentSDNSubnetVNetID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer,
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRange,
+		mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix,
+		mkResourceVirtualEnvironmentSDNSubnetGateway,
+		mkResourceVirtualEnvironmentSDNSubnetSNAT,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentSDNSubnetDigest,
+		mkResourceVirtualEnvironmentSDNSubnetSubnetID,
+		mkResourceVirtualEnvironmentSDNSubnetZone,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNSubnetCIDR:          schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetDHCPDNSServer: schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRange:     schema.TypeList,
+		mkResourceVirtualEnvironmentSDNSubnetDigest:        schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetDNSZonePrefix: schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetGateway:       schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetSNAT:          schema.TypeBool,
+		mkResourceVirtualEnvironmentSDNSubnetSubnetID:      schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetVNetID:        schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetZone:          schema.TypeString,
+	})
+
+	dhcpRangeSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentSDNSubnetDHCPRange)
+
+	testRequiredArguments(t, dhcpRangeSchema, []string{
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress,
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress,
+	})
+
+	testValueTypes(t, dhcpRangeSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRangeEndAddress:   schema.TypeString,
+		mkResourceVirtualEnvironmentSDNSubnetDHCPRangeStartAddress: schema.TypeString,
+	})
+}
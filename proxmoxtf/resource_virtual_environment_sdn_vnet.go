@@ -0,0 +1,228 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentSDNVNetAlias     = ""
+	dvResourceVirtualEnvironmentSDNVNetTag       = 0
+	dvResourceVirtualEnvironmentSDNVNetVLANAware = false
+
+	mkResourceVirtualEnvironmentSDNVNetAlias     = "alias"
+	mkResourceVirtualEnvironmentSDNVNetDigest    = "digest"
+	mkResourceVirtualEnvironmentSDNVNetTag       = "tag"
+	mkResourceVirtualEnvironmentSDNVNetVLANAware = "vlan_aware"
+	mkResourceVirtualEnvironmentSDNVNetVNetID    = "vnet_id"
+	mkResourceVirtualEnvironmentSDNVNetZone      = "zone"
+)
+
+func resourceVirtualEnvironmentSDNVNet() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSDNVNetAlias: {
+				Type:        schema.TypeString,
+				Description: "The VNet alias",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNVNetAlias,
+			},
+			mkResourceVirtualEnvironmentSDNVNetDigest: {
+				Type:        schema.TypeString,
+				Description: "The digest of the configuration",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSDNVNetTag: {
+				Type:         schema.TypeInt,
+				Description:  "The VLAN tag or VXLAN id",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNVNetTag,
+				ValidateFunc: validation.IntBetween(0, 16777215),
+			},
+			mkResourceVirtualEnvironmentSDNVNetVLANAware: {
+				Type:        schema.TypeBool,
+				Description: "Whether to allow VLANs to pass through the VNet",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNVNetVLANAware,
+			},
+			mkResourceVirtualEnvironmentSDNVNetVNetID: {
+				Type:         schema.TypeString,
+				Description:  "The VNet id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNIDValidator(),
+			},
+			mkResourceVirtualEnvironmentSDNVNetZone: {
+				Type:         schema.TypeString,
+				Description:  "The zone id",
+				Required:     true,
+				ValidateFunc: getSDNIDValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentSDNVNetCreate,
+		Read:   resourceVirtualEnvironmentSDNVNetRead,
+		Update: resourceVirtualEnvironmentSDNVNetUpdate,
+		Delete: resourceVirtualEnvironmentSDNVNetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+
+	r.CustomizeDiff = getConfigDigestCustomizeDiffFunc(r, mkResourceVirtualEnvironmentSDNVNetDigest)
+
+	return r
+}
+
+func resourceVirtualEnvironmentSDNVNetCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	alias := d.Get(mkResourceVirtualEnvironmentSDNVNetAlias).(string)
+	tag := d.Get(mkResourceVirtualEnvironmentSDNVNetTag).(int)
+	vlanAware := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentSDNVNetVLANAware).(bool))
+
+	body := &proxmox.VirtualEnvironmentSDNVNetCreateRequestBody{
+		ID:        d.Get(mkResourceVirtualEnvironmentSDNVNetVNetID).(string),
+		VLANAware: &vlanAware,
+		Zone:      d.Get(mkResourceVirtualEnvironmentSDNVNetZone).(string),
+	}
+
+	if alias != "" {
+		body.Alias = &alias
+	}
+
+	if tag > 0 {
+		body.Tag = &tag
+	}
+
+	err = veClient.CreateSDNVNet(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(body.ID)
+
+	return resourceVirtualEnvironmentSDNVNetRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNVNetRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	vnet, err := veClient.GetSDNVNet(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if vnet.Alias != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetAlias, *vnet.Alias)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetAlias, dvResourceVirtualEnvironmentSDNVNetAlias)
+	}
+
+	if vnet.Tag != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetTag, int(*vnet.Tag))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetTag, dvResourceVirtualEnvironmentSDNVNetTag)
+	}
+
+	if vnet.VLANAware != nil {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetVLANAware, bool(*vnet.VLANAware))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSDNVNetVLANAware, dvResourceVirtualEnvironmentSDNVNetVLANAware)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNVNetVNetID, d.Id())
+	d.Set(mkResourceVirtualEnvironmentSDNVNetZone, vnet.Zone)
+
+	d.Set(mkResourceVirtualEnvironmentSDNVNetDigest, getConfigDigest(resourceVirtualEnvironmentSDNVNet(), d.Get))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSDNVNetUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	alias := d.Get(mkResourceVirtualEnvironmentSDNVNetAlias).(string)
+	tag := d.Get(mkResourceVirtualEnvironmentSDNVNetTag).(int)
+	vlanAware := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentSDNVNetVLANAware).(bool))
+	zone := d.Get(mkResourceVirtualEnvironmentSDNVNetZone).(string)
+
+	body := &proxmox.VirtualEnvironmentSDNVNetUpdateRequestBody{
+		VLANAware: &vlanAware,
+		Zone:      &zone,
+	}
+
+	if alias != "" {
+		body.Alias = &alias
+	} else {
+		body.Delete = append(body.Delete, "alias")
+	}
+
+	if tag > 0 {
+		body.Tag = &tag
+	} else {
+		body.Delete = append(body.Delete, "tag")
+	}
+
+	err = veClient.UpdateSDNVNet(d.Id(), body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentSDNVNetRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNVNetDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteSDNVNet(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSDNVNetInstantiation tests whether the ResourceVirtualEnvironmentSDNVNet instance can be instantiated.
+func TestResourceVirtualEnvironmentSDNVNetInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNVNet()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSDNVNet")
+	}
+}
+
+// TestResourceVirtualEnvironmentSDNVNetSchema tests the resourceVirtualEnvironmentSDNVNet schema.
+func TestResourceVirtualEnvironmentSDNVNetSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNVNet()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNVNetVNetID,
+		mkResourceVirtualEnvironmentSDNVNetZone,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNVNetAlias,
+		mkResourceVirtualEnvironmentSDNVNetTag,
+		mkResourceVirtualEnvironmentSDNVNetVLANAware,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentSDNVNetDigest,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNVNetAlias:     schema.TypeString,
+		mkResourceVirtualEnvironmentSDNVNetDigest:    schema.TypeString,
+		mkResourceVirtualEnvironmentSDNVNetTag:       schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNVNetVLANAware: schema.TypeBool,
+		mkResourceVirtualEnvironmentSDNVNetVNetID:    schema.TypeString,
+		mkResourceVirtualEnvironmentSDNVNetZone:      schema.TypeString,
+	})
+}
@@ -0,0 +1,445 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentSDNZoneBridge       = ""
+	dvResourceVirtualEnvironmentSDNZoneController   = ""
+	dvResourceVirtualEnvironmentSDNZoneDHCP         = ""
+	dvResourceVirtualEnvironmentSDNZoneDNS          = ""
+	dvResourceVirtualEnvironmentSDNZoneDNSZone      = ""
+	dvResourceVirtualEnvironmentSDNZoneIPAM         = ""
+	dvResourceVirtualEnvironmentSDNZoneMTU          = 0
+	dvResourceVirtualEnvironmentSDNZoneReverseDNS   = ""
+	dvResourceVirtualEnvironmentSDNZoneTag          = 0
+	dvResourceVirtualEnvironmentSDNZoneVLANProtocol = ""
+	dvResourceVirtualEnvironmentSDNZoneVRFVXLAN     = 0
+
+	mkResourceVirtualEnvironmentSDNZoneBridge       = "bridge"
+	mkResourceVirtualEnvironmentSDNZoneController   = "controller"
+	mkResourceVirtualEnvironmentSDNZoneDHCP         = "dhcp"
+	mkResourceVirtualEnvironmentSDNZoneDigest       = "digest"
+	mkResourceVirtualEnvironmentSDNZoneDNS          = "dns"
+	mkResourceVirtualEnvironmentSDNZoneDNSZone      = "dns_zone"
+	mkResourceVirtualEnvironmentSDNZoneExitNodes    = "exit_nodes"
+	mkResourceVirtualEnvironmentSDNZoneIPAM         = "ipam"
+	mkResourceVirtualEnvironmentSDNZoneMTU          = "mtu"
+	mkResourceVirtualEnvironmentSDNZoneNodes        = "nodes"
+	mkResourceVirtualEnvironmentSDNZonePeers        = "peers"
+	mkResourceVirtualEnvironmentSDNZoneReverseDNS   = "reverse_dns"
+	mkResourceVirtualEnvironmentSDNZoneTag          = "tag"
+	mkResourceVirtualEnvironmentSDNZoneType         = "type"
+	mkResourceVirtualEnvironmentSDNZoneVLANProtocol = "vlan_protocol"
+	mkResourceVirtualEnvironmentSDNZoneVRFVXLAN     = "vrf_vxlan"
+	mkResourceVirtualEnvironmentSDNZoneZoneID       = "zone_id"
+)
+
+func resourceVirtualEnvironmentSDNZone() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSDNZoneBridge: {
+				Type:        schema.TypeString,
+				Description: "The bridge for VLAN and QinQ zones",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneBridge,
+			},
+			mkResourceVirtualEnvironmentSDNZoneController: {
+				Type:        schema.TypeString,
+				Description: "The controller for EVPN zones",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneController,
+			},
+			mkResourceVirtualEnvironmentSDNZoneDHCP: {
+				Type:         schema.TypeString,
+				Description:  "The DHCP backend",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNZoneDHCP,
+				ValidateFunc: validation.StringInSlice([]string{"", "dnsmasq"}, false),
+			},
+			mkResourceVirtualEnvironmentSDNZoneDigest: {
+				Type:        schema.TypeString,
+				Description: "The digest of the configuration",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSDNZoneDNS: {
+				Type:        schema.TypeString,
+				Description: "The DNS API server",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneDNS,
+			},
+			mkResourceVirtualEnvironmentSDNZoneDNSZone: {
+				Type:        schema.TypeString,
+				Description: "The DNS domain name",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneDNSZone,
+			},
+			mkResourceVirtualEnvironmentSDNZoneExitNodes: {
+				Type:        schema.TypeList,
+				Description: "The exit nodes for EVPN zones",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentSDNZoneIPAM: {
+				Type:        schema.TypeString,
+				Description: "The IP address management server",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneIPAM,
+			},
+			mkResourceVirtualEnvironmentSDNZoneMTU: {
+				Type:         schema.TypeInt,
+				Description:  "The MTU",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNZoneMTU,
+				ValidateFunc: validation.IntBetween(0, 65520),
+			},
+			mkResourceVirtualEnvironmentSDNZoneNodes: {
+				Type:        schema.TypeList,
+				Description: "The nodes to restrict the zone to",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentSDNZonePeers: {
+				Type:        schema.TypeList,
+				Description: "The peer addresses for VXLAN zones",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			mkResourceVirtualEnvironmentSDNZoneReverseDNS: {
+				Type:        schema.TypeString,
+				Description: "The reverse DNS API server",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentSDNZoneReverseDNS,
+			},
+			mkResourceVirtualEnvironmentSDNZoneTag: {
+				Type:         schema.TypeInt,
+				Description:  "The service VLAN tag for QinQ zones",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNZoneTag,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			mkResourceVirtualEnvironmentSDNZoneType: {
+				Type:         schema.TypeString,
+				Description:  "The zone type",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNZoneTypeValidator(),
+			},
+			mkResourceVirtualEnvironmentSDNZoneVLANProtocol: {
+				Type:         schema.TypeString,
+				Description:  "The service VLAN protocol for QinQ zones",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNZoneVLANProtocol,
+				ValidateFunc: validation.StringInSlice([]string{"", "802.1ad", "802.1q"}, false),
+			},
+			mkResourceVirtualEnvironmentSDNZoneVRFVXLAN: {
+				Type:         schema.TypeInt,
+				Description:  "The VRF VXLAN id for EVPN zones",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentSDNZoneVRFVXLAN,
+				ValidateFunc: validation.IntBetween(0, 16777215),
+			},
+			mkResourceVirtualEnvironmentSDNZoneZoneID: {
+				Type:         schema.TypeString,
+				Description:  "The zone id",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getSDNIDValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentSDNZoneCreate,
+		Read:   resourceVirtualEnvironmentSDNZoneRead,
+		Update: resourceVirtualEnvironmentSDNZoneUpdate,
+		Delete: resourceVirtualEnvironmentSDNZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+
+	r.CustomizeDiff = getConfigDigestCustomizeDiffFunc(r, mkResourceVirtualEnvironmentSDNZoneDigest)
+
+	return r
+}
+
+func resourceVirtualEnvironmentSDNZoneCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, _, err := resourceVirtualEnvironmentSDNZoneGetRequestBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.CreateSDNZone(body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(body.ID)
+
+	return resourceVirtualEnvironmentSDNZoneRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNZoneGetRequestBody(d *schema.ResourceData) (*proxmox.VirtualEnvironmentSDNZoneCreateRequestBody, []string, error) {
+	body := &proxmox.VirtualEnvironmentSDNZoneCreateRequestBody{
+		ID:   d.Get(mkResourceVirtualEnvironmentSDNZoneZoneID).(string),
+		Type: d.Get(mkResourceVirtualEnvironmentSDNZoneType).(string),
+	}
+
+	del := []string{}
+
+	// Empty values are removed from the zone configuration instead of being submitted.
+	optionalInt := func(key string, param string) *int {
+		v := d.Get(key).(int)
+
+		if v == 0 {
+			del = append(del, param)
+
+			return nil
+		}
+
+		return &v
+	}
+
+	optionalList := func(key string, param string) []string {
+		v := []string{}
+
+		for _, item := range d.Get(key).([]interface{}) {
+			v = append(v, item.(string))
+		}
+
+		if len(v) == 0 {
+			del = append(del, param)
+		}
+
+		return v
+	}
+
+	optionalString := func(key string, param string) *string {
+		v := d.Get(key).(string)
+
+		if v == "" {
+			del = append(del, param)
+
+			return nil
+		}
+
+		return &v
+	}
+
+	body.Bridge = optionalString(mkResourceVirtualEnvironmentSDNZoneBridge, "bridge")
+	body.Controller = optionalString(mkResourceVirtualEnvironmentSDNZoneController, "controller")
+	body.DHCP = optionalString(mkResourceVirtualEnvironmentSDNZoneDHCP, "dhcp")
+	body.DNS = optionalString(mkResourceVirtualEnvironmentSDNZoneDNS, "dns")
+	body.DNSZone = optionalString(mkResourceVirtualEnvironmentSDNZoneDNSZone, "dnszone")
+	body.ExitNodes = optionalList(mkResourceVirtualEnvironmentSDNZoneExitNodes, "exitnodes")
+	body.IPAM = optionalString(mkResourceVirtualEnvironmentSDNZoneIPAM, "ipam")
+	body.MTU = optionalInt(mkResourceVirtualEnvironmentSDNZoneMTU, "mtu")
+	body.Nodes = optionalList(mkResourceVirtualEnvironmentSDNZoneNodes, "nodes")
+	body.Peers = optionalList(mkResourceVirtualEnvironmentSDNZonePeers, "peers")
+	body.ReverseDNS = optionalString(mkResourceVirtualEnvironmentSDNZoneReverseDNS, "reversedns")
+	body.Tag = optionalInt(mkResourceVirtualEnvironmentSDNZoneTag, "tag")
+	body.VLANProtocol = optionalString(mkResourceVirtualEnvironmentSDNZoneVLANProtocol, "vlan-protocol")
+	body.VRFVXLAN = optionalInt(mkResourceVirtualEnvironmentSDNZoneVRFVXLAN, "vrf-vxlan")
+
+	// Each zone type has its own set of mandatory arguments, which the schema is unable to express.
+	required := map[string][]string{
+		"evpn":   {mkResourceVirtualEnvironmentSDNZoneController, mkResourceVirtualEnvironmentSDNZoneVRFVXLAN},
+		"qinq":   {mkResourceVirtualEnvironmentSDNZoneBridge, mkResourceVirtualEnvironmentSDNZoneTag},
+		"simple": {},
+		"vlan":   {mkResourceVirtualEnvironmentSDNZoneBridge},
+		"vxlan":  {mkResourceVirtualEnvironmentSDNZonePeers},
+	}
+
+	missing := []string{}
+
+	for _, key := range required[body.Type] {
+		switch v := d.Get(key).(type) {
+		case []interface{}:
+			if len(v) == 0 {
+				missing = append(missing, key)
+			}
+		case int:
+			if v == 0 {
+				missing = append(missing, key)
+			}
+		case string:
+			if v == "" {
+				missing = append(missing, key)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf(
+			"The \"%s\" argument(s) must be specified for zones of type \"%s\"",
+			strings.Join(missing, "\", \""),
+			body.Type,
+		)
+	}
+
+	return body, del, nil
+}
+
+func resourceVirtualEnvironmentSDNZoneRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	zone, err := veClient.GetSDNZone(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	intValue := func(v *proxmox.CustomInt) int {
+		if v != nil {
+			return int(*v)
+		}
+
+		return 0
+	}
+
+	listValue := func(v *proxmox.CustomCommaSeparatedList) []interface{} {
+		list := []interface{}{}
+
+		if v != nil {
+			for _, item := range *v {
+				if item != "" {
+					list = append(list, item)
+				}
+			}
+		}
+
+		return list
+	}
+
+	stringValue := func(v *string) string {
+		if v != nil {
+			return *v
+		}
+
+		return ""
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSDNZoneBridge, stringValue(zone.Bridge))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneController, stringValue(zone.Controller))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneDHCP, stringValue(zone.DHCP))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneDNS, stringValue(zone.DNS))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneDNSZone, stringValue(zone.DNSZone))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneExitNodes, listValue(zone.ExitNodes))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneIPAM, stringValue(zone.IPAM))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneMTU, intValue(zone.MTU))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneNodes, listValue(zone.Nodes))
+	d.Set(mkResourceVirtualEnvironmentSDNZonePeers, listValue(zone.Peers))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneReverseDNS, stringValue(zone.ReverseDNS))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneTag, intValue(zone.Tag))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneType, zone.Type)
+	d.Set(mkResourceVirtualEnvironmentSDNZoneVLANProtocol, stringValue(zone.VLANProtocol))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneVRFVXLAN, intValue(zone.VRFVXLAN))
+	d.Set(mkResourceVirtualEnvironmentSDNZoneZoneID, d.Id())
+
+	d.Set(mkResourceVirtualEnvironmentSDNZoneDigest, getConfigDigest(resourceVirtualEnvironmentSDNZone(), d.Get))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSDNZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	body, del, err := resourceVirtualEnvironmentSDNZoneGetRequestBody(d)
+
+	if err != nil {
+		return err
+	}
+
+	updateBody := &proxmox.VirtualEnvironmentSDNZoneUpdateRequestBody{
+		Bridge:       body.Bridge,
+		Controller:   body.Controller,
+		Delete:       del,
+		DHCP:         body.DHCP,
+		DNS:          body.DNS,
+		DNSZone:      body.DNSZone,
+		ExitNodes:    body.ExitNodes,
+		IPAM:         body.IPAM,
+		MTU:          body.MTU,
+		Nodes:        body.Nodes,
+		Peers:        body.Peers,
+		ReverseDNS:   body.ReverseDNS,
+		Tag:          body.Tag,
+		VLANProtocol: body.VLANProtocol,
+		VRFVXLAN:     body.VRFVXLAN,
+	}
+
+	err = veClient.UpdateSDNZone(d.Id(), updateBody)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentSDNZoneRead(d, m)
+}
+
+func resourceVirtualEnvironmentSDNZoneDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteSDNZone(d.Id())
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "does not exist") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSDNZoneInstantiation tests whether the ResourceVirtualEnvironmentSDNZone instance can be instantiated.
+func TestResourceVirtualEnvironmentSDNZoneInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNZone()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSDNZone")
+	}
+}
+
+// TestResourceVirtualEnvironmentSDNZoneSchema tests the resourceVirtualEnvironmentSDNZone schema.
+func TestResourceVirtualEnvironmentSDNZoneSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSDNZone()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNZoneType,
+		mkResourceVirtualEnvironmentSDNZoneZoneID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSDNZoneBridge,
+		mkResourceVirtualEnvironmentSDNZoneController,
+		mkResourceVirtualEnvironmentSDNZoneDHCP,
+		mkResourceVirtualEnvironmentSDNZoneDNS,
+		mkResourceVirtualEnvironmentSDNZoneDNSZone,
+		mkResourceVirtualEnvironmentSDNZoneExitNodes,
+		mkResourceVirtualEnvironmentSDNZoneIPAM,
+		mkResourceVirtualEnvironmentSDNZoneMTU,
+		mkResourceVirtualEnvironmentSDNZoneNodes,
+		mkResourceVirtualEnvironmentSDNZonePeers,
+		mkResourceVirtualEnvironmentSDNZoneReverseDNS,
+		mkResourceVirtualEnvironmentSDNZoneTag,
+		mkResourceVirtualEnvironmentSDNZoneVLANProtocol,
+		mkResourceVirtualEnvironmentSDNZoneVRFVXLAN,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentSDNZoneDigest,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSDNZoneBridge:       schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneController:   schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneDHCP:         schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneDigest:       schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneDNS:          schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneDNSZone:      schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneExitNodes:    schema.TypeList,
+		mkResourceVirtualEnvironmentSDNZoneIPAM:         schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneMTU:          schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNZoneNodes:        schema.TypeList,
+		mkResourceVirtualEnvironmentSDNZonePeers:        schema.TypeList,
+		mkResourceVirtualEnvironmentSDNZoneReverseDNS:   schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneTag:          schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNZoneType:         schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneVLANProtocol: schema.TypeString,
+		mkResourceVirtualEnvironmentSDNZoneVRFVXLAN:     schema.TypeInt,
+		mkResourceVirtualEnvironmentSDNZoneZoneID:       schema.TypeString,
+	})
+}
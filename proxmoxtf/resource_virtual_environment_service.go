@@ -0,0 +1,155 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentServiceStarted = true
+	dvResourceVirtualEnvironmentServiceTimeout = 60
+
+	mkResourceVirtualEnvironmentServiceDescription = "description"
+	mkResourceVirtualEnvironmentServiceName        = "name"
+	mkResourceVirtualEnvironmentServiceNodeName    = "node_name"
+	mkResourceVirtualEnvironmentServiceStarted     = "started"
+	mkResourceVirtualEnvironmentServiceState       = "state"
+	mkResourceVirtualEnvironmentServiceUnitState   = "unit_state"
+)
+
+func resourceVirtualEnvironmentService() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentServiceDescription: {
+				Type:        schema.TypeString,
+				Description: "The service description",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentServiceName: {
+				Type:        schema.TypeString,
+				Description: "The service name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentServiceNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentServiceStarted: {
+				Type:        schema.TypeBool,
+				Description: "Whether the service is running",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentServiceStarted,
+			},
+			mkResourceVirtualEnvironmentServiceState: {
+				Type:        schema.TypeString,
+				Description: "The service state",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentServiceUnitState: {
+				Type:        schema.TypeString,
+				Description: "The state of the systemd unit",
+				Computed:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentServiceCreate,
+		Read:   resourceVirtualEnvironmentServiceRead,
+		Update: resourceVirtualEnvironmentServiceUpdate,
+		Delete: resourceVirtualEnvironmentServiceDelete,
+	}
+}
+
+func resourceVirtualEnvironmentServiceCreate(d *schema.ResourceData, m interface{}) error {
+	name := d.Get(mkResourceVirtualEnvironmentServiceName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentServiceNodeName).(string)
+
+	d.SetId(fmt.Sprintf("%s_%s", nodeName, name))
+
+	return resourceVirtualEnvironmentServiceUpdate(d, m)
+}
+
+func resourceVirtualEnvironmentServiceRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentServiceName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentServiceNodeName).(string)
+
+	service, err := veClient.GetService(nodeName, name)
+
+	if err != nil {
+		return err
+	}
+
+	if service.Description != nil {
+		d.Set(mkResourceVirtualEnvironmentServiceDescription, *service.Description)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentServiceDescription, "")
+	}
+
+	if service.State != nil {
+		d.Set(mkResourceVirtualEnvironmentServiceStarted, *service.State == "running")
+		d.Set(mkResourceVirtualEnvironmentServiceState, *service.State)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentServiceStarted, false)
+		d.Set(mkResourceVirtualEnvironmentServiceState, "")
+	}
+
+	if service.UnitState != nil {
+		d.Set(mkResourceVirtualEnvironmentServiceUnitState, *service.UnitState)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentServiceUnitState, "")
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentServiceUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get(mkResourceVirtualEnvironmentServiceName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentServiceNodeName).(string)
+	started := d.Get(mkResourceVirtualEnvironmentServiceStarted).(bool)
+
+	service, err := veClient.GetService(nodeName, name)
+
+	if err != nil {
+		return err
+	}
+
+	running := service.State != nil && *service.State == "running"
+
+	if started && !running {
+		err = veClient.ExecuteServiceCommand(nodeName, name, "start", dvResourceVirtualEnvironmentServiceTimeout)
+	} else if !started && running {
+		err = veClient.ExecuteServiceCommand(nodeName, name, "stop", dvResourceVirtualEnvironmentServiceTimeout)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentServiceRead(d, m)
+}
+
+func resourceVirtualEnvironmentServiceDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentServiceInstantiation tests whether the ResourceVirtualEnvironmentService instance can be instantiated.
+func TestResourceVirtualEnvironmentServiceInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentService()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentService")
+	}
+}
+
+// TestResourceVirtualEnvironmentServiceSchema tests the resourceVirtualEnvironmentService schema.
+func TestResourceVirtualEnvironmentServiceSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentService()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentServiceName,
+		mkResourceVirtualEnvironmentServiceNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentServiceStarted,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentServiceDescription,
+		mkResourceVirtualEnvironmentServiceState,
+		mkResourceVirtualEnvironmentServiceUnitState,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentServiceDescription: schema.TypeString,
+		mkResourceVirtualEnvironmentServiceName:        schema.TypeString,
+		mkResourceVirtualEnvironmentServiceNodeName:    schema.TypeString,
+		mkResourceVirtualEnvironmentServiceStarted:     schema.TypeBool,
+		mkResourceVirtualEnvironmentServiceState:       schema.TypeString,
+		mkResourceVirtualEnvironmentServiceUnitState:   schema.TypeString,
+	})
+}
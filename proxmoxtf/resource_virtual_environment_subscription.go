@@ -0,0 +1,201 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	mkResourceVirtualEnvironmentSubscriptionKey         = "key"
+	mkResourceVirtualEnvironmentSubscriptionLevel       = "level"
+	mkResourceVirtualEnvironmentSubscriptionNextDueDate = "next_due_date"
+	mkResourceVirtualEnvironmentSubscriptionNodeName    = "node_name"
+	mkResourceVirtualEnvironmentSubscriptionProductName = "product_name"
+	mkResourceVirtualEnvironmentSubscriptionServerID    = "server_id"
+	mkResourceVirtualEnvironmentSubscriptionSockets     = "sockets"
+	mkResourceVirtualEnvironmentSubscriptionStatus      = "status"
+)
+
+func resourceVirtualEnvironmentSubscription() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentSubscriptionKey: {
+				Type:        schema.TypeString,
+				Description: "The subscription key",
+				Required:    true,
+				Sensitive:   true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionLevel: {
+				Type:        schema.TypeString,
+				Description: "The subscription level",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionNextDueDate: {
+				Type:        schema.TypeString,
+				Description: "The next due date",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionProductName: {
+				Type:        schema.TypeString,
+				Description: "The product name",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionServerID: {
+				Type:        schema.TypeString,
+				Description: "The server id",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionSockets: {
+				Type:        schema.TypeInt,
+				Description: "The number of sockets covered by the subscription",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentSubscriptionStatus: {
+				Type:        schema.TypeString,
+				Description: "The subscription status",
+				Computed:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentSubscriptionCreate,
+		Read:   resourceVirtualEnvironmentSubscriptionRead,
+		Update: resourceVirtualEnvironmentSubscriptionUpdate,
+		Delete: resourceVirtualEnvironmentSubscriptionDelete,
+	}
+}
+
+func resourceVirtualEnvironmentSubscriptionCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentSubscriptionUpdate(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentSubscriptionNodeName).(string)
+
+	d.SetId(fmt.Sprintf("%s_subscription", nodeName))
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSubscriptionRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentSubscriptionNodeName).(string)
+	subscription, err := veClient.GetSubscription(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	if subscription.Key == nil || subscription.Status == "notfound" {
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSubscriptionKey, *subscription.Key)
+
+	if subscription.Level != nil {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionLevel, *subscription.Level)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionLevel, "")
+	}
+
+	if subscription.NextDueDate != nil {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionNextDueDate, *subscription.NextDueDate)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionNextDueDate, "")
+	}
+
+	if subscription.ProductName != nil {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionProductName, *subscription.ProductName)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionProductName, "")
+	}
+
+	if subscription.ServerID != nil {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionServerID, *subscription.ServerID)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionServerID, "")
+	}
+
+	if subscription.Sockets != nil {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionSockets, *subscription.Sockets)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentSubscriptionSockets, 0)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentSubscriptionStatus, subscription.Status)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentSubscriptionUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	key := d.Get(mkResourceVirtualEnvironmentSubscriptionKey).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentSubscriptionNodeName).(string)
+
+	err = veClient.UpdateSubscription(nodeName, &proxmox.VirtualEnvironmentSubscriptionUpdateRequestBody{
+		Key: key,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Refresh the subscription information in order to report the actual status of the new key.
+	force := proxmox.CustomBool(true)
+
+	err = veClient.CheckSubscription(nodeName, &proxmox.VirtualEnvironmentSubscriptionCheckRequestBody{
+		Force: &force,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentSubscriptionRead(d, m)
+}
+
+func resourceVirtualEnvironmentSubscriptionDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentSubscriptionNodeName).(string)
+	err = veClient.DeleteSubscription(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
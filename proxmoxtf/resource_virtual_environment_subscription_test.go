@@ -0,0 +1,50 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentSubscriptionInstantiation tests whether the ResourceVirtualEnvironmentSubscription instance can be instantiated.
+func TestResourceVirtualEnvironmentSubscriptionInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentSubscription()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentSubscription")
+	}
+}
+
+// TestResourceVirtualEnvironmentSubscriptionSchema tests the resourceVirtualEnvironmentSubscription schema.
+func TestResourceVirtualEnvironmentSubscriptionSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentSubscription()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentSubscriptionKey,
+		mkResourceVirtualEnvironmentSubscriptionNodeName,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentSubscriptionLevel,
+		mkResourceVirtualEnvironmentSubscriptionNextDueDate,
+		mkResourceVirtualEnvironmentSubscriptionProductName,
+		mkResourceVirtualEnvironmentSubscriptionServerID,
+		mkResourceVirtualEnvironmentSubscriptionSockets,
+		mkResourceVirtualEnvironmentSubscriptionStatus,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentSubscriptionKey:         schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionLevel:       schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionNextDueDate: schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionNodeName:    schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionProductName: schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionServerID:    schema.TypeString,
+		mkResourceVirtualEnvironmentSubscriptionSockets:     schema.TypeInt,
+		mkResourceVirtualEnvironmentSubscriptionStatus:      schema.TypeString,
+	})
+}
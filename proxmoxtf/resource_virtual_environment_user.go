@@ -14,6 +14,7 @@ import (
 )
 
 const (
+	dvResourceVirtualEnvironmentUserACLMode   = "authoritative"
 	dvResourceVirtualEnvironmentUserComment   = ""
 	dvResourceVirtualEnvironmentUserEmail     = ""
 	dvResourceVirtualEnvironmentUserEnabled   = true
@@ -22,6 +23,7 @@ const (
 	dvResourceVirtualEnvironmentUserLastName  = ""
 
 	mkResourceVirtualEnvironmentUserACL            = "acl"
+	mkResourceVirtualEnvironmentUserACLMode        = "acl_mode"
 	mkResourceVirtualEnvironmentUserACLPath        = "path"
 	mkResourceVirtualEnvironmentUserACLPropagate   = "propagate"
 	mkResourceVirtualEnvironmentUserACLRoleID      = "role_id"
@@ -68,6 +70,13 @@ func resourceVirtualEnvironmentUser() *schema.Resource {
 					},
 				},
 			},
+			mkResourceVirtualEnvironmentUserACLMode: {
+				Type:         schema.TypeString,
+				Description:  "The access control list mode",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentUserACLMode,
+				ValidateFunc: getACLModeValidator(),
+			},
 			mkResourceVirtualEnvironmentUserComment: {
 				Type:        schema.TypeString,
 				Description: "The user comment",
@@ -216,6 +225,40 @@ func resourceVirtualEnvironmentUserCreate(d *schema.ResourceData, m interface{})
 	return resourceVirtualEnvironmentUserRead(d, m)
 }
 
+// resourceVirtualEnvironmentUserGetACL retrieves the access control list entries of a user.
+// Only the entries declared in the configuration are returned in additive mode, in order to coexist with proxmox_virtual_environment_acl.
+func resourceVirtualEnvironmentUserGetACL(d *schema.ResourceData, acl []*proxmox.VirtualEnvironmentACLGetResponseData, userID string) []interface{} {
+	additive := d.Get(mkResourceVirtualEnvironmentUserACLMode).(string) == "additive"
+	aclManaged := map[string]bool{}
+
+	for _, v := range d.Get(mkResourceVirtualEnvironmentUserACL).(*schema.Set).List() {
+		aclEntry := v.(map[string]interface{})
+		aclManaged[aclEntry[mkResourceVirtualEnvironmentUserACLPath].(string)+"|"+aclEntry[mkResourceVirtualEnvironmentUserACLRoleID].(string)] = true
+	}
+
+	aclParsed := []interface{}{}
+
+	for _, v := range acl {
+		if v.Type == "user" && v.UserOrGroupID == userID && (!additive || aclManaged[v.Path+"|"+v.RoleID]) {
+			aclEntry := map[string]interface{}{}
+
+			aclEntry[mkResourceVirtualEnvironmentUserACLPath] = v.Path
+
+			if v.Propagate != nil {
+				aclEntry[mkResourceVirtualEnvironmentUserACLPropagate] = bool(*v.Propagate)
+			} else {
+				aclEntry[mkResourceVirtualEnvironmentUserACLPropagate] = false
+			}
+
+			aclEntry[mkResourceVirtualEnvironmentUserACLRoleID] = v.RoleID
+
+			aclParsed = append(aclParsed, aclEntry)
+		}
+	}
+
+	return aclParsed
+}
+
 func resourceVirtualEnvironmentUserRead(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
@@ -243,27 +286,12 @@ func resourceVirtualEnvironmentUserRead(d *schema.ResourceData, m interface{}) e
 		return err
 	}
 
-	aclParsed := []interface{}{}
-
-	for _, v := range acl {
-		if v.Type == "user" && v.UserOrGroupID == userID {
-			aclEntry := map[string]interface{}{}
-
-			aclEntry[mkResourceVirtualEnvironmentUserACLPath] = v.Path
-
-			if v.Propagate != nil {
-				aclEntry[mkResourceVirtualEnvironmentUserACLPropagate] = bool(*v.Propagate)
-			} else {
-				aclEntry[mkResourceVirtualEnvironmentUserACLPropagate] = false
-			}
-
-			aclEntry[mkResourceVirtualEnvironmentUserACLRoleID] = v.RoleID
-
-			aclParsed = append(aclParsed, aclEntry)
-		}
+	// Resources which have been imported lack the mode, in which case the default one applies.
+	if d.Get(mkResourceVirtualEnvironmentUserACLMode).(string) == "" {
+		d.Set(mkResourceVirtualEnvironmentUserACLMode, dvResourceVirtualEnvironmentUserACLMode)
 	}
 
-	d.Set(mkResourceVirtualEnvironmentUserACL, aclParsed)
+	d.Set(mkResourceVirtualEnvironmentUserACL, resourceVirtualEnvironmentUserGetACL(d, acl, userID))
 
 	if user.Comment != nil {
 		d.Set(mkResourceVirtualEnvironmentUserComment, user.Comment)
@@ -7,7 +7,9 @@ package proxmoxtf
 import (
 	"testing"
 
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // TestResourceVirtualEnvironmentUserInstantiation tests whether the ResourceVirtualEnvironmentUser instance can be instantiated.
@@ -30,6 +32,7 @@ func TestResourceVirtualEnvironmentUserSchema(t *testing.T) {
 
 	testOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentUserACL,
+		mkResourceVirtualEnvironmentUserACLMode,
 		mkResourceVirtualEnvironmentUserComment,
 		mkResourceVirtualEnvironmentUserEmail,
 		mkResourceVirtualEnvironmentUserEnabled,
@@ -42,6 +45,7 @@ func TestResourceVirtualEnvironmentUserSchema(t *testing.T) {
 
 	testValueTypes(t, s, map[string]schema.ValueType{
 		mkResourceVirtualEnvironmentUserACL:            schema.TypeSet,
+		mkResourceVirtualEnvironmentUserACLMode:        schema.TypeString,
 		mkResourceVirtualEnvironmentUserComment:        schema.TypeString,
 		mkResourceVirtualEnvironmentUserEmail:          schema.TypeString,
 		mkResourceVirtualEnvironmentUserEnabled:        schema.TypeBool,
@@ -71,3 +75,48 @@ func TestResourceVirtualEnvironmentUserSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentUserACLRoleID:    schema.TypeString,
 	})
 }
+
+// TestResourceVirtualEnvironmentUserGetACL tests whether the access control list entries are filtered according to the mode.
+func TestResourceVirtualEnvironmentUserGetACL(t *testing.T) {
+	propagate := proxmox.CustomBool(true)
+	acl := []*proxmox.VirtualEnvironmentACLGetResponseData{
+		{Path: "/vms/1234", Propagate: &propagate, RoleID: "PVEVMUser", Type: "user", UserOrGroupID: "operations-automation@pve"},
+		{Path: "/storage", RoleID: "PVEDatastoreUser", Type: "user", UserOrGroupID: "operations-automation@pve"},
+		{Path: "/vms/1234", RoleID: "PVEVMUser", Type: "group", UserOrGroupID: "operations-automation@pve"},
+		{Path: "/", RoleID: "PVEAuditor", Type: "user", UserOrGroupID: "other"},
+	}
+
+	s := resourceVirtualEnvironmentUser()
+
+	// Imported resources have an empty state, which must not cause the existing entries to be dropped.
+	d := s.Data(&terraform.InstanceState{ID: "operations-automation@pve"})
+
+	if v := resourceVirtualEnvironmentUserGetACL(d, acl, "operations-automation@pve"); len(v) != 2 {
+		t.Fatalf("Expected 2 entries for an imported resource but got %d", len(v))
+	}
+
+	d = s.Data(nil)
+	d.Set(mkResourceVirtualEnvironmentUserACL, []interface{}{
+		map[string]interface{}{
+			mkResourceVirtualEnvironmentUserACLPath:      "/vms/1234",
+			mkResourceVirtualEnvironmentUserACLPropagate: true,
+			mkResourceVirtualEnvironmentUserACLRoleID:    "PVEVMUser",
+		},
+	})
+
+	if v := resourceVirtualEnvironmentUserGetACL(d, acl, "operations-automation@pve"); len(v) != 2 {
+		t.Fatalf("Expected 2 entries in authoritative mode but got %d", len(v))
+	}
+
+	d.Set(mkResourceVirtualEnvironmentUserACLMode, "additive")
+
+	v := resourceVirtualEnvironmentUserGetACL(d, acl, "operations-automation@pve")
+
+	if len(v) != 1 {
+		t.Fatalf("Expected 1 entry in additive mode but got %d", len(v))
+	}
+
+	if v[0].(map[string]interface{})[mkResourceVirtualEnvironmentUserACLPath] != "/vms/1234" {
+		t.Fatalf("Expected the declared entry in additive mode but got %v", v[0])
+	}
+}
@@ -0,0 +1,268 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentUserTokenComment              = ""
+	dvResourceVirtualEnvironmentUserTokenPrivilegesSeparation = true
+
+	mkResourceVirtualEnvironmentUserTokenComment              = "comment"
+	mkResourceVirtualEnvironmentUserTokenExpirationDate       = "expiration_date"
+	mkResourceVirtualEnvironmentUserTokenID                   = "token_id"
+	mkResourceVirtualEnvironmentUserTokenName                 = "token_name"
+	mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation = "privileges_separation"
+	mkResourceVirtualEnvironmentUserTokenSecret               = "secret"
+	mkResourceVirtualEnvironmentUserTokenUserID               = "user_id"
+)
+
+func resourceVirtualEnvironmentUserToken() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentUserTokenComment: {
+				Type:        schema.TypeString,
+				Description: "The token comment",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentUserTokenComment,
+			},
+			mkResourceVirtualEnvironmentUserTokenExpirationDate: {
+				Type:         schema.TypeString,
+				Description:  "The token's expiration date",
+				Optional:     true,
+				Default:      time.Unix(0, 0).UTC().Format(time.RFC3339),
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			mkResourceVirtualEnvironmentUserTokenID: {
+				Type:        schema.TypeString,
+				Description: "The full token id",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentUserTokenName: {
+				Type:         schema.TypeString,
+				Description:  "The token name",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getUserTokenNameValidator(),
+			},
+			mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation: {
+				Type:        schema.TypeBool,
+				Description: "Whether to restrict the token to its own access control list entries",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentUserTokenPrivilegesSeparation,
+			},
+			mkResourceVirtualEnvironmentUserTokenSecret: {
+				Type:        schema.TypeString,
+				Description: "The token secret",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			mkResourceVirtualEnvironmentUserTokenUserID: {
+				Type:        schema.TypeString,
+				Description: "The user id",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentUserTokenCreate,
+		Read:   resourceVirtualEnvironmentUserTokenRead,
+		Update: resourceVirtualEnvironmentUserTokenUpdate,
+		Delete: resourceVirtualEnvironmentUserTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVirtualEnvironmentUserTokenImport,
+		},
+	}
+}
+
+func resourceVirtualEnvironmentUserTokenCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentUserTokenComment).(string)
+	expirationDate, err := time.Parse(time.RFC3339, d.Get(mkResourceVirtualEnvironmentUserTokenExpirationDate).(string))
+
+	if err != nil {
+		return err
+	}
+
+	expirationDateCustom := proxmox.CustomTimestamp(expirationDate)
+	privilegesSeparation := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation).(bool))
+	tokenName := d.Get(mkResourceVirtualEnvironmentUserTokenName).(string)
+	userID := d.Get(mkResourceVirtualEnvironmentUserTokenUserID).(string)
+
+	body := &proxmox.VirtualEnvironmentUserTokenCreateRequestBody{
+		Comment:              &comment,
+		ExpirationDate:       &expirationDateCustom,
+		PrivilegesSeparation: &privilegesSeparation,
+	}
+
+	token, err := veClient.CreateUserToken(userID, tokenName, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s!%s", userID, tokenName))
+
+	// The secret is only included in the create response, which is why it must never be overwritten by a refresh.
+	d.Set(mkResourceVirtualEnvironmentUserTokenSecret, token.Value)
+
+	return resourceVirtualEnvironmentUserTokenRead(d, m)
+}
+
+func resourceVirtualEnvironmentUserTokenImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userID, tokenName, err := resourceVirtualEnvironmentUserTokenParseID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(mkResourceVirtualEnvironmentUserTokenName, tokenName)
+	d.Set(mkResourceVirtualEnvironmentUserTokenUserID, userID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVirtualEnvironmentUserTokenParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "!", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("The identifier must be of the form \"user@realm!token\"")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceVirtualEnvironmentUserTokenRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tokenName, err := resourceVirtualEnvironmentUserTokenParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	token, err := veClient.GetUserToken(userID, tokenName)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such token") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if token.Comment != nil {
+		d.Set(mkResourceVirtualEnvironmentUserTokenComment, token.Comment)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentUserTokenComment, "")
+	}
+
+	if token.ExpirationDate != nil {
+		d.Set(mkResourceVirtualEnvironmentUserTokenExpirationDate, time.Time(*token.ExpirationDate).UTC().Format(time.RFC3339))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentUserTokenExpirationDate, time.Unix(0, 0).UTC().Format(time.RFC3339))
+	}
+
+	d.Set(mkResourceVirtualEnvironmentUserTokenID, d.Id())
+
+	if token.PrivilegesSeparation != nil {
+		d.Set(mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation, bool(*token.PrivilegesSeparation))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation, false)
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentUserTokenUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tokenName, err := resourceVirtualEnvironmentUserTokenParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	comment := d.Get(mkResourceVirtualEnvironmentUserTokenComment).(string)
+	expirationDate, err := time.Parse(time.RFC3339, d.Get(mkResourceVirtualEnvironmentUserTokenExpirationDate).(string))
+
+	if err != nil {
+		return err
+	}
+
+	expirationDateCustom := proxmox.CustomTimestamp(expirationDate)
+	privilegesSeparation := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation).(bool))
+
+	body := &proxmox.VirtualEnvironmentUserTokenUpdateRequestBody{
+		Comment:              &comment,
+		ExpirationDate:       &expirationDateCustom,
+		PrivilegesSeparation: &privilegesSeparation,
+	}
+
+	err = veClient.UpdateUserToken(userID, tokenName, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentUserTokenRead(d, m)
+}
+
+func resourceVirtualEnvironmentUserTokenDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tokenName, err := resourceVirtualEnvironmentUserTokenParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = veClient.DeleteUserToken(userID, tokenName)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
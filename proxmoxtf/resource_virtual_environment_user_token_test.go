@@ -0,0 +1,51 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentUserTokenInstantiation tests whether the ResourceVirtualEnvironmentUserToken instance can be instantiated.
+func TestResourceVirtualEnvironmentUserTokenInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentUserToken()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentUserToken")
+	}
+}
+
+// TestResourceVirtualEnvironmentUserTokenSchema tests the resourceVirtualEnvironmentUserToken schema.
+func TestResourceVirtualEnvironmentUserTokenSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentUserToken()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentUserTokenName,
+		mkResourceVirtualEnvironmentUserTokenUserID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentUserTokenComment,
+		mkResourceVirtualEnvironmentUserTokenExpirationDate,
+		mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentUserTokenID,
+		mkResourceVirtualEnvironmentUserTokenSecret,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentUserTokenComment:              schema.TypeString,
+		mkResourceVirtualEnvironmentUserTokenExpirationDate:       schema.TypeString,
+		mkResourceVirtualEnvironmentUserTokenID:                   schema.TypeString,
+		mkResourceVirtualEnvironmentUserTokenName:                 schema.TypeString,
+		mkResourceVirtualEnvironmentUserTokenPrivilegesSeparation: schema.TypeBool,
+		mkResourceVirtualEnvironmentUserTokenSecret:               schema.TypeString,
+		mkResourceVirtualEnvironmentUserTokenUserID:               schema.TypeString,
+	})
+}
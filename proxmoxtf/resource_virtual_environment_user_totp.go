@@ -0,0 +1,267 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	dvResourceVirtualEnvironmentUserTOTPDescription = ""
+	dvResourceVirtualEnvironmentUserTOTPEnabled     = true
+	dvResourceVirtualEnvironmentUserTOTPIssuer      = "Proxmox VE"
+
+	mkResourceVirtualEnvironmentUserTOTPDescription     = "description"
+	mkResourceVirtualEnvironmentUserTOTPEnabled         = "enabled"
+	mkResourceVirtualEnvironmentUserTOTPIssuer          = "issuer"
+	mkResourceVirtualEnvironmentUserTOTPProvisioningURI = "provisioning_uri"
+	mkResourceVirtualEnvironmentUserTOTPSecret          = "secret"
+	mkResourceVirtualEnvironmentUserTOTPTFAID           = "tfa_id"
+	mkResourceVirtualEnvironmentUserTOTPUserID          = "user_id"
+)
+
+func resourceVirtualEnvironmentUserTOTP() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentUserTOTPDescription: {
+				Type:        schema.TypeString,
+				Description: "The description",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentUserTOTPDescription,
+			},
+			mkResourceVirtualEnvironmentUserTOTPEnabled: {
+				Type:        schema.TypeBool,
+				Description: "Whether the second factor is enabled",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentUserTOTPEnabled,
+			},
+			mkResourceVirtualEnvironmentUserTOTPIssuer: {
+				Type:        schema.TypeString,
+				Description: "The issuer included in the provisioning URI",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     dvResourceVirtualEnvironmentUserTOTPIssuer,
+			},
+			mkResourceVirtualEnvironmentUserTOTPProvisioningURI: {
+				Type:        schema.TypeString,
+				Description: "The provisioning URI for authenticator apps",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			mkResourceVirtualEnvironmentUserTOTPSecret: {
+				Type:         schema.TypeString,
+				Description:  "The base32 encoded secret",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: getTOTPSecretValidator(),
+			},
+			mkResourceVirtualEnvironmentUserTOTPTFAID: {
+				Type:        schema.TypeString,
+				Description: "The second factor id",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentUserTOTPUserID: {
+				Type:        schema.TypeString,
+				Description: "The user id",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+		Create: resourceVirtualEnvironmentUserTOTPCreate,
+		Read:   resourceVirtualEnvironmentUserTOTPRead,
+		Update: resourceVirtualEnvironmentUserTOTPUpdate,
+		Delete: resourceVirtualEnvironmentUserTOTPDelete,
+	}
+}
+
+func resourceVirtualEnvironmentUserTOTPCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	description := d.Get(mkResourceVirtualEnvironmentUserTOTPDescription).(string)
+	enabled := d.Get(mkResourceVirtualEnvironmentUserTOTPEnabled).(bool)
+	issuer := d.Get(mkResourceVirtualEnvironmentUserTOTPIssuer).(string)
+	secret := d.Get(mkResourceVirtualEnvironmentUserTOTPSecret).(string)
+	userID := d.Get(mkResourceVirtualEnvironmentUserTOTPUserID).(string)
+
+	if secret == "" {
+		secret, err = proxmox.GenerateTOTPSecret()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// The server verifies the secret by requiring the current code to be included in the request.
+	code, err := proxmox.GenerateTOTPCode(secret, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	provisioningURI := proxmox.GetTOTPProvisioningURI(issuer, userID, secret)
+
+	body := &proxmox.VirtualEnvironmentTFACreateRequestBody{
+		Description: &description,
+		Password:    &veClient.Password,
+		TOTP:        &provisioningURI,
+		Type:        "totp",
+		Value:       &code,
+	}
+
+	tfa, err := veClient.CreateUserTFA(userID, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", userID, tfa.ID))
+
+	d.Set(mkResourceVirtualEnvironmentUserTOTPProvisioningURI, provisioningURI)
+	d.Set(mkResourceVirtualEnvironmentUserTOTPSecret, secret)
+
+	if !enabled {
+		err = resourceVirtualEnvironmentUserTOTPUpdate(d, m)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceVirtualEnvironmentUserTOTPRead(d, m)
+}
+
+func resourceVirtualEnvironmentUserTOTPParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "|", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("The identifier must be of the form \"user@realm|id\"")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceVirtualEnvironmentUserTOTPRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tfaID, err := resourceVirtualEnvironmentUserTOTPParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tfa, err := veClient.GetUserTFA(userID, tfaID)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") || strings.Contains(err.Error(), "no such") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	if tfa.Description != nil {
+		d.Set(mkResourceVirtualEnvironmentUserTOTPDescription, tfa.Description)
+	} else {
+		d.Set(mkResourceVirtualEnvironmentUserTOTPDescription, "")
+	}
+
+	if tfa.Enabled != nil {
+		d.Set(mkResourceVirtualEnvironmentUserTOTPEnabled, bool(*tfa.Enabled))
+	} else {
+		d.Set(mkResourceVirtualEnvironmentUserTOTPEnabled, true)
+	}
+
+	d.Set(mkResourceVirtualEnvironmentUserTOTPTFAID, tfa.ID)
+	d.Set(mkResourceVirtualEnvironmentUserTOTPUserID, userID)
+
+	return nil
+}
+
+func resourceVirtualEnvironmentUserTOTPUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tfaID, err := resourceVirtualEnvironmentUserTOTPParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	description := d.Get(mkResourceVirtualEnvironmentUserTOTPDescription).(string)
+	enabled := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentUserTOTPEnabled).(bool))
+
+	body := &proxmox.VirtualEnvironmentTFAUpdateRequestBody{
+		Description: &description,
+		Enabled:     &enabled,
+		Password:    &veClient.Password,
+	}
+
+	err = veClient.UpdateUserTFA(userID, tfaID, body)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentUserTOTPRead(d, m)
+}
+
+func resourceVirtualEnvironmentUserTOTPDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	userID, tfaID, err := resourceVirtualEnvironmentUserTOTPParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	body := &proxmox.VirtualEnvironmentTFADeleteRequestBody{
+		Password: &veClient.Password,
+	}
+
+	err = veClient.DeleteUserTFA(userID, tfaID, body)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") {
+			d.SetId("")
+
+			return nil
+		}
+
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
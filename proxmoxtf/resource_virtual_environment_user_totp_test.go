@@ -0,0 +1,83 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/danitso/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentUserTOTPInstantiation tests whether the ResourceVirtualEnvironmentUserTOTP instance can be instantiated.
+func TestResourceVirtualEnvironmentUserTOTPInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentUserTOTP()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentUserTOTP")
+	}
+}
+
+// TestResourceVirtualEnvironmentUserTOTPSchema tests the resourceVirtualEnvironmentUserTOTP schema.
+func TestResourceVirtualEnvironmentUserTOTPSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentUserTOTP()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentUserTOTPUserID,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentUserTOTPDescription,
+		mkResourceVirtualEnvironmentUserTOTPEnabled,
+		mkResourceVirtualEnvironmentUserTOTPIssuer,
+		mkResourceVirtualEnvironmentUserTOTPSecret,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentUserTOTPProvisioningURI,
+		mkResourceVirtualEnvironmentUserTOTPSecret,
+		mkResourceVirtualEnvironmentUserTOTPTFAID,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentUserTOTPDescription:     schema.TypeString,
+		mkResourceVirtualEnvironmentUserTOTPEnabled:         schema.TypeBool,
+		mkResourceVirtualEnvironmentUserTOTPIssuer:          schema.TypeString,
+		mkResourceVirtualEnvironmentUserTOTPProvisioningURI: schema.TypeString,
+		mkResourceVirtualEnvironmentUserTOTPSecret:          schema.TypeString,
+		mkResourceVirtualEnvironmentUserTOTPTFAID:           schema.TypeString,
+		mkResourceVirtualEnvironmentUserTOTPUserID:          schema.TypeString,
+	})
+}
+
+// TestResourceVirtualEnvironmentUserTOTPCode tests the codes generated for the RFC 6238 test vectors.
+func TestResourceVirtualEnvironmentUserTOTPCode(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for k, v := range vectors {
+		code, err := proxmox.GenerateTOTPCode(secret, time.Unix(k, 0))
+
+		if err != nil {
+			t.Fatalf("Failed to generate TOTP code for %d - Reason: %s", k, err.Error())
+		}
+
+		if code != v {
+			t.Fatalf("Expected TOTP code for %d to be %s, got %s", k, v, code)
+		}
+	}
+
+	_, err := proxmox.GenerateTOTPCode("not-base32!", time.Now())
+
+	if err == nil {
+		t.Fatalf("Expected TOTP code generation to fail for an invalid secret")
+	}
+}
@@ -72,11 +72,17 @@ const (
 	dvResourceVirtualEnvironmentVMNetworkDeviceRateLimit            = 0
 	dvResourceVirtualEnvironmentVMNetworkDeviceVLANID               = 0
 	dvResourceVirtualEnvironmentVMOperatingSystemType               = "other"
+	dvResourceVirtualEnvironmentVMPlacementDatastoreID              = ""
+	dvResourceVirtualEnvironmentVMPlacementStrategy                 = "memory"
 	dvResourceVirtualEnvironmentVMPoolID                            = ""
+	dvResourceVirtualEnvironmentVMRestoreDatastoreID                = ""
+	dvResourceVirtualEnvironmentVMRestoreLiveRestore                = false
+	dvResourceVirtualEnvironmentVMRestoreUnique                     = false
 	dvResourceVirtualEnvironmentVMSerialDeviceDevice                = "socket"
 	dvResourceVirtualEnvironmentVMStarted                           = true
 	dvResourceVirtualEnvironmentVMTabletDevice                      = true
 	dvResourceVirtualEnvironmentVMTemplate                          = false
+	dvResourceVirtualEnvironmentVMTimeoutRestore                    = "30m"
 	dvResourceVirtualEnvironmentVMVGAEnabled                        = true
 	dvResourceVirtualEnvironmentVMVGAMemory                         = 16
 	dvResourceVirtualEnvironmentVMVGAType                           = "std"
@@ -164,12 +170,23 @@ const (
 	mkResourceVirtualEnvironmentVMNodeName                          = "node_name"
 	mkResourceVirtualEnvironmentVMOperatingSystem                   = "operating_system"
 	mkResourceVirtualEnvironmentVMOperatingSystemType               = "type"
+	mkResourceVirtualEnvironmentVMPlacement                         = "placement"
+	mkResourceVirtualEnvironmentVMPlacementDatastoreID              = "datastore_id"
+	mkResourceVirtualEnvironmentVMPlacementNodes                    = "nodes"
+	mkResourceVirtualEnvironmentVMPlacementStrategy                 = "strategy"
 	mkResourceVirtualEnvironmentVMPoolID                            = "pool_id"
+	mkResourceVirtualEnvironmentVMRestore                           = "restore"
+	mkResourceVirtualEnvironmentVMRestoreBackupFile                 = "backup_file"
+	mkResourceVirtualEnvironmentVMRestoreDatastoreID                = "datastore_id"
+	mkResourceVirtualEnvironmentVMRestoreLiveRestore                = "live_restore"
+	mkResourceVirtualEnvironmentVMRestoreUnique                     = "unique"
 	mkResourceVirtualEnvironmentVMSerialDevice                      = "serial_device"
 	mkResourceVirtualEnvironmentVMSerialDeviceDevice                = "device"
 	mkResourceVirtualEnvironmentVMStarted                           = "started"
 	mkResourceVirtualEnvironmentVMTabletDevice                      = "tablet_device"
+	mkResourceVirtualEnvironmentVMTags                              = "tags"
 	mkResourceVirtualEnvironmentVMTemplate                          = "template"
+	mkResourceVirtualEnvironmentVMTimeoutRestore                    = "timeout_restore"
 	mkResourceVirtualEnvironmentVMVGA                               = "vga"
 	mkResourceVirtualEnvironmentVMVGAEnabled                        = "enabled"
 	mkResourceVirtualEnvironmentVMVGAMemory                         = "memory"
@@ -845,7 +862,8 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 			mkResourceVirtualEnvironmentVMNodeName: {
 				Type:        schema.TypeString,
 				Description: "The node name",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			mkResourceVirtualEnvironmentVMOperatingSystem: {
@@ -873,6 +891,43 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 				MaxItems: 1,
 				MinItems: 0,
 			},
+			mkResourceVirtualEnvironmentVMPlacement: {
+				Type:        schema.TypeList,
+				Description: "The placement configuration, which selects the node when node_name is omitted",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentVMPlacementDatastoreID: {
+							Type:        schema.TypeString,
+							Description: "The ID of a datastore, which the node must have access to",
+							Optional:    true,
+							Default:     dvResourceVirtualEnvironmentVMPlacementDatastoreID,
+						},
+						mkResourceVirtualEnvironmentVMPlacementNodes: {
+							Type:        schema.TypeList,
+							Description: "The names of the nodes to choose from",
+							Optional:    true,
+							DefaultFunc: func() (interface{}, error) {
+								return []interface{}{}, nil
+							},
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						mkResourceVirtualEnvironmentVMPlacementStrategy: {
+							Type:         schema.TypeString,
+							Description:  "The strategy for selecting the node",
+							Optional:     true,
+							Default:      dvResourceVirtualEnvironmentVMPlacementStrategy,
+							ValidateFunc: getPlacementStrategyValidator(),
+						},
+					},
+				},
+				MaxItems:      1,
+				MinItems:      0,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentVMNodeName},
+			},
 			mkResourceVirtualEnvironmentVMPoolID: {
 				Type:        schema.TypeString,
 				Description: "The ID of the pool to assign the virtual machine to",
@@ -880,6 +935,49 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 				ForceNew:    true,
 				Default:     dvResourceVirtualEnvironmentVMPoolID,
 			},
+			mkResourceVirtualEnvironmentVMRestore: {
+				Type:        schema.TypeList,
+				Description: "The restore configuration",
+				Optional:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkResourceVirtualEnvironmentVMRestoreBackupFile: {
+							Type:         schema.TypeString,
+							Description:  "The volume ID of the backup to restore",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: getFileIDValidator(),
+						},
+						mkResourceVirtualEnvironmentVMRestoreDatastoreID: {
+							Type:        schema.TypeString,
+							Description: "The ID of the target datastore",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentVMRestoreDatastoreID,
+						},
+						mkResourceVirtualEnvironmentVMRestoreLiveRestore: {
+							Type:        schema.TypeBool,
+							Description: "Whether to start the virtual machine while the restore is still in progress",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentVMRestoreLiveRestore,
+						},
+						mkResourceVirtualEnvironmentVMRestoreUnique: {
+							Type:        schema.TypeBool,
+							Description: "Whether to assign unique random ethernet addresses",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvResourceVirtualEnvironmentVMRestoreUnique,
+						},
+					},
+				},
+				MaxItems:      1,
+				MinItems:      0,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentVMClone},
+			},
 			mkResourceVirtualEnvironmentVMSerialDevice: {
 				Type:        schema.TypeList,
 				Description: "The serial devices",
@@ -920,6 +1018,16 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 				Optional:    true,
 				Default:     dvResourceVirtualEnvironmentVMTabletDevice,
 			},
+			mkResourceVirtualEnvironmentVMTags: {
+				Type:        schema.TypeSet,
+				Description: "The tags",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: getTagValidator(),
+				},
+				Set: getTagHash,
+			},
 			mkResourceVirtualEnvironmentVMTemplate: {
 				Type:        schema.TypeBool,
 				Description: "Whether to create a template",
@@ -927,6 +1035,13 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 				ForceNew:    true,
 				Default:     dvResourceVirtualEnvironmentVMTemplate,
 			},
+			mkResourceVirtualEnvironmentVMTimeoutRestore: {
+				Type:         schema.TypeString,
+				Description:  "The maximum amount of time to wait for a backup to be restored",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentVMTimeoutRestore,
+				ValidateFunc: getTimeoutValidator(),
+			},
 			mkResourceVirtualEnvironmentVMVGA: {
 				Type:        schema.TypeList,
 				Description: "The VGA configuration",
@@ -984,12 +1099,24 @@ func resourceVirtualEnvironmentVM() *schema.Resource {
 }
 
 func resourceVirtualEnvironmentVMCreate(d *schema.ResourceData, m interface{}) error {
+	err := resourceVirtualEnvironmentVMCreatePlacement(d, m)
+
+	if err != nil {
+		return err
+	}
+
 	clone := d.Get(mkResourceVirtualEnvironmentVMClone).([]interface{})
 
 	if len(clone) > 0 {
 		return resourceVirtualEnvironmentVMCreateClone(d, m)
 	}
 
+	restore := d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})
+
+	if len(restore) > 0 {
+		return resourceVirtualEnvironmentVMCreateRestore(d, m)
+	}
+
 	return resourceVirtualEnvironmentVMCreateCustom(d, m)
 }
 
@@ -1014,21 +1141,10 @@ func resourceVirtualEnvironmentVMCreateClone(d *schema.ResourceData, m interface
 	poolID := d.Get(mkResourceVirtualEnvironmentVMPoolID).(string)
 	vmID := d.Get(mkResourceVirtualEnvironmentVMVMID).(int)
 
-	if vmID == -1 {
-		vmIDNew, err := veClient.GetVMID()
-
-		if err != nil {
-			return err
-		}
-
-		vmID = *vmIDNew
-	}
-
 	fullCopy := proxmox.CustomBool(true)
 
 	cloneBody := &proxmox.VirtualEnvironmentVMCloneRequestBody{
 		FullCopy: &fullCopy,
-		VMIDNew:  vmID,
 	}
 
 	if cloneDatastoreID != "" {
@@ -1049,12 +1165,16 @@ func resourceVirtualEnvironmentVMCreateClone(d *schema.ResourceData, m interface
 
 	if cloneNodeName != "" && cloneNodeName != nodeName {
 		cloneBody.TargetNodeName = &nodeName
-
-		err = veClient.CloneVM(cloneNodeName, cloneVMID, cloneRetries, cloneBody)
 	} else {
-		err = veClient.CloneVM(nodeName, cloneVMID, cloneRetries, cloneBody)
+		cloneNodeName = nodeName
 	}
 
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		cloneBody.VMIDNew = vmID
+
+		return veClient.CloneVM(cloneNodeName, cloneVMID, cloneRetries, cloneBody)
+	})
+
 	if err != nil {
 		return err
 	}
@@ -1068,342 +1188,278 @@ func resourceVirtualEnvironmentVMCreateClone(d *schema.ResourceData, m interface
 		return err
 	}
 
-	// Now that the virtual machine has been cloned, we need to perform some modifications.
-	acpi := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMACPI).(bool))
-	agent := d.Get(mkResourceVirtualEnvironmentVMAgent).([]interface{})
-	audioDevices, err := resourceVirtualEnvironmentVMGetAudioDeviceList(d, m)
+	return resourceVirtualEnvironmentVMCreateReconfigure(d, m)
+}
+
+func resourceVirtualEnvironmentVMCreateCustom(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
 
 	if err != nil {
 		return err
 	}
 
-	bios := d.Get(mkResourceVirtualEnvironmentVMBIOS).(string)
-	cdrom := d.Get(mkResourceVirtualEnvironmentVMCDROM).([]interface{})
-	cpu := d.Get(mkResourceVirtualEnvironmentVMCPU).([]interface{})
-	initialization := d.Get(mkResourceVirtualEnvironmentVMInitialization).([]interface{})
-	keyboardLayout := d.Get(mkResourceVirtualEnvironmentVMKeyboardLayout).(string)
-	memory := d.Get(mkResourceVirtualEnvironmentVMMemory).([]interface{})
-	networkDevice := d.Get(mkResourceVirtualEnvironmentVMNetworkDevice).([]interface{})
-	operatingSystem := d.Get(mkResourceVirtualEnvironmentVMOperatingSystem).([]interface{})
-	serialDevice := d.Get(mkResourceVirtualEnvironmentVMSerialDevice).([]interface{})
-	onBoot := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMOnBoot).(bool))
-	tabletDevice := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTabletDevice).(bool))
-	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTemplate).(bool))
-	vga := d.Get(mkResourceVirtualEnvironmentVMVGA).([]interface{})
+	resource := resourceVirtualEnvironmentVM()
 
-	updateBody := &proxmox.VirtualEnvironmentVMUpdateRequestBody{
-		AudioDevices: audioDevices,
-	}
+	acpi := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMACPI).(bool))
 
-	delete := []string{}
+	agentBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMAgent}, 0, true)
 
-	if acpi != dvResourceVirtualEnvironmentVMACPI {
-		updateBody.ACPI = &acpi
+	if err != nil {
+		return err
 	}
 
-	if len(agent) > 0 {
-		agentBlock := agent[0].(map[string]interface{})
-
-		agentEnabled := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentEnabled].(bool))
-		agentTrim := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentTrim].(bool))
-		agentType := agentBlock[mkResourceVirtualEnvironmentVMAgentType].(string)
+	agentEnabled := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentEnabled].(bool))
+	agentTrim := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentTrim].(bool))
+	agentType := agentBlock[mkResourceVirtualEnvironmentVMAgentType].(string)
 
-		updateBody.Agent = &proxmox.CustomAgent{
-			Enabled:         &agentEnabled,
-			TrimClonedDisks: &agentTrim,
-			Type:            &agentType,
-		}
-	}
+	audioDevices, err := resourceVirtualEnvironmentVMGetAudioDeviceList(d, m)
 
-	if bios != dvResourceVirtualEnvironmentVMBIOS {
-		updateBody.BIOS = &bios
+	if err != nil {
+		return err
 	}
 
-	if len(cdrom) > 0 {
-		cdromBlock := cdrom[0].(map[string]interface{})
+	bios := d.Get(mkResourceVirtualEnvironmentVMBIOS).(string)
 
-		cdromEnabled := cdromBlock[mkResourceVirtualEnvironmentVMCDROMEnabled].(bool)
-		cdromFileID := cdromBlock[mkResourceVirtualEnvironmentVMCDROMFileID].(string)
+	cdromBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMCDROM}, 0, true)
 
-		if cdromFileID == "" {
-			cdromFileID = "cdrom"
-		}
+	if err != nil {
+		return err
+	}
 
-		cdromMedia := "cdrom"
+	cdromEnabled := cdromBlock[mkResourceVirtualEnvironmentVMCDROMEnabled].(bool)
+	cdromFileID := cdromBlock[mkResourceVirtualEnvironmentVMCDROMFileID].(string)
 
-		updateBody.IDEDevices = proxmox.CustomStorageDevices{
-			"ide0": proxmox.CustomStorageDevice{
-				Enabled: false,
-			},
-			"ide1": proxmox.CustomStorageDevice{
-				Enabled: false,
-			},
-			"ide2": proxmox.CustomStorageDevice{
-				Enabled:    cdromEnabled,
-				FileVolume: cdromFileID,
-				Media:      &cdromMedia,
-			},
-		}
+	if cdromFileID == "" {
+		cdromFileID = "cdrom"
 	}
 
-	if len(cpu) > 0 {
-		cpuBlock := cpu[0].(map[string]interface{})
+	cpuBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMCPU}, 0, true)
 
-		cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentVMCPUArchitecture].(string)
-		cpuCores := cpuBlock[mkResourceVirtualEnvironmentVMCPUCores].(int)
-		cpuFlags := cpuBlock[mkResourceVirtualEnvironmentVMCPUFlags].([]interface{})
-		cpuHotplugged := cpuBlock[mkResourceVirtualEnvironmentVMCPUHotplugged].(int)
-		cpuSockets := cpuBlock[mkResourceVirtualEnvironmentVMCPUSockets].(int)
-		cpuType := cpuBlock[mkResourceVirtualEnvironmentVMCPUType].(string)
-		cpuUnits := cpuBlock[mkResourceVirtualEnvironmentVMCPUUnits].(int)
+	if err != nil {
+		return err
+	}
 
-		cpuFlagsConverted := make([]string, len(cpuFlags))
+	cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentVMCPUArchitecture].(string)
+	cpuCores := cpuBlock[mkResourceVirtualEnvironmentVMCPUCores].(int)
+	cpuFlags := cpuBlock[mkResourceVirtualEnvironmentVMCPUFlags].([]interface{})
+	cpuHotplugged := cpuBlock[mkResourceVirtualEnvironmentVMCPUHotplugged].(int)
+	cpuSockets := cpuBlock[mkResourceVirtualEnvironmentVMCPUSockets].(int)
+	cpuType := cpuBlock[mkResourceVirtualEnvironmentVMCPUType].(string)
+	cpuUnits := cpuBlock[mkResourceVirtualEnvironmentVMCPUUnits].(int)
 
-		for fi, flag := range cpuFlags {
-			cpuFlagsConverted[fi] = flag.(string)
-		}
+	description := d.Get(mkResourceVirtualEnvironmentVMDescription).(string)
+	diskDeviceObjects, err := resourceVirtualEnvironmentVMGetDiskDeviceObjects(d, m, nil)
 
-		// Only the root account is allowed to change the CPU architecture, which makes this check necessary.
-		if veClient.Username == proxmox.DefaultRootAccount || cpuArchitecture != dvResourceVirtualEnvironmentVMCPUArchitecture {
-			updateBody.CPUArchitecture = &cpuArchitecture
-		}
+	if err != nil {
+		return err
+	}
 
-		updateBody.CPUCores = &cpuCores
-		updateBody.CPUEmulation = &proxmox.CustomCPUEmulation{
-			Flags: &cpuFlagsConverted,
-			Type:  cpuType,
-		}
-		updateBody.CPUSockets = &cpuSockets
-		updateBody.CPUUnits = &cpuUnits
+	virtioDeviceObjects := diskDeviceObjects["vitio"]
+	scsiDeviceObjects := diskDeviceObjects["scsi"]
+	//ideDeviceObjects := getOrderedDiskDeviceList(diskDeviceObjects, "ide")
+	sataDeviceObjects := diskDeviceObjects["sata"]
 
-		if cpuHotplugged > 0 {
-			updateBody.VirtualCPUCount = &cpuHotplugged
-		}
+	initializationConfig, err := resourceVirtualEnvironmentVMGetCloudInitConfig(d, m)
+
+	if err != nil {
+		return err
 	}
 
-	if len(initialization) > 0 {
+	if initializationConfig != nil {
+		initialization := d.Get(mkResourceVirtualEnvironmentVMInitialization).([]interface{})
 		initializationBlock := initialization[0].(map[string]interface{})
 		initializationDatastoreID := initializationBlock[mkResourceVirtualEnvironmentVMInitializationDatastoreID].(string)
 
-		cdromEnabled := true
-		cdromFileID := fmt.Sprintf("%s:cloudinit", initializationDatastoreID)
-		cdromMedia := "cdrom"
+		cdromEnabled = true
+		cdromFileID = fmt.Sprintf("%s:cloudinit", initializationDatastoreID)
+	}
 
-		updateBody.IDEDevices = proxmox.CustomStorageDevices{
-			"ide0": proxmox.CustomStorageDevice{
-				Enabled: false,
-			},
-			"ide1": proxmox.CustomStorageDevice{
-				Enabled: false,
-			},
-			"ide2": proxmox.CustomStorageDevice{
-				Enabled:    cdromEnabled,
-				FileVolume: cdromFileID,
-				Media:      &cdromMedia,
-			},
-		}
+	keyboardLayout := d.Get(mkResourceVirtualEnvironmentVMKeyboardLayout).(string)
+	memoryBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMMemory}, 0, true)
 
-		initializationConfig, err := resourceVirtualEnvironmentVMGetCloudInitConfig(d, m)
+	if err != nil {
+		return err
+	}
 
-		if err != nil {
-			return err
-		}
+	memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentVMMemoryDedicated].(int)
+	memoryFloating := memoryBlock[mkResourceVirtualEnvironmentVMMemoryFloating].(int)
+	memoryShared := memoryBlock[mkResourceVirtualEnvironmentVMMemoryShared].(int)
 
-		updateBody.CloudInitConfig = initializationConfig
-	}
+	name := d.Get(mkResourceVirtualEnvironmentVMName).(string)
 
-	if keyboardLayout != dvResourceVirtualEnvironmentVMKeyboardLayout {
-		updateBody.KeyboardLayout = &keyboardLayout
+	networkDeviceObjects, err := resourceVirtualEnvironmentVMGetNetworkDeviceObjects(d, m)
+
+	if err != nil {
+		return err
 	}
 
-	if len(memory) > 0 {
-		memoryBlock := memory[0].(map[string]interface{})
-
-		memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentVMMemoryDedicated].(int)
-		memoryFloating := memoryBlock[mkResourceVirtualEnvironmentVMMemoryFloating].(int)
-		memoryShared := memoryBlock[mkResourceVirtualEnvironmentVMMemoryShared].(int)
-
-		updateBody.DedicatedMemory = &memoryDedicated
-		updateBody.FloatingMemory = &memoryFloating
+	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
 
-		if memoryShared > 0 {
-			memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
+	operatingSystem, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMOperatingSystem}, 0, true)
 
-			updateBody.SharedMemory = &proxmox.CustomSharedMemory{
-				Name: &memorySharedName,
-				Size: memoryShared,
-			}
-		}
+	if err != nil {
+		return err
 	}
 
-	if len(networkDevice) > 0 {
-		updateBody.NetworkDevices, err = resourceVirtualEnvironmentVMGetNetworkDeviceObjects(d, m)
+	operatingSystemType := operatingSystem[mkResourceVirtualEnvironmentVMOperatingSystemType].(string)
 
-		if err != nil {
-			return err
-		}
+	poolID := d.Get(mkResourceVirtualEnvironmentVMPoolID).(string)
 
-		for i := 0; i < len(updateBody.NetworkDevices); i++ {
-			if !updateBody.NetworkDevices[i].Enabled {
-				delete = append(delete, fmt.Sprintf("net%d", i))
-			}
-		}
+	serialDevices, err := resourceVirtualEnvironmentVMGetSerialDeviceList(d, m)
 
-		for i := len(updateBody.NetworkDevices); i < maxResourceVirtualEnvironmentVMNetworkDevices; i++ {
-			delete = append(delete, fmt.Sprintf("net%d", i))
-		}
+	if err != nil {
+		return err
 	}
 
-	if len(operatingSystem) > 0 {
-		operatingSystemBlock := operatingSystem[0].(map[string]interface{})
-		operatingSystemType := operatingSystemBlock[mkResourceVirtualEnvironmentVMOperatingSystemType].(string)
+	onBoot := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMOnBoot).(bool))
+	tabletDevice := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTabletDevice).(bool))
+	tags := d.Get(mkResourceVirtualEnvironmentVMTags).(*schema.Set).List()
+	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTemplate).(bool))
 
-		updateBody.OSType = &operatingSystemType
+	vgaDevice, err := resourceVirtualEnvironmentVMGetVGADeviceObject(d, m)
+
+	if err != nil {
+		return err
 	}
 
-	if len(serialDevice) > 0 {
-		updateBody.SerialDevices, err = resourceVirtualEnvironmentVMGetSerialDeviceList(d, m)
+	vmID := d.Get(mkResourceVirtualEnvironmentVMVMID).(int)
 
-		if err != nil {
-			return err
-		}
+	var memorySharedObject *proxmox.CustomSharedMemory
 
-		for i := len(updateBody.SerialDevices); i < maxResourceVirtualEnvironmentVMSerialDevices; i++ {
-			delete = append(delete, fmt.Sprintf("serial%d", i))
-		}
+	bootDisk := "scsi0"
+	bootOrder := "c"
+
+	if cdromEnabled {
+		bootOrder = "cd"
 	}
 
-	updateBody.StartOnBoot = &onBoot
+	cpuFlagsConverted := make([]string, len(cpuFlags))
 
-	if tabletDevice != dvResourceVirtualEnvironmentVMTabletDevice {
-		updateBody.TabletDeviceEnabled = &tabletDevice
+	for fi, flag := range cpuFlags {
+		cpuFlagsConverted[fi] = flag.(string)
 	}
 
-	if template != dvResourceVirtualEnvironmentVMTemplate {
-		updateBody.Template = &template
+	ideDevice2Media := "cdrom"
+	ideDevices := proxmox.CustomStorageDevices{
+		"ide0": proxmox.CustomStorageDevice{
+			Enabled: false,
+		},
+		"ide1": proxmox.CustomStorageDevice{
+			Enabled: false,
+		},
+		"ide2": proxmox.CustomStorageDevice{
+			Enabled:    cdromEnabled,
+			FileVolume: cdromFileID,
+			Media:      &ideDevice2Media,
+		},
 	}
 
-	if len(vga) > 0 {
-		vgaDevice, err := resourceVirtualEnvironmentVMGetVGADeviceObject(d, m)
-
-		if err != nil {
-			return err
+	if memoryShared > 0 {
+		memorySharedObject = &proxmox.CustomSharedMemory{
+			Size: memoryShared,
 		}
-
-		updateBody.VGADevice = vgaDevice
 	}
 
-	updateBody.Delete = delete
+	scsiHardware := "virtio-scsi-pci"
 
-	err = veClient.UpdateVM(nodeName, vmID, updateBody)
-	if err != nil {
-		return err
+	createBody := &proxmox.VirtualEnvironmentVMCreateRequestBody{
+		ACPI: &acpi,
+		Agent: &proxmox.CustomAgent{
+			Enabled:         &agentEnabled,
+			TrimClonedDisks: &agentTrim,
+			Type:            &agentType,
+		},
+		AudioDevices:    audioDevices,
+		BIOS:            &bios,
+		BootDisk:        &bootDisk,
+		BootOrder:       &bootOrder,
+		CloudInitConfig: initializationConfig,
+		CPUCores:        &cpuCores,
+		CPUEmulation: &proxmox.CustomCPUEmulation{
+			Flags: &cpuFlagsConverted,
+			Type:  cpuType,
+		},
+		CPUSockets:          &cpuSockets,
+		CPUUnits:            &cpuUnits,
+		DedicatedMemory:     &memoryDedicated,
+		FloatingMemory:      &memoryFloating,
+		IDEDevices:          ideDevices,
+		KeyboardLayout:      &keyboardLayout,
+		NetworkDevices:      networkDeviceObjects,
+		OSType:              &operatingSystemType,
+		PoolID:              &poolID,
+		SCSIHardware:        &scsiHardware,
+		SerialDevices:       serialDevices,
+		SharedMemory:        memorySharedObject,
+		StartOnBoot:         &onBoot,
+		TabletDeviceEnabled: &tabletDevice,
+		Template:            &template,
+		VGADevice:           vgaDevice,
 	}
 
-	disk := d.Get(mkResourceVirtualEnvironmentVMDisk).([]interface{})
-
-	vmConfig, err := veClient.GetVM(nodeName, vmID)
-
-	if err != nil {
-		if strings.Contains(err.Error(), "HTTP 404") ||
-			(strings.Contains(err.Error(), "HTTP 500") && strings.Contains(err.Error(), "does not exist")) {
-			d.SetId("")
-
-			return nil
-		}
-
-		return err
+	if sataDeviceObjects != nil {
+		createBody.SATADevices = sataDeviceObjects
 	}
 
-	allDiskInfo := getDiskInfo(vmConfig)
-
-	diskDeviceObjects, err := resourceVirtualEnvironmentVMGetDiskDeviceObjects(d, m, nil)
-
-	if err != nil {
-		return err
+	if scsiDeviceObjects != nil {
+		createBody.SCSIDevices = scsiDeviceObjects
 	}
 
-	for i := range disk {
-
-		diskBlock := disk[i].(map[string]interface{})
-		diskInterface := diskBlock[mkResourcevirtualEnvironmentVMDiskInterface].(string)
-		dataStoreID := diskBlock[mkResourceVirtualEnvironmentVMDiskDatastoreID].(string)
-		diskSize := diskBlock[mkResourceVirtualEnvironmentVMDiskSize].(int)
-
-		currentDiskInfo := allDiskInfo[diskInterface]
-
-		if currentDiskInfo == nil {
-			diskUpdateBody := &proxmox.VirtualEnvironmentVMUpdateRequestBody{}
-			prefix := diskDigitPrefix(diskInterface)
-			switch prefix {
-			case "virtio":
-				if diskUpdateBody.VirtualIODevices == nil {
-					diskUpdateBody.VirtualIODevices = make(proxmox.CustomStorageDevices)
-				}
-				diskUpdateBody.VirtualIODevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
-			case "sata":
-				if diskUpdateBody.SATADevices == nil {
-					diskUpdateBody.SATADevices = make(proxmox.CustomStorageDevices)
-				}
-				diskUpdateBody.SATADevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
-			case "scsi":
-				if diskUpdateBody.SCSIDevices == nil {
-					diskUpdateBody.SCSIDevices = make(proxmox.CustomStorageDevices)
-				}
-				diskUpdateBody.SCSIDevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
-			}
-
-			err = veClient.UpdateVM(nodeName, vmID, diskUpdateBody)
-			if err != nil {
-				return err
-			}
+	if virtioDeviceObjects != nil {
+		createBody.VirtualIODevices = virtioDeviceObjects
+	}
 
-			continue
+	//this will most likely break the cdrom part thats why ide is disabled in line 2017
+	/*
+		if ideDevices != nil {
+			createBody.IDEDevices = ideDeviceObjects
 		}
+	*/
 
-		compareString := *currentDiskInfo.Size
-		compareSize := len(compareString)
-		compareNumber, err := strconv.Atoi(compareString[:compareSize-1])
+	// Only the root account is allowed to change the CPU architecture, which makes this check necessary.
+	if veClient.Username == proxmox.DefaultRootAccount || cpuArchitecture != dvResourceVirtualEnvironmentVMCPUArchitecture {
+		createBody.CPUArchitecture = &cpuArchitecture
+	}
 
-		if err != nil {
-			return fmt.Errorf("Disk resize failed, vm disk size could not be converted to int disk size = %s", *currentDiskInfo.Size)
-		}
+	if cpuHotplugged > 0 {
+		createBody.VirtualCPUCount = &cpuHotplugged
+	}
 
-		if diskSize < compareNumber {
-			return fmt.Errorf("Disk resize fails requests size (%dG) is lower than current size (%s)", diskSize, *currentDiskInfo.Size)
-		}
+	if description != "" {
+		createBody.Description = &description
+	}
 
-		deleteOriginalDisk := proxmox.CustomBool(true)
-		diskMoveBody := &proxmox.VirtualEnvironmentVMMoveDiskRequestBody{
-			DeleteOriginalDisk: &deleteOriginalDisk,
-			Disk:               diskInterface,
-			TargetStorage:      dataStoreID,
-		}
+	if name != "" {
+		createBody.Name = &name
+	}
 
-		diskResizeBody := &proxmox.VirtualEnvironmentVMResizeDiskRequestBody{
-			Disk: diskInterface,
-			Size: fmt.Sprintf("%dG", diskSize),
-		}
+	if len(tags) > 0 {
+		tagsString := getTagString(tags)
+		createBody.Tags = &tagsString
+	}
 
-		if dataStoreID != "" {
-			err = veClient.MoveVMDisk(nodeName, vmID, diskMoveBody)
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		createBody.VMID = &vmID
 
-			if err != nil {
-				return err
-			}
+		if createBody.SharedMemory != nil {
+			memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
+			createBody.SharedMemory.Name = &memorySharedName
 		}
 
-		err = veClient.ResizeVMDisk(nodeName, vmID, diskResizeBody)
+		return veClient.CreateVM(nodeName, createBody)
+	})
 
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
 	}
 
-	return resourceVirtualEnvironmentVMCreateStart(d, m)
+	d.SetId(strconv.Itoa(vmID))
+
+	return resourceVirtualEnvironmentVMCreateCustomDisks(d, m)
 }
 
-func resourceVirtualEnvironmentVMCreateCustom(d *schema.ResourceData, m interface{}) error {
+func resourceVirtualEnvironmentVMCreateCustomDisks(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
 
@@ -1411,403 +1467,631 @@ func resourceVirtualEnvironmentVMCreateCustom(d *schema.ResourceData, m interfac
 		return err
 	}
 
-	resource := resourceVirtualEnvironmentVM()
-
-	acpi := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMACPI).(bool))
-
-	agentBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMAgent}, 0, true)
+	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
+	vmID, err := strconv.Atoi(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	agentEnabled := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentEnabled].(bool))
-	agentTrim := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentTrim].(bool))
-	agentType := agentBlock[mkResourceVirtualEnvironmentVMAgentType].(string)
+	commands := []string{}
 
-	audioDevices, err := resourceVirtualEnvironmentVMGetAudioDeviceList(d, m)
+	// Determine the ID of the next disk.
+	disk := d.Get(mkResourceVirtualEnvironmentVMDisk).([]interface{})
+	diskCount := 0
 
-	if err != nil {
-		return err
+	for _, d := range disk {
+		block := d.(map[string]interface{})
+		fileID, _ := block[mkResourceVirtualEnvironmentVMDiskFileID].(string)
+
+		if fileID == "" {
+			diskCount++
+		}
 	}
 
-	bios := d.Get(mkResourceVirtualEnvironmentVMBIOS).(string)
+	// Retrieve some information about the disk schema.
+	resourceSchema := resourceVirtualEnvironmentVM().Schema
+	diskSchemaElem := resourceSchema[mkResourceVirtualEnvironmentVMDisk].Elem
+	diskSchemaResource := diskSchemaElem.(*schema.Resource)
+	diskSpeedResource := diskSchemaResource.Schema[mkResourceVirtualEnvironmentVMDiskSpeed]
 
-	cdromBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMCDROM}, 0, true)
+	// Generate the commands required to import the specified disks.
+	importedDiskCount := 0
 
-	if err != nil {
-		return err
-	}
+	for i, d := range disk {
+		block := d.(map[string]interface{})
 
-	cdromEnabled := cdromBlock[mkResourceVirtualEnvironmentVMCDROMEnabled].(bool)
-	cdromFileID := cdromBlock[mkResourceVirtualEnvironmentVMCDROMFileID].(string)
+		fileID, _ := block[mkResourceVirtualEnvironmentVMDiskFileID].(string)
 
-	if cdromFileID == "" {
-		cdromFileID = "cdrom"
+		if fileID == "" {
+			continue
+		}
+
+		datastoreID, _ := block[mkResourceVirtualEnvironmentVMDiskDatastoreID].(string)
+		fileFormat, _ := block[mkResourceVirtualEnvironmentVMDiskFileFormat].(string)
+		size, _ := block[mkResourceVirtualEnvironmentVMDiskSize].(int)
+		speed := block[mkResourceVirtualEnvironmentVMDiskSpeed].([]interface{})
+
+		if len(speed) == 0 {
+			diskSpeedDefault, err := diskSpeedResource.DefaultValue()
+
+			if err != nil {
+				return err
+			}
+
+			speed = diskSpeedDefault.([]interface{})
+		}
+
+		speedBlock := speed[0].(map[string]interface{})
+		speedLimitRead := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedRead].(int)
+		speedLimitReadBurstable := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedReadBurstable].(int)
+		speedLimitWrite := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedWrite].(int)
+		speedLimitWriteBurstable := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedWriteBurstable].(int)
+
+		diskOptions := ""
+
+		if speedLimitRead > 0 {
+			diskOptions += fmt.Sprintf(",mbps_rd=%d", speedLimitRead)
+		}
+
+		if speedLimitReadBurstable > 0 {
+			diskOptions += fmt.Sprintf(",mbps_rd_max=%d", speedLimitReadBurstable)
+		}
+
+		if speedLimitWrite > 0 {
+			diskOptions += fmt.Sprintf(",mbps_wr=%d", speedLimitWrite)
+		}
+
+		if speedLimitWriteBurstable > 0 {
+			diskOptions += fmt.Sprintf(",mbps_wr_max=%d", speedLimitWriteBurstable)
+		}
+
+		fileIDParts := strings.Split(fileID, ":")
+		filePath := ""
+
+		if strings.HasPrefix(fileIDParts[1], "iso/") {
+			filePath = fmt.Sprintf("/template/%s", fileIDParts[1])
+		} else {
+			filePath = fmt.Sprintf("/%s", fileIDParts[1])
+		}
+
+		filePathTmp := fmt.Sprintf("/tmp/vm-%d-disk-%d.%s", vmID, diskCount+importedDiskCount, fileFormat)
+
+		commands = append(
+			commands,
+			`set -e`,
+			fmt.Sprintf(`datastore_id_image="%s"`, fileIDParts[0]),
+			fmt.Sprintf(`datastore_id_target="%s"`, datastoreID),
+			fmt.Sprintf(`disk_count="%d"`, diskCount+importedDiskCount),
+			fmt.Sprintf(`disk_index="%d"`, i),
+			fmt.Sprintf(`disk_options="%s"`, diskOptions),
+			fmt.Sprintf(`disk_size="%d"`, size),
+			fmt.Sprintf(`file_path="%s"`, filePath),
+			fmt.Sprintf(`file_path_tmp="%s"`, filePathTmp),
+			fmt.Sprintf(`vm_id="%d"`, vmID),
+			`getdsi() { local nr='^([A-Za-z0-9_-]+): ([A-Za-z0-9_-]+)$'; local pr='^[[:space:]]+path[[:space:]]+([^[:space:]]+)$'; local dn=""; local dt=""; while IFS='' read -r l || [[ -n "$l" ]]; do if [[ "$l" =~ $nr ]]; then dt="${BASH_REMATCH[1]}"; dn="${BASH_REMATCH[2]}"; elif [[ "$l" =~ $pr ]] && [[ "$dn" == "$1" ]]; then echo "${BASH_REMATCH[1]};${dt}"; break; fi; done < /etc/pve/storage.cfg; }`,
+			`dsi_image="$(getdsi "$datastore_id_image")"`,
+			`dsp_image="$(echo "$dsi_image" | cut -d ";" -f 1)"`,
+			`dst_image="$(echo "$dsi_image" | cut -d ";" -f 2)"`,
+			`if [[ -z "$dsp_image" ]]; then echo "Failed to determine the path for datastore '${datastore_id_image}' (${dsi_image})"; exit 1; fi`,
+			`dsi_target="$(getdsi "$datastore_id_target")"`,
+			`dst_target="$(echo "$dsi_target" | cut -d ";" -f 2)"`,
+			`cp "${dsp_image}${file_path}" "$file_path_tmp"`,
+			`qemu-img resize "$file_path_tmp" "${disk_size}G"`,
+			`qm importdisk "$vm_id" "$file_path_tmp" "$datastore_id_target" -format qcow2`,
+			`disk_id="${datastore_id_target}:$([[ "$dst_target" == "dir" ]] && echo "${vm_id}/" || echo "")vm-${vm_id}-disk-${disk_count}$([[ "$dst_target" == "dir" ]] && echo ".qcow2" || echo "")${disk_options}"`,
+			`qm set "$vm_id" "-scsi${disk_index}" "$disk_id"`,
+			`rm -f "$file_path_tmp"`,
+		)
+
+		importedDiskCount++
 	}
 
-	cpuBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMCPU}, 0, true)
+	// Execute the commands on the node and wait for the result.
+	// This is a highly experimental approach to disk imports and is not recommended by Proxmox.
+	if len(commands) > 0 {
+		err = veClient.ExecuteNodeCommands(nodeName, commands)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
 	}
 
-	cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentVMCPUArchitecture].(string)
-	cpuCores := cpuBlock[mkResourceVirtualEnvironmentVMCPUCores].(int)
-	cpuFlags := cpuBlock[mkResourceVirtualEnvironmentVMCPUFlags].([]interface{})
-	cpuHotplugged := cpuBlock[mkResourceVirtualEnvironmentVMCPUHotplugged].(int)
-	cpuSockets := cpuBlock[mkResourceVirtualEnvironmentVMCPUSockets].(int)
-	cpuType := cpuBlock[mkResourceVirtualEnvironmentVMCPUType].(string)
-	cpuUnits := cpuBlock[mkResourceVirtualEnvironmentVMCPUUnits].(int)
+	return resourceVirtualEnvironmentVMCreateStart(d, m)
+}
 
-	description := d.Get(mkResourceVirtualEnvironmentVMDescription).(string)
-	diskDeviceObjects, err := resourceVirtualEnvironmentVMGetDiskDeviceObjects(d, m, nil)
+func resourceVirtualEnvironmentVMCreatePlacement(d *schema.ResourceData, m interface{}) error {
+	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
 
-	if err != nil {
-		return err
+	if nodeName != "" {
+		return nil
 	}
 
-	virtioDeviceObjects := diskDeviceObjects["vitio"]
-	scsiDeviceObjects := diskDeviceObjects["scsi"]
-	//ideDeviceObjects := getOrderedDiskDeviceList(diskDeviceObjects, "ide")
-	sataDeviceObjects := diskDeviceObjects["sata"]
+	placement := d.Get(mkResourceVirtualEnvironmentVMPlacement).([]interface{})
 
-	initializationConfig, err := resourceVirtualEnvironmentVMGetCloudInitConfig(d, m)
+	if len(placement) == 0 {
+		return fmt.Errorf("Either %s or %s must be specified", mkResourceVirtualEnvironmentVMNodeName, mkResourceVirtualEnvironmentVMPlacement)
+	}
+
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
 
 	if err != nil {
 		return err
 	}
 
-	if initializationConfig != nil {
-		initialization := d.Get(mkResourceVirtualEnvironmentVMInitialization).([]interface{})
-		initializationBlock := initialization[0].(map[string]interface{})
-		initializationDatastoreID := initializationBlock[mkResourceVirtualEnvironmentVMInitializationDatastoreID].(string)
+	placementBlock := placement[0].(map[string]interface{})
+	placementDatastoreID := placementBlock[mkResourceVirtualEnvironmentVMPlacementDatastoreID].(string)
+	placementNodes := placementBlock[mkResourceVirtualEnvironmentVMPlacementNodes].([]interface{})
+	placementStrategy := placementBlock[mkResourceVirtualEnvironmentVMPlacementStrategy].(string)
 
-		cdromEnabled = true
-		cdromFileID = fmt.Sprintf("%s:cloudinit", initializationDatastoreID)
+	allowedNodeNames := make([]string, len(placementNodes))
+
+	for i, v := range placementNodes {
+		allowedNodeNames[i] = v.(string)
 	}
 
-	keyboardLayout := d.Get(mkResourceVirtualEnvironmentVMKeyboardLayout).(string)
-	memoryBlock, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMMemory}, 0, true)
+	nodeName, err = getPlacementNodeName(veClient, allowedNodeNames, placementDatastoreID, placementStrategy)
 
 	if err != nil {
 		return err
 	}
 
-	memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentVMMemoryDedicated].(int)
-	memoryFloating := memoryBlock[mkResourceVirtualEnvironmentVMMemoryFloating].(int)
-	memoryShared := memoryBlock[mkResourceVirtualEnvironmentVMMemoryShared].(int)
+	// The selected node is stored in the state, which prevents the virtual machine from being moved by subsequent plans.
+	d.Set(mkResourceVirtualEnvironmentVMNodeName, nodeName)
 
-	name := d.Get(mkResourceVirtualEnvironmentVMName).(string)
+	return nil
+}
 
-	networkDeviceObjects, err := resourceVirtualEnvironmentVMGetNetworkDeviceObjects(d, m)
+func resourceVirtualEnvironmentVMCreateReconfigure(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
 
 	if err != nil {
 		return err
 	}
 
 	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
-
-	operatingSystem, err := getSchemaBlock(resource, d, m, []string{mkResourceVirtualEnvironmentVMOperatingSystem}, 0, true)
+	vmID, err := strconv.Atoi(d.Id())
 
 	if err != nil {
 		return err
 	}
 
-	operatingSystemType := operatingSystem[mkResourceVirtualEnvironmentVMOperatingSystemType].(string)
-
-	poolID := d.Get(mkResourceVirtualEnvironmentVMPoolID).(string)
-
-	serialDevices, err := resourceVirtualEnvironmentVMGetSerialDeviceList(d, m)
+	// Now that the virtual machine has been cloned or restored, we need to perform some modifications.
+	acpi := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMACPI).(bool))
+	agent := d.Get(mkResourceVirtualEnvironmentVMAgent).([]interface{})
+	audioDevices, err := resourceVirtualEnvironmentVMGetAudioDeviceList(d, m)
 
 	if err != nil {
 		return err
 	}
 
+	bios := d.Get(mkResourceVirtualEnvironmentVMBIOS).(string)
+	cdrom := d.Get(mkResourceVirtualEnvironmentVMCDROM).([]interface{})
+	cpu := d.Get(mkResourceVirtualEnvironmentVMCPU).([]interface{})
+	initialization := d.Get(mkResourceVirtualEnvironmentVMInitialization).([]interface{})
+	keyboardLayout := d.Get(mkResourceVirtualEnvironmentVMKeyboardLayout).(string)
+	memory := d.Get(mkResourceVirtualEnvironmentVMMemory).([]interface{})
+	networkDevice := d.Get(mkResourceVirtualEnvironmentVMNetworkDevice).([]interface{})
+	operatingSystem := d.Get(mkResourceVirtualEnvironmentVMOperatingSystem).([]interface{})
+	serialDevice := d.Get(mkResourceVirtualEnvironmentVMSerialDevice).([]interface{})
 	onBoot := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMOnBoot).(bool))
 	tabletDevice := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTabletDevice).(bool))
+	tags := d.Get(mkResourceVirtualEnvironmentVMTags).(*schema.Set).List()
 	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTemplate).(bool))
+	vga := d.Get(mkResourceVirtualEnvironmentVMVGA).([]interface{})
 
-	vgaDevice, err := resourceVirtualEnvironmentVMGetVGADeviceObject(d, m)
+	updateBody := &proxmox.VirtualEnvironmentVMUpdateRequestBody{
+		AudioDevices: audioDevices,
+	}
 
-	if err != nil {
-		return err
+	delete := []string{}
+
+	if acpi != dvResourceVirtualEnvironmentVMACPI {
+		updateBody.ACPI = &acpi
 	}
 
-	vmID := d.Get(mkResourceVirtualEnvironmentVMVMID).(int)
+	if len(agent) > 0 {
+		agentBlock := agent[0].(map[string]interface{})
 
-	if vmID == -1 {
-		vmIDNew, err := veClient.GetVMID()
+		agentEnabled := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentEnabled].(bool))
+		agentTrim := proxmox.CustomBool(agentBlock[mkResourceVirtualEnvironmentVMAgentTrim].(bool))
+		agentType := agentBlock[mkResourceVirtualEnvironmentVMAgentType].(string)
 
-		if err != nil {
-			return err
+		updateBody.Agent = &proxmox.CustomAgent{
+			Enabled:         &agentEnabled,
+			TrimClonedDisks: &agentTrim,
+			Type:            &agentType,
 		}
+	}
 
-		vmID = *vmIDNew
+	if bios != dvResourceVirtualEnvironmentVMBIOS {
+		updateBody.BIOS = &bios
 	}
 
-	var memorySharedObject *proxmox.CustomSharedMemory
+	if len(cdrom) > 0 {
+		cdromBlock := cdrom[0].(map[string]interface{})
 
-	bootDisk := "scsi0"
-	bootOrder := "c"
+		cdromEnabled := cdromBlock[mkResourceVirtualEnvironmentVMCDROMEnabled].(bool)
+		cdromFileID := cdromBlock[mkResourceVirtualEnvironmentVMCDROMFileID].(string)
 
-	if cdromEnabled {
-		bootOrder = "cd"
-	}
+		if cdromFileID == "" {
+			cdromFileID = "cdrom"
+		}
 
-	cpuFlagsConverted := make([]string, len(cpuFlags))
+		cdromMedia := "cdrom"
 
-	for fi, flag := range cpuFlags {
-		cpuFlagsConverted[fi] = flag.(string)
+		updateBody.IDEDevices = proxmox.CustomStorageDevices{
+			"ide0": proxmox.CustomStorageDevice{
+				Enabled: false,
+			},
+			"ide1": proxmox.CustomStorageDevice{
+				Enabled: false,
+			},
+			"ide2": proxmox.CustomStorageDevice{
+				Enabled:    cdromEnabled,
+				FileVolume: cdromFileID,
+				Media:      &cdromMedia,
+			},
+		}
 	}
 
-	ideDevice2Media := "cdrom"
-	ideDevices := proxmox.CustomStorageDevices{
-		"ide0": proxmox.CustomStorageDevice{
-			Enabled: false,
-		},
-		"ide1": proxmox.CustomStorageDevice{
-			Enabled: false,
-		},
-		"ide2": proxmox.CustomStorageDevice{
-			Enabled:    cdromEnabled,
-			FileVolume: cdromFileID,
-			Media:      &ideDevice2Media,
-		},
-	}
+	if len(cpu) > 0 {
+		cpuBlock := cpu[0].(map[string]interface{})
 
-	if memoryShared > 0 {
-		memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
-		memorySharedObject = &proxmox.CustomSharedMemory{
-			Name: &memorySharedName,
-			Size: memoryShared,
+		cpuArchitecture := cpuBlock[mkResourceVirtualEnvironmentVMCPUArchitecture].(string)
+		cpuCores := cpuBlock[mkResourceVirtualEnvironmentVMCPUCores].(int)
+		cpuFlags := cpuBlock[mkResourceVirtualEnvironmentVMCPUFlags].([]interface{})
+		cpuHotplugged := cpuBlock[mkResourceVirtualEnvironmentVMCPUHotplugged].(int)
+		cpuSockets := cpuBlock[mkResourceVirtualEnvironmentVMCPUSockets].(int)
+		cpuType := cpuBlock[mkResourceVirtualEnvironmentVMCPUType].(string)
+		cpuUnits := cpuBlock[mkResourceVirtualEnvironmentVMCPUUnits].(int)
+
+		cpuFlagsConverted := make([]string, len(cpuFlags))
+
+		for fi, flag := range cpuFlags {
+			cpuFlagsConverted[fi] = flag.(string)
 		}
-	}
 
-	scsiHardware := "virtio-scsi-pci"
+		// Only the root account is allowed to change the CPU architecture, which makes this check necessary.
+		if veClient.Username == proxmox.DefaultRootAccount || cpuArchitecture != dvResourceVirtualEnvironmentVMCPUArchitecture {
+			updateBody.CPUArchitecture = &cpuArchitecture
+		}
 
-	createBody := &proxmox.VirtualEnvironmentVMCreateRequestBody{
-		ACPI: &acpi,
-		Agent: &proxmox.CustomAgent{
-			Enabled:         &agentEnabled,
-			TrimClonedDisks: &agentTrim,
-			Type:            &agentType,
-		},
-		AudioDevices:    audioDevices,
-		BIOS:            &bios,
-		BootDisk:        &bootDisk,
-		BootOrder:       &bootOrder,
-		CloudInitConfig: initializationConfig,
-		CPUCores:        &cpuCores,
-		CPUEmulation: &proxmox.CustomCPUEmulation{
+		updateBody.CPUCores = &cpuCores
+		updateBody.CPUEmulation = &proxmox.CustomCPUEmulation{
 			Flags: &cpuFlagsConverted,
 			Type:  cpuType,
-		},
-		CPUSockets:          &cpuSockets,
-		CPUUnits:            &cpuUnits,
-		DedicatedMemory:     &memoryDedicated,
-		FloatingMemory:      &memoryFloating,
-		IDEDevices:          ideDevices,
-		KeyboardLayout:      &keyboardLayout,
-		NetworkDevices:      networkDeviceObjects,
-		OSType:              &operatingSystemType,
-		PoolID:              &poolID,
-		SCSIHardware:        &scsiHardware,
-		SerialDevices:       serialDevices,
-		SharedMemory:        memorySharedObject,
-		StartOnBoot:         &onBoot,
-		TabletDeviceEnabled: &tabletDevice,
-		Template:            &template,
-		VGADevice:           vgaDevice,
-		VMID:                &vmID,
+		}
+		updateBody.CPUSockets = &cpuSockets
+		updateBody.CPUUnits = &cpuUnits
+
+		if cpuHotplugged > 0 {
+			updateBody.VirtualCPUCount = &cpuHotplugged
+		}
 	}
 
-	if sataDeviceObjects != nil {
-		createBody.SATADevices = sataDeviceObjects
+	if len(initialization) > 0 {
+		initializationBlock := initialization[0].(map[string]interface{})
+		initializationDatastoreID := initializationBlock[mkResourceVirtualEnvironmentVMInitializationDatastoreID].(string)
+
+		cdromEnabled := true
+		cdromFileID := fmt.Sprintf("%s:cloudinit", initializationDatastoreID)
+		cdromMedia := "cdrom"
+
+		updateBody.IDEDevices = proxmox.CustomStorageDevices{
+			"ide0": proxmox.CustomStorageDevice{
+				Enabled: false,
+			},
+			"ide1": proxmox.CustomStorageDevice{
+				Enabled: false,
+			},
+			"ide2": proxmox.CustomStorageDevice{
+				Enabled:    cdromEnabled,
+				FileVolume: cdromFileID,
+				Media:      &cdromMedia,
+			},
+		}
+
+		initializationConfig, err := resourceVirtualEnvironmentVMGetCloudInitConfig(d, m)
+
+		if err != nil {
+			return err
+		}
+
+		updateBody.CloudInitConfig = initializationConfig
 	}
 
-	if scsiDeviceObjects != nil {
-		createBody.SCSIDevices = scsiDeviceObjects
+	if keyboardLayout != dvResourceVirtualEnvironmentVMKeyboardLayout {
+		updateBody.KeyboardLayout = &keyboardLayout
 	}
 
-	if virtioDeviceObjects != nil {
-		createBody.VirtualIODevices = virtioDeviceObjects
+	if len(memory) > 0 {
+		memoryBlock := memory[0].(map[string]interface{})
+
+		memoryDedicated := memoryBlock[mkResourceVirtualEnvironmentVMMemoryDedicated].(int)
+		memoryFloating := memoryBlock[mkResourceVirtualEnvironmentVMMemoryFloating].(int)
+		memoryShared := memoryBlock[mkResourceVirtualEnvironmentVMMemoryShared].(int)
+
+		updateBody.DedicatedMemory = &memoryDedicated
+		updateBody.FloatingMemory = &memoryFloating
+
+		if memoryShared > 0 {
+			memorySharedName := fmt.Sprintf("vm-%d-ivshmem", vmID)
+
+			updateBody.SharedMemory = &proxmox.CustomSharedMemory{
+				Name: &memorySharedName,
+				Size: memoryShared,
+			}
+		}
 	}
 
-	//this will most likely break the cdrom part thats why ide is disabled in line 2017
-	/*
-		if ideDevices != nil {
-			createBody.IDEDevices = ideDeviceObjects
+	if len(networkDevice) > 0 {
+		updateBody.NetworkDevices, err = resourceVirtualEnvironmentVMGetNetworkDeviceObjects(d, m)
+
+		if err != nil {
+			return err
 		}
-	*/
 
-	// Only the root account is allowed to change the CPU architecture, which makes this check necessary.
-	if veClient.Username == proxmox.DefaultRootAccount || cpuArchitecture != dvResourceVirtualEnvironmentVMCPUArchitecture {
-		createBody.CPUArchitecture = &cpuArchitecture
+		for i := 0; i < len(updateBody.NetworkDevices); i++ {
+			if !updateBody.NetworkDevices[i].Enabled {
+				delete = append(delete, fmt.Sprintf("net%d", i))
+			}
+		}
+
+		for i := len(updateBody.NetworkDevices); i < maxResourceVirtualEnvironmentVMNetworkDevices; i++ {
+			delete = append(delete, fmt.Sprintf("net%d", i))
+		}
 	}
 
-	if cpuHotplugged > 0 {
-		createBody.VirtualCPUCount = &cpuHotplugged
+	if len(operatingSystem) > 0 {
+		operatingSystemBlock := operatingSystem[0].(map[string]interface{})
+		operatingSystemType := operatingSystemBlock[mkResourceVirtualEnvironmentVMOperatingSystemType].(string)
+
+		updateBody.OSType = &operatingSystemType
 	}
 
-	if description != "" {
-		createBody.Description = &description
+	if len(serialDevice) > 0 {
+		updateBody.SerialDevices, err = resourceVirtualEnvironmentVMGetSerialDeviceList(d, m)
+
+		if err != nil {
+			return err
+		}
+
+		for i := len(updateBody.SerialDevices); i < maxResourceVirtualEnvironmentVMSerialDevices; i++ {
+			delete = append(delete, fmt.Sprintf("serial%d", i))
+		}
+	}
+
+	updateBody.StartOnBoot = &onBoot
+
+	if tabletDevice != dvResourceVirtualEnvironmentVMTabletDevice {
+		updateBody.TabletDeviceEnabled = &tabletDevice
 	}
 
-	if name != "" {
-		createBody.Name = &name
+	if len(tags) > 0 {
+		tagsString := getTagString(tags)
+		updateBody.Tags = &tagsString
+	}
+
+	if template != dvResourceVirtualEnvironmentVMTemplate {
+		updateBody.Template = &template
+	}
+
+	if len(vga) > 0 {
+		vgaDevice, err := resourceVirtualEnvironmentVMGetVGADeviceObject(d, m)
+
+		if err != nil {
+			return err
+		}
+
+		updateBody.VGADevice = vgaDevice
 	}
 
-	err = veClient.CreateVM(nodeName, createBody)
+	updateBody.Delete = delete
 
+	err = veClient.UpdateVM(nodeName, vmID, updateBody)
 	if err != nil {
 		return err
 	}
 
-	d.SetId(strconv.Itoa(vmID))
+	// Restored virtual machines keep the disks from the backup, unless the disk devices have been declared explicitly.
+	restore := d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})
 
-	return resourceVirtualEnvironmentVMCreateCustomDisks(d, m)
-}
+	if len(restore) > 0 && resourceVirtualEnvironmentVMHasDefaultDisk(d) {
+		return resourceVirtualEnvironmentVMCreateStart(d, m)
+	}
 
-func resourceVirtualEnvironmentVMCreateCustomDisks(d *schema.ResourceData, m interface{}) error {
-	config := m.(providerConfiguration)
-	veClient, err := config.GetVEClient()
+	disk := d.Get(mkResourceVirtualEnvironmentVMDisk).([]interface{})
+
+	vmConfig, err := veClient.GetVM(nodeName, vmID)
 
 	if err != nil {
+		if strings.Contains(err.Error(), "HTTP 404") ||
+			(strings.Contains(err.Error(), "HTTP 500") && strings.Contains(err.Error(), "does not exist")) {
+			d.SetId("")
+
+			return nil
+		}
+
 		return err
 	}
 
-	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
-	vmID, err := strconv.Atoi(d.Id())
+	allDiskInfo := getDiskInfo(vmConfig)
+
+	diskDeviceObjects, err := resourceVirtualEnvironmentVMGetDiskDeviceObjects(d, m, nil)
 
 	if err != nil {
 		return err
 	}
 
-	commands := []string{}
+	for i := range disk {
 
-	// Determine the ID of the next disk.
-	disk := d.Get(mkResourceVirtualEnvironmentVMDisk).([]interface{})
-	diskCount := 0
+		diskBlock := disk[i].(map[string]interface{})
+		diskInterface := diskBlock[mkResourcevirtualEnvironmentVMDiskInterface].(string)
+		dataStoreID := diskBlock[mkResourceVirtualEnvironmentVMDiskDatastoreID].(string)
+		diskSize := diskBlock[mkResourceVirtualEnvironmentVMDiskSize].(int)
 
-	for _, d := range disk {
-		block := d.(map[string]interface{})
-		fileID, _ := block[mkResourceVirtualEnvironmentVMDiskFileID].(string)
+		currentDiskInfo := allDiskInfo[diskInterface]
 
-		if fileID == "" {
-			diskCount++
-		}
-	}
+		if currentDiskInfo == nil {
+			diskUpdateBody := &proxmox.VirtualEnvironmentVMUpdateRequestBody{}
+			prefix := diskDigitPrefix(diskInterface)
+			switch prefix {
+			case "virtio":
+				if diskUpdateBody.VirtualIODevices == nil {
+					diskUpdateBody.VirtualIODevices = make(proxmox.CustomStorageDevices)
+				}
+				diskUpdateBody.VirtualIODevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
+			case "sata":
+				if diskUpdateBody.SATADevices == nil {
+					diskUpdateBody.SATADevices = make(proxmox.CustomStorageDevices)
+				}
+				diskUpdateBody.SATADevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
+			case "scsi":
+				if diskUpdateBody.SCSIDevices == nil {
+					diskUpdateBody.SCSIDevices = make(proxmox.CustomStorageDevices)
+				}
+				diskUpdateBody.SCSIDevices[diskInterface] = diskDeviceObjects[prefix][diskInterface]
+			}
 
-	// Retrieve some information about the disk schema.
-	resourceSchema := resourceVirtualEnvironmentVM().Schema
-	diskSchemaElem := resourceSchema[mkResourceVirtualEnvironmentVMDisk].Elem
-	diskSchemaResource := diskSchemaElem.(*schema.Resource)
-	diskSpeedResource := diskSchemaResource.Schema[mkResourceVirtualEnvironmentVMDiskSpeed]
+			err = veClient.UpdateVM(nodeName, vmID, diskUpdateBody)
+			if err != nil {
+				return err
+			}
 
-	// Generate the commands required to import the specified disks.
-	importedDiskCount := 0
+			continue
+		}
 
-	for i, d := range disk {
-		block := d.(map[string]interface{})
+		compareString := *currentDiskInfo.Size
+		compareSize := len(compareString)
+		compareNumber, err := strconv.Atoi(compareString[:compareSize-1])
 
-		fileID, _ := block[mkResourceVirtualEnvironmentVMDiskFileID].(string)
+		if err != nil {
+			return fmt.Errorf("Disk resize failed, vm disk size could not be converted to int disk size = %s", *currentDiskInfo.Size)
+		}
 
-		if fileID == "" {
-			continue
+		if diskSize < compareNumber {
+			return fmt.Errorf("Disk resize fails requests size (%dG) is lower than current size (%s)", diskSize, *currentDiskInfo.Size)
 		}
 
-		datastoreID, _ := block[mkResourceVirtualEnvironmentVMDiskDatastoreID].(string)
-		fileFormat, _ := block[mkResourceVirtualEnvironmentVMDiskFileFormat].(string)
-		size, _ := block[mkResourceVirtualEnvironmentVMDiskSize].(int)
-		speed := block[mkResourceVirtualEnvironmentVMDiskSpeed].([]interface{})
+		deleteOriginalDisk := proxmox.CustomBool(true)
+		diskMoveBody := &proxmox.VirtualEnvironmentVMMoveDiskRequestBody{
+			DeleteOriginalDisk: &deleteOriginalDisk,
+			Disk:               diskInterface,
+			TargetStorage:      dataStoreID,
+		}
 
-		if len(speed) == 0 {
-			diskSpeedDefault, err := diskSpeedResource.DefaultValue()
+		diskResizeBody := &proxmox.VirtualEnvironmentVMResizeDiskRequestBody{
+			Disk: diskInterface,
+			Size: fmt.Sprintf("%dG", diskSize),
+		}
+
+		if dataStoreID != "" {
+			err = veClient.MoveVMDisk(nodeName, vmID, diskMoveBody)
 
 			if err != nil {
 				return err
 			}
+		}
 
-			speed = diskSpeedDefault.([]interface{})
+		err = veClient.ResizeVMDisk(nodeName, vmID, diskResizeBody)
+
+		if err != nil {
+			return err
 		}
+	}
 
-		speedBlock := speed[0].(map[string]interface{})
-		speedLimitRead := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedRead].(int)
-		speedLimitReadBurstable := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedReadBurstable].(int)
-		speedLimitWrite := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedWrite].(int)
-		speedLimitWriteBurstable := speedBlock[mkResourceVirtualEnvironmentVMDiskSpeedWriteBurstable].(int)
+	return resourceVirtualEnvironmentVMCreateStart(d, m)
+}
 
-		diskOptions := ""
+func resourceVirtualEnvironmentVMCreateRestore(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
 
-		if speedLimitRead > 0 {
-			diskOptions += fmt.Sprintf(",mbps_rd=%d", speedLimitRead)
-		}
+	if err != nil {
+		return err
+	}
 
-		if speedLimitReadBurstable > 0 {
-			diskOptions += fmt.Sprintf(",mbps_rd_max=%d", speedLimitReadBurstable)
-		}
+	restore := d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})
+	restoreBlock := restore[0].(map[string]interface{})
+	restoreBackupFile := restoreBlock[mkResourceVirtualEnvironmentVMRestoreBackupFile].(string)
+	restoreDatastoreID := restoreBlock[mkResourceVirtualEnvironmentVMRestoreDatastoreID].(string)
+	restoreLiveRestore := proxmox.CustomBool(restoreBlock[mkResourceVirtualEnvironmentVMRestoreLiveRestore].(bool))
+	restoreUnique := proxmox.CustomBool(restoreBlock[mkResourceVirtualEnvironmentVMRestoreUnique].(bool))
 
-		if speedLimitWrite > 0 {
-			diskOptions += fmt.Sprintf(",mbps_wr=%d", speedLimitWrite)
-		}
+	description := d.Get(mkResourceVirtualEnvironmentVMDescription).(string)
+	name := d.Get(mkResourceVirtualEnvironmentVMName).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentVMNodeName).(string)
+	poolID := d.Get(mkResourceVirtualEnvironmentVMPoolID).(string)
+	timeoutRestore, err := time.ParseDuration(d.Get(mkResourceVirtualEnvironmentVMTimeoutRestore).(string))
 
-		if speedLimitWriteBurstable > 0 {
-			diskOptions += fmt.Sprintf(",mbps_wr_max=%d", speedLimitWriteBurstable)
-		}
+	if err != nil {
+		return err
+	}
 
-		fileIDParts := strings.Split(fileID, ":")
-		filePath := ""
+	vmID := d.Get(mkResourceVirtualEnvironmentVMVMID).(int)
 
-		if strings.HasPrefix(fileIDParts[1], "iso/") {
-			filePath = fmt.Sprintf("/template/%s", fileIDParts[1])
-		} else {
-			filePath = fmt.Sprintf("/%s", fileIDParts[1])
-		}
+	restoreBody := &proxmox.VirtualEnvironmentVMCreateRequestBody{
+		BackupFile: &restoreBackupFile,
+	}
 
-		filePathTmp := fmt.Sprintf("/tmp/vm-%d-disk-%d.%s", vmID, diskCount+importedDiskCount, fileFormat)
+	if restoreDatastoreID != "" {
+		restoreBody.TargetStorage = &restoreDatastoreID
+	}
 
-		commands = append(
-			commands,
-			`set -e`,
-			fmt.Sprintf(`datastore_id_image="%s"`, fileIDParts[0]),
-			fmt.Sprintf(`datastore_id_target="%s"`, datastoreID),
-			fmt.Sprintf(`disk_count="%d"`, diskCount+importedDiskCount),
-			fmt.Sprintf(`disk_index="%d"`, i),
-			fmt.Sprintf(`disk_options="%s"`, diskOptions),
-			fmt.Sprintf(`disk_size="%d"`, size),
-			fmt.Sprintf(`file_path="%s"`, filePath),
-			fmt.Sprintf(`file_path_tmp="%s"`, filePathTmp),
-			fmt.Sprintf(`vm_id="%d"`, vmID),
-			`getdsi() { local nr='^([A-Za-z0-9_-]+): ([A-Za-z0-9_-]+)$'; local pr='^[[:space:]]+path[[:space:]]+([^[:space:]]+)$'; local dn=""; local dt=""; while IFS='' read -r l || [[ -n "$l" ]]; do if [[ "$l" =~ $nr ]]; then dt="${BASH_REMATCH[1]}"; dn="${BASH_REMATCH[2]}"; elif [[ "$l" =~ $pr ]] && [[ "$dn" == "$1" ]]; then echo "${BASH_REMATCH[1]};${dt}"; break; fi; done < /etc/pve/storage.cfg; }`,
-			`dsi_image="$(getdsi "$datastore_id_image")"`,
-			`dsp_image="$(echo "$dsi_image" | cut -d ";" -f 1)"`,
-			`dst_image="$(echo "$dsi_image" | cut -d ";" -f 2)"`,
-			`if [[ -z "$dsp_image" ]]; then echo "Failed to determine the path for datastore '${datastore_id_image}' (${dsi_image})"; exit 1; fi`,
-			`dsi_target="$(getdsi "$datastore_id_target")"`,
-			`dst_target="$(echo "$dsi_target" | cut -d ";" -f 2)"`,
-			`cp "${dsp_image}${file_path}" "$file_path_tmp"`,
-			`qemu-img resize "$file_path_tmp" "${disk_size}G"`,
-			`qm importdisk "$vm_id" "$file_path_tmp" "$datastore_id_target" -format qcow2`,
-			`disk_id="${datastore_id_target}:$([[ "$dst_target" == "dir" ]] && echo "${vm_id}/" || echo "")vm-${vm_id}-disk-${disk_count}$([[ "$dst_target" == "dir" ]] && echo ".qcow2" || echo "")${disk_options}"`,
-			`qm set "$vm_id" "-scsi${disk_index}" "$disk_id"`,
-			`rm -f "$file_path_tmp"`,
-		)
+	if restoreLiveRestore {
+		restoreBody.LiveRestore = &restoreLiveRestore
+	}
 
-		importedDiskCount++
+	if restoreUnique {
+		restoreBody.Unique = &restoreUnique
 	}
 
-	// Execute the commands on the node and wait for the result.
-	// This is a highly experimental approach to disk imports and is not recommended by Proxmox.
-	if len(commands) > 0 {
-		err = veClient.ExecuteNodeCommands(nodeName, commands)
+	if poolID != "" {
+		restoreBody.PoolID = &poolID
+	}
+
+	vmID, err = createWithVMID(veClient, vmID, func(vmID int) error {
+		restoreBody.VMID = &vmID
+
+		return veClient.RestoreVM(nodeName, int(timeoutRestore.Seconds()), restoreBody)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	// Wait for the virtual machine to be restored and its configuration lock to be released.
+	err = veClient.WaitForVMConfigUnlock(nodeName, vmID, 600, 5, true)
+
+	if err != nil {
+		return err
+	}
+
+	// The API does not accept a name or a description in combination with an archive, so we set them afterwards.
+	if description != "" || name != "" {
+		updateBody := &proxmox.VirtualEnvironmentVMUpdateRequestBody{}
+
+		if description != "" {
+			updateBody.Description = &description
+		}
+
+		if name != "" {
+			updateBody.Name = &name
+		}
+
+		err = veClient.UpdateVM(nodeName, vmID, updateBody)
 
 		if err != nil {
 			return err
 		}
 	}
 
-	return resourceVirtualEnvironmentVMCreateStart(d, m)
+	return resourceVirtualEnvironmentVMCreateReconfigure(d, m)
 }
 
 func resourceVirtualEnvironmentVMCreateStart(d *schema.ResourceData, m interface{}) error {
@@ -1833,13 +2117,22 @@ func resourceVirtualEnvironmentVMCreateStart(d *schema.ResourceData, m interface
 		return err
 	}
 
-	// Start the virtual machine and wait for it to reach a running state before continuing.
-	err = veClient.StartVM(nodeName, vmID)
+	vmStatus, err := veClient.GetVMStatus(nodeName, vmID)
 
 	if err != nil {
 		return err
 	}
 
+	// Start the virtual machine and wait for it to reach a running state before continuing.
+	// A live restore has already started the virtual machine, in which case we skip this step.
+	if vmStatus.Status != "running" {
+		err = veClient.StartVM(nodeName, vmID)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	if reboot {
 		rebootTimeout := 300
 
@@ -2202,6 +2495,41 @@ func resourceVirtualEnvironmentVMGetVGADeviceObject(d *schema.ResourceData, m in
 	return vgaDevice, nil
 }
 
+// resourceVirtualEnvironmentVMHasDefaultDisk determines whether the disk devices are either omitted or identical to the default ones.
+func resourceVirtualEnvironmentVMHasDefaultDisk(d *schema.ResourceData) bool {
+	disk := d.Get(mkResourceVirtualEnvironmentVMDisk).([]interface{})
+
+	if len(disk) == 0 {
+		return true
+	}
+
+	if len(disk) > 1 {
+		return false
+	}
+
+	diskBlock := disk[0].(map[string]interface{})
+
+	if diskBlock[mkResourceVirtualEnvironmentVMDiskDatastoreID].(string) != dvResourceVirtualEnvironmentVMDiskDatastoreID ||
+		diskBlock[mkResourceVirtualEnvironmentVMDiskFileFormat].(string) != dvResourceVirtualEnvironmentVMDiskFileFormat ||
+		diskBlock[mkResourceVirtualEnvironmentVMDiskFileID].(string) != dvResourceVirtualEnvironmentVMDiskFileID ||
+		diskBlock[mkResourcevirtualEnvironmentVMDiskInterface].(string) != dvResourcevirtualEnvironmentVMDiskInterface ||
+		diskBlock[mkResourceVirtualEnvironmentVMDiskSize].(int) != dvResourceVirtualEnvironmentVMDiskSize {
+		return false
+	}
+
+	speed := diskBlock[mkResourceVirtualEnvironmentVMDiskSpeed].([]interface{})
+
+	if len(speed) > 0 && speed[0] != nil {
+		for _, v := range speed[0].(map[string]interface{}) {
+			if v.(int) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func resourceVirtualEnvironmentVMRead(d *schema.ResourceData, m interface{}) error {
 	config := m.(providerConfiguration)
 	veClient, err := config.GetVEClient()
@@ -2256,6 +2584,9 @@ func resourceVirtualEnvironmentVMReadCustom(d *schema.ResourceData, m interface{
 
 	clone := d.Get(mkResourceVirtualEnvironmentVMClone).([]interface{})
 
+	// Restored virtual machines inherit their configuration from the backup, just like clones do.
+	clone = append(clone, d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})...)
+
 	// Compare the agent configuration to the one stored in the state.
 	currentAgent := d.Get(mkResourceVirtualEnvironmentVMAgent).([]interface{})
 
@@ -2566,7 +2897,10 @@ func resourceVirtualEnvironmentVMReadCustom(d *schema.ResourceData, m interface{
 		orderedDiskList = append(orderedDiskList, diskMap[k])
 	}
 	if len(clone) > 0 {
-		if len(orderedDiskList) > 0 {
+		restore := d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})
+
+		// The disks of restored virtual machines are not managed, unless they have been declared explicitly.
+		if len(orderedDiskList) > 0 && (len(restore) == 0 || !resourceVirtualEnvironmentVMHasDefaultDisk(d)) {
 			d.Set(mkResourceVirtualEnvironmentVMDisk, orderedDiskList)
 		}
 	} else if len(orderedDiskList) > 0 {
@@ -3010,6 +3344,7 @@ func resourceVirtualEnvironmentVMReadNetworkValues(d *schema.ResourceData, m int
 
 func resourceVirtualEnvironmentVMReadPrimitiveValues(d *schema.ResourceData, m interface{}, vmID int, vmConfig *proxmox.VirtualEnvironmentVMGetResponseData, vmStatus *proxmox.VirtualEnvironmentVMGetStatusResponseData) error {
 	clone := d.Get(mkResourceVirtualEnvironmentVMClone).([]interface{})
+	clone = append(clone, d.Get(mkResourceVirtualEnvironmentVMRestore).([]interface{})...)
 	currentACPI := d.Get(mkResourceVirtualEnvironmentVMACPI).(bool)
 
 	if len(clone) == 0 || currentACPI != dvResourceVirtualEnvironmentVMACPI {
@@ -3078,6 +3413,16 @@ func resourceVirtualEnvironmentVMReadPrimitiveValues(d *schema.ResourceData, m i
 		}
 	}
 
+	currentTags := d.Get(mkResourceVirtualEnvironmentVMTags).(*schema.Set)
+
+	if len(clone) == 0 || currentTags.Len() > 0 {
+		if vmConfig.Tags != nil {
+			d.Set(mkResourceVirtualEnvironmentVMTags, getTagList(*vmConfig.Tags))
+		} else {
+			d.Set(mkResourceVirtualEnvironmentVMTags, []string{})
+		}
+	}
+
 	currentTemplate := d.Get(mkResourceVirtualEnvironmentVMTemplate).(bool)
 
 	if len(clone) == 0 || currentTemplate != dvResourceVirtualEnvironmentVMTemplate {
@@ -3166,6 +3511,17 @@ func resourceVirtualEnvironmentVMUpdate(d *schema.ResourceData, m interface{}) e
 		rebootRequired = true
 	}
 
+	if d.HasChange(mkResourceVirtualEnvironmentVMTags) {
+		tags := d.Get(mkResourceVirtualEnvironmentVMTags).(*schema.Set).List()
+
+		if len(tags) > 0 {
+			tagsString := getTagString(tags)
+			updateBody.Tags = &tagsString
+		} else {
+			delete = append(delete, "tags")
+		}
+	}
+
 	template := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentVMTemplate).(bool))
 
 	if d.HasChange(mkResourceVirtualEnvironmentVMTemplate) {
@@ -3642,6 +3998,13 @@ func resourceVirtualEnvironmentVMDelete(d *schema.ResourceData, m interface{}) e
 		return err
 	}
 
+	// Remove the virtual machine from the HA configuration before shutting it down, as the HA manager would otherwise start it again and prevent its deletion.
+	err = resourceVirtualEnvironmentHAResourceRemoveGuest(veClient, fmt.Sprintf("vm:%d", vmID))
+
+	if err != nil {
+		return err
+	}
+
 	// Shut down the virtual machine before deleting it.
 	status, err := veClient.GetVMStatus(nodeName, vmID)
 
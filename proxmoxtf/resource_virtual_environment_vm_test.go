@@ -23,10 +23,6 @@ func TestResourceVirtualEnvironmentVMInstantiation(t *testing.T) {
 func TestResourceVirtualEnvironmentVMSchema(t *testing.T) {
 	s := resourceVirtualEnvironmentVM()
 
-	testRequiredArguments(t, s, []string{
-		mkResourceVirtualEnvironmentVMNodeName,
-	})
-
 	testOptionalArguments(t, s, []string{
 		mkResourceVirtualEnvironmentVMACPI,
 		mkResourceVirtualEnvironmentVMAgent,
@@ -42,12 +38,17 @@ func TestResourceVirtualEnvironmentVMSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentVMMemory,
 		mkResourceVirtualEnvironmentVMName,
 		mkResourceVirtualEnvironmentVMNetworkDevice,
+		mkResourceVirtualEnvironmentVMNodeName,
 		mkResourceVirtualEnvironmentVMOperatingSystem,
+		mkResourceVirtualEnvironmentVMPlacement,
 		mkResourceVirtualEnvironmentVMPoolID,
+		mkResourceVirtualEnvironmentVMRestore,
 		mkResourceVirtualEnvironmentVMSerialDevice,
 		mkResourceVirtualEnvironmentVMStarted,
 		mkResourceVirtualEnvironmentVMTabletDevice,
+		mkResourceVirtualEnvironmentVMTags,
 		mkResourceVirtualEnvironmentVMTemplate,
+		mkResourceVirtualEnvironmentVMTimeoutRestore,
 		mkResourceVirtualEnvironmentVMVMID,
 	})
 
@@ -77,11 +78,15 @@ func TestResourceVirtualEnvironmentVMSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentVMMACAddresses:          schema.TypeList,
 		mkResourceVirtualEnvironmentVMNetworkInterfaceNames: schema.TypeList,
 		mkResourceVirtualEnvironmentVMOperatingSystem:       schema.TypeList,
+		mkResourceVirtualEnvironmentVMPlacement:             schema.TypeList,
 		mkResourceVirtualEnvironmentVMPoolID:                schema.TypeString,
+		mkResourceVirtualEnvironmentVMRestore:               schema.TypeList,
 		mkResourceVirtualEnvironmentVMSerialDevice:          schema.TypeList,
 		mkResourceVirtualEnvironmentVMStarted:               schema.TypeBool,
 		mkResourceVirtualEnvironmentVMTabletDevice:          schema.TypeBool,
+		mkResourceVirtualEnvironmentVMTags:                  schema.TypeSet,
 		mkResourceVirtualEnvironmentVMTemplate:              schema.TypeBool,
+		mkResourceVirtualEnvironmentVMTimeoutRestore:        schema.TypeString,
 		mkResourceVirtualEnvironmentVMVMID:                  schema.TypeInt,
 	})
 
@@ -317,6 +322,39 @@ func TestResourceVirtualEnvironmentVMSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentVMOperatingSystemType: schema.TypeString,
 	})
 
+	placementSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentVMPlacement)
+
+	testOptionalArguments(t, placementSchema, []string{
+		mkResourceVirtualEnvironmentVMPlacementDatastoreID,
+		mkResourceVirtualEnvironmentVMPlacementNodes,
+		mkResourceVirtualEnvironmentVMPlacementStrategy,
+	})
+
+	testValueTypes(t, placementSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentVMPlacementDatastoreID: schema.TypeString,
+		mkResourceVirtualEnvironmentVMPlacementNodes:       schema.TypeList,
+		mkResourceVirtualEnvironmentVMPlacementStrategy:    schema.TypeString,
+	})
+
+	restoreSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentVMRestore)
+
+	testRequiredArguments(t, restoreSchema, []string{
+		mkResourceVirtualEnvironmentVMRestoreBackupFile,
+	})
+
+	testOptionalArguments(t, restoreSchema, []string{
+		mkResourceVirtualEnvironmentVMRestoreDatastoreID,
+		mkResourceVirtualEnvironmentVMRestoreLiveRestore,
+		mkResourceVirtualEnvironmentVMRestoreUnique,
+	})
+
+	testValueTypes(t, restoreSchema, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentVMRestoreBackupFile:  schema.TypeString,
+		mkResourceVirtualEnvironmentVMRestoreDatastoreID: schema.TypeString,
+		mkResourceVirtualEnvironmentVMRestoreLiveRestore: schema.TypeBool,
+		mkResourceVirtualEnvironmentVMRestoreUnique:      schema.TypeBool,
+	})
+
 	serialDeviceSchema := testNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentVMSerialDevice)
 
 	testOptionalArguments(t, serialDeviceSchema, []string{
@@ -341,3 +379,74 @@ func TestResourceVirtualEnvironmentVMSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentVMVGAType:    schema.TypeString,
 	})
 }
+
+// TestResourceVirtualEnvironmentVMHasDefaultDisk tests whether disk devices, which have not been declared explicitly, are detected.
+func TestResourceVirtualEnvironmentVMHasDefaultDisk(t *testing.T) {
+	restore := []interface{}{
+		map[string]interface{}{
+			mkResourceVirtualEnvironmentVMRestoreBackupFile: "local:backup/vzdump-qemu-100.vma.zst",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		disk     []interface{}
+		expected bool
+	}{
+		{"omitted disk", nil, true},
+		{"default disk", []interface{}{
+			map[string]interface{}{
+				mkResourcevirtualEnvironmentVMDiskInterface: dvResourcevirtualEnvironmentVMDiskInterface,
+			},
+		}, true},
+		{"resized disk", []interface{}{
+			map[string]interface{}{
+				mkResourcevirtualEnvironmentVMDiskInterface: dvResourcevirtualEnvironmentVMDiskInterface,
+				mkResourceVirtualEnvironmentVMDiskSize:      32,
+			},
+		}, false},
+		{"moved disk", []interface{}{
+			map[string]interface{}{
+				mkResourceVirtualEnvironmentVMDiskDatastoreID: "ceph",
+				mkResourcevirtualEnvironmentVMDiskInterface:   dvResourcevirtualEnvironmentVMDiskInterface,
+			},
+		}, false},
+		{"limited disk", []interface{}{
+			map[string]interface{}{
+				mkResourcevirtualEnvironmentVMDiskInterface: dvResourcevirtualEnvironmentVMDiskInterface,
+				mkResourceVirtualEnvironmentVMDiskSpeed: []interface{}{
+					map[string]interface{}{
+						mkResourceVirtualEnvironmentVMDiskSpeedRead: 100,
+					},
+				},
+			},
+		}, false},
+		{"additional disk", []interface{}{
+			map[string]interface{}{
+				mkResourcevirtualEnvironmentVMDiskInterface: dvResourcevirtualEnvironmentVMDiskInterface,
+			},
+			map[string]interface{}{
+				mkResourcevirtualEnvironmentVMDiskInterface: "scsi1",
+			},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				mkResourceVirtualEnvironmentVMNodeName: "pve",
+				mkResourceVirtualEnvironmentVMRestore:  restore,
+			}
+
+			if tt.disk != nil {
+				raw[mkResourceVirtualEnvironmentVMDisk] = tt.disk
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceVirtualEnvironmentVM().Schema, raw)
+
+			if v := resourceVirtualEnvironmentVMHasDefaultDisk(d); v != tt.expected {
+				t.Fatalf("Expected %t but got %t", tt.expected, v)
+			}
+		})
+	}
+}
@@ -5,8 +5,14 @@
 package proxmoxtf
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"log"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 	"unicode"
@@ -16,6 +22,62 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// createWithVMID invokes the create function with an allocated identifier, when vmID is -1. The create request claims the
+// identifier atomically on the cluster, which is why a new identifier is allocated whenever it fails because another client
+// has claimed the previous one before the guest could be created.
+func createWithVMID(veClient *proxmox.VirtualEnvironmentClient, vmID int, create func(vmID int) error) (int, error) {
+	if vmID != -1 {
+		return vmID, create(vmID)
+	}
+
+	var allocatedVMID *int
+	var err error
+
+	for i := 0; i < 5; i++ {
+		allocatedVMID, err = veClient.GetVMID()
+
+		if err != nil {
+			return -1, err
+		}
+
+		err = create(*allocatedVMID)
+
+		if err == nil {
+			return *allocatedVMID, nil
+		}
+
+		if !strings.Contains(err.Error(), "already exists") {
+			return -1, err
+		}
+
+		log.Printf("[DEBUG] VM identifier %d was claimed by another client - allocating a new identifier", *allocatedVMID)
+	}
+
+	return -1, err
+}
+
+func getACLModeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{"additive", "authoritative"}, false)
+}
+
+func getACMEIDValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]+$`),
+		"must begin with a letter and only contain letters, digits, dashes and underscores",
+	)
+}
+
+func getBackupJobIDValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]+$`),
+		"must begin with a letter and only contain letters, digits, dashes and underscores",
+	)
+}
+
+func getBackupModeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{"snapshot", "stop", "suspend"}, false)
+}
+
 func getBIOSValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"ovmf",
@@ -23,6 +85,143 @@ func getBIOSValidator() schema.SchemaValidateFunc {
 	}, false)
 }
 
+func getCalendarEventValidator() schema.SchemaValidateFunc {
+	component := `(?:\*|[0-9]{1,4}(?:\.\.[0-9]{1,4})?)(?:/[0-9]{1,4})?`
+	components := fmt.Sprintf(`%s(?:,%s)*`, component, component)
+	weekday := `(?:monday|tuesday|wednesday|thursday|friday|saturday|sunday|mon|tue|wed|thu|fri|sat|sun)`
+	weekdays := fmt.Sprintf(`%s(?:\.\.%s)?`, weekday, weekday)
+
+	keywordRegex := regexp.MustCompile(`^(?:minutely|hourly|daily|weekly|monthly|yearly|annually|quarterly|semiannually)$`)
+	weekdaysRegex := regexp.MustCompile(fmt.Sprintf(`^(?i)%s(?:,%s)*$`, weekdays, weekdays))
+	dateRegex := regexp.MustCompile(fmt.Sprintf(`^%s-%s(?:-%s)?$`, components, components, components))
+	timeRegex := regexp.MustCompile(fmt.Sprintf(`^%s(?::%s){0,2}$`, components, components))
+
+	return func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(string)
+
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if keywordRegex.MatchString(v) {
+			return
+		}
+
+		// Calendar events consist of an optional weekday, date and time specification in that order.
+		fields := strings.Fields(v)
+		remaining := fields
+
+		if len(remaining) > 0 && weekdaysRegex.MatchString(remaining[0]) {
+			remaining = remaining[1:]
+		}
+
+		if len(remaining) > 0 && dateRegex.MatchString(remaining[0]) {
+			err := validateCalendarEventDate(remaining[0])
+
+			if err != nil {
+				es = append(es, fmt.Errorf("expected %s to be a valid calendar event, got %s - Reason: %s", k, v, err.Error()))
+				return
+			}
+
+			remaining = remaining[1:]
+		}
+
+		if len(remaining) > 0 && timeRegex.MatchString(remaining[0]) {
+			err := validateCalendarEventTime(remaining[0])
+
+			if err != nil {
+				es = append(es, fmt.Errorf("expected %s to be a valid calendar event, got %s - Reason: %s", k, v, err.Error()))
+				return
+			}
+
+			remaining = remaining[1:]
+		}
+
+		if len(fields) == 0 || len(remaining) > 0 {
+			es = append(es, fmt.Errorf("expected %s to be a calendar event (e.g. \"daily\" or \"mon..fri 02:00\"), got %s", k, v))
+			return
+		}
+
+		return
+	}
+}
+
+func getChecksumAlgorithmValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"md5",
+		"sha1",
+		"sha224",
+		"sha256",
+		"sha384",
+		"sha512",
+	}, false)
+}
+
+func getCloudInitPartContentTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"text/cloud-boothook",
+		"text/cloud-config",
+		"text/cloud-config-archive",
+		"text/jinja2",
+		"text/part-handler",
+		"text/x-include-url",
+		"text/x-shellscript",
+	}, false)
+}
+
+func getCloudInitSnippetFileNameValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9\-_.]+$`),
+		"must only contain letters, digits, dashes, underscores and periods",
+	)
+}
+
+func getCloudInitTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"meta",
+		"network",
+		"user",
+		"vendor",
+	}, false)
+}
+
+// getConfigDigest calculates a digest of the arguments of a resource, which allows other resources to reference its
+// configuration in order to detect changes while planning.
+func getConfigDigest(r *schema.Resource, get func(string) interface{}) string {
+	values := map[string]interface{}{}
+
+	for k, v := range r.Schema {
+		if v.Optional || v.Required {
+			values[k] = get(k)
+		}
+	}
+
+	// The values are limited to primitives, lists and maps, which can always be encoded.
+	data, _ := json.Marshal(values)
+
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// getConfigDigestCustomizeDiffFunc returns a function, which updates the digest attribute while planning.
+func getConfigDigestCustomizeDiffFunc(r *schema.Resource, key string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		for k, v := range r.Schema {
+			if (v.Optional || v.Required) && !d.NewValueKnown(k) {
+				return d.SetNewComputed(key)
+			}
+		}
+
+		digest := getConfigDigest(r, d.Get)
+
+		if d.Get(key).(string) == digest {
+			return nil
+		}
+
+		return d.SetNew(key, digest)
+	}
+}
+
 func getContentTypeValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"backup",
@@ -140,6 +339,27 @@ func getCPUTypeValidator() schema.SchemaValidateFunc {
 	}, false)
 }
 
+func getDatastoreContentTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"",
+		"backup",
+		"images",
+		"iso",
+		"rootdir",
+		"snippets",
+		"vztmpl",
+	}, false)
+}
+
+func getDecompressionAlgorithmValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"",
+		"gz",
+		"lzo",
+		"zst",
+	}, false)
+}
+
 func getFileFormatValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"qcow2",
@@ -171,6 +391,69 @@ func getFileIDValidator() schema.SchemaValidateFunc {
 	}
 }
 
+func getFirewallLogLevelValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"",
+		"alert",
+		"crit",
+		"debug",
+		"emerg",
+		"err",
+		"info",
+		"nolog",
+		"notice",
+		"warning",
+	}, false)
+}
+
+func getFirewallNameValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]+$`),
+		"must begin with a letter and only contain letters, digits, dashes and underscores",
+	)
+}
+
+func getFirewallPolicyValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"",
+		"ACCEPT",
+		"DROP",
+		"REJECT",
+	}, false)
+}
+
+func getFirewallRuleTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"group",
+		"in",
+		"out",
+	}, false)
+}
+
+func getFirewallSecurityGroupNameValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]{1,17}$`),
+		"must begin with a letter, only contain letters, digits, dashes and underscores and be at most 18 characters long",
+	)
+}
+
+func getHAResourceIDValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^(?:ct|vm):[1-9][0-9]*$`),
+		"must be of the form \"vm:<id>\" or \"ct:<id>\"",
+	)
+}
+
+func getHAResourceStateValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"disabled",
+		"enabled",
+		"ignored",
+		"started",
+		"stopped",
+	}, false)
+}
+
 func getKeyboardLayoutValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"da",
@@ -237,6 +520,118 @@ func getNetworkDeviceModelValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{"e1000", "rtl8139", "virtio", "vmxnet3"}, false)
 }
 
+func getNetworkInterfaceBondModeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"",
+		"802.3ad",
+		"active-backup",
+		"balance-alb",
+		"balance-rr",
+		"balance-slb",
+		"balance-tlb",
+		"balance-xor",
+		"broadcast",
+		"lacp-balance-slb",
+		"lacp-balance-tcp",
+	}, false)
+}
+
+func getNetworkInterfaceTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"bond",
+		"bridge",
+		"OVSBond",
+		"OVSBridge",
+		"OVSIntPort",
+		"OVSPort",
+		"vlan",
+	}, false)
+}
+
+// getPlacementNodeName selects the online node, which is best suited for a new guest according to the placement strategy.
+func getPlacementNodeName(veClient *proxmox.VirtualEnvironmentClient, allowedNodeNames []string, datastoreID string, strategy string) (string, error) {
+	if strategy == "datastore" && datastoreID == "" {
+		return "", fmt.Errorf("The placement strategy \"datastore\" requires a datastore id")
+	}
+
+	nodes, err := veClient.ListNodes()
+
+	if err != nil {
+		return "", err
+	}
+
+	allowedNodes := map[string]bool{}
+
+	for _, v := range allowedNodeNames {
+		allowedNodes[v] = true
+	}
+
+	nodeName := ""
+	nodeScore := 0.0
+
+	for _, v := range nodes {
+		if v.Status == nil || *v.Status != "online" {
+			continue
+		}
+
+		if len(allowedNodes) > 0 && !allowedNodes[v.Name] {
+			continue
+		}
+
+		datastoreSpaceAvailable := 0
+
+		if datastoreID != "" {
+			datastores, err := veClient.ListDatastores(v.Name, nil)
+
+			if err != nil {
+				return "", err
+			}
+
+			for _, ds := range datastores {
+				if ds.ID == datastoreID && ds.Active != nil && bool(*ds.Active) && ds.SpaceAvailable != nil {
+					datastoreSpaceAvailable = *ds.SpaceAvailable
+				}
+			}
+
+			// Nodes without access to the datastore cannot host the guest.
+			if datastoreSpaceAvailable == 0 {
+				continue
+			}
+		}
+
+		score := 0.0
+
+		switch strategy {
+		case "cpu":
+			if v.CPUUtilization != nil {
+				score = -*v.CPUUtilization
+			}
+		case "datastore":
+			score = float64(datastoreSpaceAvailable)
+		default:
+			if v.MemoryAvailable != nil && v.MemoryUsed != nil {
+				score = float64(*v.MemoryAvailable - *v.MemoryUsed)
+			}
+		}
+
+		// The nodes are sorted by name, which makes the selection deterministic for nodes with the same score.
+		if nodeName == "" || score > nodeScore {
+			nodeName = v.Name
+			nodeScore = score
+		}
+	}
+
+	if nodeName == "" {
+		return "", fmt.Errorf("Failed to find an online node matching the placement constraints")
+	}
+
+	return nodeName, nil
+}
+
+func getPlacementStrategyValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{"cpu", "datastore", "memory"}, false)
+}
+
 func getQEMUAgentTypeValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{"isa", "virtio"}, false)
 }
@@ -285,6 +680,118 @@ func getSchemaBlock(r *schema.Resource, d *schema.ResourceData, m interface{}, k
 	return resourceBlock, nil
 }
 
+func getRealmDirectoryModeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"ldap",
+		"ldap+starttls",
+		"ldaps",
+	}, false)
+}
+
+func getRealmSyncRemoveVanishedValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"acl",
+		"entry",
+		"properties",
+	}, false)
+}
+
+func getRealmSyncScopeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"both",
+		"groups",
+		"users",
+	}, false)
+}
+
+func getSDNControllerTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"bgp",
+		"evpn",
+	}, false)
+}
+
+func getSDNIDValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z][a-z0-9]{0,7}$`),
+		"must begin with a lowercase letter, only contain lowercase letters and digits and be at most 8 characters long",
+	)
+}
+
+func getSDNZoneTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"evpn",
+		"qinq",
+		"simple",
+		"vlan",
+		"vxlan",
+	}, false)
+}
+
+func getTagColorMapValidator() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(map[string]interface{})
+
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be map", k))
+			return
+		}
+
+		colorRegex := regexp.MustCompile(`^[0-9A-Fa-f]{6}(:[0-9A-Fa-f]{6})?$`)
+
+		for tag, color := range v {
+			if !colorRegex.MatchString(color.(string)) {
+				es = append(es, fmt.Errorf("expected the color of tag %s in %s to be a hex color optionally followed by a hex text color (e.g. FF0000:FFFFFF), got %s", tag, k, color))
+			}
+		}
+
+		return
+	}
+}
+
+func getTagHash(v interface{}) int {
+	return schema.HashString(strings.ToLower(v.(string)))
+}
+
+// getTagList splits a tag string and normalizes the tags the same way as the API (lowercase, sorted and unique).
+func getTagList(tags string) []string {
+	list := []string{}
+	seen := map[string]bool{}
+
+	for _, t := range strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ';' || r == ',' || r == ' '
+	}) {
+		t = strings.ToLower(t)
+
+		if !seen[t] {
+			list = append(list, t)
+			seen[t] = true
+		}
+	}
+
+	sort.Strings(list)
+
+	return list
+}
+
+// getTagString joins a list of tags with the separator expected by the API.
+func getTagString(tags []interface{}) string {
+	list := make([]string, len(tags))
+
+	for i, t := range tags {
+		list[i] = t.(string)
+	}
+
+	return strings.Join(getTagList(strings.Join(list, ";")), ";")
+}
+
+func getTagValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9\-_+.]*$`),
+		"must begin with a letter, digit or underscore and only contain letters, digits, dashes, underscores, plus signs and periods",
+	)
+}
+
 func getTimeoutValidator() schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (ws []string, es []error) {
 		v, ok := i.(string)
@@ -305,6 +812,35 @@ func getTimeoutValidator() schema.SchemaValidateFunc {
 	}
 }
 
+func getTOTPSecretValidator() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(string)
+
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if v != "" {
+			err := proxmox.ValidateTOTPSecret(v)
+
+			if err != nil {
+				es = append(es, fmt.Errorf("expected %s to be a base32 encoded string", k))
+				return
+			}
+		}
+
+		return
+	}
+}
+
+func getUserTokenNameValidator() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\.\-_]+$`),
+		"must begin with a letter and only contain letters, digits, periods, dashes and underscores",
+	)
+}
+
 func getVGAMemoryValidator() schema.SchemaValidateFunc {
 	return validation.IntBetween(4, 512)
 }
@@ -436,6 +972,117 @@ func getDiskInfo(data *proxmox.VirtualEnvironmentVMGetResponseData) map[string]*
 	return storageDevices
 }
 
+// isCertificateExpiring determines whether a certificate expires within the specified duration.
+func isCertificateExpiring(expirationDate string, renewBefore string) bool {
+	if expirationDate == "" {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expirationDate)
+
+	if err != nil {
+		return false
+	}
+
+	window, err := time.ParseDuration(renewBefore)
+
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Add(window).After(expiresAt)
+}
+
+// validateCalendarEventComponents ensures that the values, ranges and repetitions of a calendar event component are within bounds.
+func validateCalendarEventComponents(name string, value string, min int, max int) error {
+	for _, c := range strings.Split(value, ",") {
+		base := c
+		repetition := ""
+
+		if i := strings.Index(c, "/"); i >= 0 {
+			base = c[:i]
+			repetition = c[i+1:]
+		}
+
+		if base != "*" {
+			bounds := strings.Split(base, "..")
+
+			for _, b := range bounds {
+				n, err := strconv.Atoi(b)
+
+				if err != nil || n < min || n > max {
+					return fmt.Errorf("%s \"%s\" is out of range (%d-%d)", name, b, min, max)
+				}
+			}
+
+			if len(bounds) == 2 {
+				start, _ := strconv.Atoi(bounds[0])
+				end, _ := strconv.Atoi(bounds[1])
+
+				if start > end {
+					return fmt.Errorf("%s range \"%s\" must not end before it starts", name, base)
+				}
+			}
+		}
+
+		if repetition != "" {
+			n, err := strconv.Atoi(repetition)
+
+			if err != nil || n < 1 || n > max {
+				return fmt.Errorf("%s repetition \"%s\" is out of range (1-%d)", name, repetition, max)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCalendarEventDate validates the date specification of a calendar event ([YEAR-]MONTH-DAY).
+func validateCalendarEventDate(value string) error {
+	components := strings.Split(value, "-")
+
+	if len(components) == 3 {
+		err := validateCalendarEventComponents("year", components[0], 0, 9999)
+
+		if err != nil {
+			return err
+		}
+
+		components = components[1:]
+	}
+
+	err := validateCalendarEventComponents("month", components[0], 1, 12)
+
+	if err != nil {
+		return err
+	}
+
+	return validateCalendarEventComponents("day", components[1], 1, 31)
+}
+
+// validateCalendarEventTime validates the time specification of a calendar event (MINUTE, HOUR:MINUTE or HOUR:MINUTE:SECOND).
+func validateCalendarEventTime(value string) error {
+	components := strings.Split(value, ":")
+
+	if len(components) == 1 {
+		return validateCalendarEventComponents("minute", components[0], 0, 59)
+	}
+
+	err := validateCalendarEventComponents("hour", components[0], 0, 23)
+
+	if err != nil {
+		return err
+	}
+
+	err = validateCalendarEventComponents("minute", components[1], 0, 59)
+
+	if err != nil || len(components) == 2 {
+		return err
+	}
+
+	return validateCalendarEventComponents("second", components[2], 0, 59)
+}
+
 func testComputedAttributes(t *testing.T, s *schema.Resource, keys []string) {
 	for _, v := range keys {
 		if s.Schema[v] == nil {
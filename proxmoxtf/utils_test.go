@@ -0,0 +1,126 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// TestCalendarEventValidator tests the validation of calendar events.
+func TestCalendarEventValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"keyword", "daily", true},
+		{"minute repetition", "*/5", true},
+		{"time", "02:30", true},
+		{"time with seconds", "02:30:59", true},
+		{"weekday range with time", "mon..fri 02:00", true},
+		{"weekday list with hour repetition", "sat,sun *:00/15", true},
+		{"month and day", "01-01 00:00", true},
+		{"year, month and day", "2030-12-31 23:59", true},
+		{"day range", "*-1..7 00:00", true},
+		{"hour range", "8..17:00", true},
+		{"empty", "", false},
+		{"unknown keyword", "fortnightly", false},
+		{"hour out of range", "24:00", false},
+		{"minute out of range", "00:60", false},
+		{"second out of range", "00:00:60", false},
+		{"single minute out of range", "60", false},
+		{"month out of range", "13-01", false},
+		{"month zero", "00-01", false},
+		{"day out of range", "01-32", false},
+		{"day zero", "01-00", false},
+		{"hour range endpoint out of range", "20..25:00", false},
+		{"reversed range", "17..8:00", false},
+		{"zero repetition", "*/0", false},
+		{"minute repetition out of range", "*/60", false},
+		{"hour repetition out of range", "*/24:00", false},
+		{"day in list out of range", "01-1,40", false},
+		{"trailing garbage", "daily now", false},
+	}
+
+	validator := getCalendarEventValidator()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, es := validator(tt.value, "schedule")
+
+			if tt.valid && len(es) > 0 {
+				t.Fatalf("Expected \"%s\" to be valid - Reason: %s", tt.value, es[0].Error())
+			} else if !tt.valid && len(es) == 0 {
+				t.Fatalf("Expected \"%s\" to be invalid", tt.value)
+			}
+		})
+	}
+}
+
+// TestConfigDigestCustomizeDiff tests whether the digest changes along with the configuration while planning.
+func TestConfigDigestCustomizeDiff(t *testing.T) {
+	r := resourceVirtualEnvironmentSDNVNet()
+
+	plan := func(state *terraform.InstanceState, c map[string]interface{}) *terraform.InstanceDiff {
+		raw, err := config.NewRawConfig(c)
+
+		if err != nil {
+			t.Fatalf("Failed to create the configuration - Reason: %s", err.Error())
+		}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), nil)
+
+		if err != nil {
+			t.Fatalf("Failed to plan the changes - Reason: %s", err.Error())
+		}
+
+		return diff
+	}
+
+	c := map[string]interface{}{
+		mkResourceVirtualEnvironmentSDNVNetVNetID: "lab",
+		mkResourceVirtualEnvironmentSDNVNetZone:   "lab",
+	}
+
+	diff := plan(nil, c)
+	state := &terraform.InstanceState{
+		ID:         "lab",
+		Attributes: map[string]string{"id": "lab"},
+	}
+
+	for k, v := range diff.Attributes {
+		state.Attributes[k] = v.New
+	}
+
+	digest := state.Attributes[mkResourceVirtualEnvironmentSDNVNetDigest]
+
+	if digest == "" {
+		t.Fatalf("Expected the digest to be known while planning")
+	}
+
+	if d := getConfigDigest(r, r.Data(state).Get); d != digest {
+		t.Fatalf("Expected the refreshed digest to be %s (got %s)", digest, d)
+	}
+
+	diff = plan(state, c)
+
+	if diff != nil && diff.Attributes[mkResourceVirtualEnvironmentSDNVNetDigest] != nil {
+		t.Fatalf("Expected the digest to remain unchanged")
+	}
+
+	c[mkResourceVirtualEnvironmentSDNVNetAlias] = "Lab"
+	diff = plan(state, c)
+
+	if diff == nil || diff.Attributes[mkResourceVirtualEnvironmentSDNVNetDigest] == nil {
+		t.Fatalf("Expected the digest to change along with the configuration")
+	}
+
+	if diff.Attributes[mkResourceVirtualEnvironmentSDNVNetDigest].New == digest {
+		t.Fatalf("Expected the digest to differ from %s", digest)
+	}
+}